@@ -0,0 +1,184 @@
+package carbon
+
+import (
+	"math"
+	"time"
+
+	"github.com/dromara/carbon/v2/calendar/julian"
+)
+
+// altitudes of the sun's center in degrees for the solar events,
+// the sunrise and sunset altitude includes the atmospheric refraction and the solar radius.
+const (
+	sunriseAltitude              = -0.833
+	civilTwilightAltitude        = -6.0
+	nauticalTwilightAltitude     = -12.0
+	astronomicalTwilightAltitude = -18.0
+
+	// julian day of the J2000.0 epoch
+	j2000 = 2451545.0
+	// number of days in a julian century
+	daysPerJulianCentury = 36525.0
+)
+
+// SunTimes defines a SunTimes struct which holds the solar events of a day at a location,
+// an event is nil if the sun doesn't reach its altitude on the day, like the sunrise during polar night.
+type SunTimes struct {
+	// SolarNoon is the moment when the sun crosses the meridian.
+	SolarNoon *Carbon
+	// Sunrise and Sunset are the moments when the upper limb of the sun touches the horizon.
+	Sunrise, Sunset *Carbon
+	// CivilDawn and CivilDusk are the moments when the sun is 6 degrees below the horizon.
+	CivilDawn, CivilDusk *Carbon
+	// NauticalDawn and NauticalDusk are the moments when the sun is 12 degrees below the horizon.
+	NauticalDawn, NauticalDusk *Carbon
+	// AstronomicalDawn and AstronomicalDusk are the moments when the sun is 18 degrees below the horizon.
+	AstronomicalDawn, AstronomicalDusk *Carbon
+	Error                              error
+}
+
+// SunTimes gets the sunrise, sunset, solar noon and twilight moments of the day at the location,
+// latitude is positive to the north and longitude is positive to the east, both in degrees.
+func (c *Carbon) SunTimes(latitude, longitude float64) *SunTimes {
+	if c.IsNil() {
+		return nil
+	}
+	if c.IsZero() || c.IsEmpty() {
+		return &SunTimes{}
+	}
+	if c.HasError() {
+		return &SunTimes{Error: c.Error}
+	}
+	if math.IsNaN(latitude) || math.IsNaN(longitude) || math.Abs(latitude) > 90 || math.Abs(longitude) > 180 {
+		return &SunTimes{Error: ErrInvalidCoordinate(latitude, longitude)}
+	}
+
+	// the solar noon nearest to the local noon of the day
+	year, month, day := c.Date()
+	jd := julian.FromStdTime(time.Date(year, time.Month(month), day, 12, 0, 0, 0, c.loc).UTC()).JD()
+	noon := math.Round(jd+longitude/360) - longitude/360
+
+	s := &SunTimes{SolarNoon: c.atJulianDay(getSolarTransit(noon))}
+	s.Sunrise, s.Sunset = c.getSunEvents(noon, latitude, sunriseAltitude)
+	s.CivilDawn, s.CivilDusk = c.getSunEvents(noon, latitude, civilTwilightAltitude)
+	s.NauticalDawn, s.NauticalDusk = c.getSunEvents(noon, latitude, nauticalTwilightAltitude)
+	s.AstronomicalDawn, s.AstronomicalDusk = c.getSunEvents(noon, latitude, astronomicalTwilightAltitude)
+	return s
+}
+
+// MoonPhase gets the phase of the moon as a fraction of the lunation like 0.5,
+// 0 is the new moon, 0.25 is the first quarter, 0.5 is the full moon and 0.75 is the last quarter.
+func (c *Carbon) MoonPhase() float64 {
+	if c.IsInvalid() || c.IsZero() {
+		return 0
+	}
+	elongation := 180 - getMoonPhaseAngle(julian.FromStdTime(c.StdTime().UTC()).JD())
+	return normalizeDegrees(elongation) / 360
+}
+
+// MoonIllumination gets the illuminated fraction of the moon's disk like 0.98.
+func (c *Carbon) MoonIllumination() float64 {
+	if c.IsInvalid() || c.IsZero() {
+		return 0
+	}
+	i := getMoonPhaseAngle(julian.FromStdTime(c.StdTime().UTC()).JD())
+	return (1 + math.Cos(i*math.Pi/180)) / 2
+}
+
+// gets the moments of the rising and setting of the sun to the altitude around the solar noon,
+// both are nil if the sun stays above or below the altitude all day.
+func (c *Carbon) getSunEvents(noon, latitude, altitude float64) (rising, setting *Carbon) {
+	if jd, ok := getSunAltitudeTime(noon, latitude, altitude, -1); ok {
+		rising = c.atJulianDay(jd)
+	}
+	if jd, ok := getSunAltitudeTime(noon, latitude, altitude, 1); ok {
+		setting = c.atJulianDay(jd)
+	}
+	return
+}
+
+// returns a copy of the current instance at the julian day.
+func (c *Carbon) atJulianDay(jd float64) *Carbon {
+	t := c.Copy()
+	t.lang = c.lang.Copy()
+	t.time = julian.NewJulian(jd).ToGregorian().Time.In(c.loc)
+	return t
+}
+
+// gets the julian day of the solar transit near the noon of the mean solar time.
+func getSolarTransit(noon float64) float64 {
+	transit := noon
+	for i := 0; i < 3; i++ {
+		_, eot := getSunPosition(transit)
+		transit = noon - eot/1440
+	}
+	return transit
+}
+
+// gets the julian day when the sun reaches the altitude before (sign -1) or after (sign 1) the solar noon.
+func getSunAltitudeTime(noon, latitude, altitude, sign float64) (float64, bool) {
+	jd := noon
+	for i := 0; i < 4; i++ {
+		declination, eot := getSunPosition(jd)
+		phi, delta := latitude*math.Pi/180, declination*math.Pi/180
+		cosH := (math.Sin(altitude*math.Pi/180) - math.Sin(phi)*math.Sin(delta)) / (math.Cos(phi) * math.Cos(delta))
+		if cosH < -1 || cosH > 1 {
+			return 0, false
+		}
+		hourAngle := math.Acos(cosH) * 180 / math.Pi
+		jd = noon - eot/1440 + sign*hourAngle/360
+	}
+	return jd, true
+}
+
+// gets the declination of the sun in degrees and the equation of time in minutes at the julian day,
+// which follows the low accuracy solar coordinates of Jean Meeus's Astronomical Algorithms.
+func getSunPosition(jd float64) (declination, eot float64) {
+	t := (jd - j2000) / daysPerJulianCentury
+	rad := math.Pi / 180
+
+	// geometric mean longitude and mean anomaly of the sun
+	l0 := normalizeDegrees(280.46646 + t*(36000.76983+t*0.0003032))
+	m := 357.52911 + t*(35999.05029-t*0.0001537)
+	// eccentricity of the earth's orbit
+	e := 0.016708634 - t*(0.000042037+t*0.0000001267)
+	// equation of the center
+	center := math.Sin(m*rad)*(1.914602-t*(0.004817+t*0.000014)) + math.Sin(2*m*rad)*(0.019993-t*0.000101) + math.Sin(3*m*rad)*0.000289
+	// apparent longitude of the sun
+	omega := 125.04 - 1934.136*t
+	lambda := l0 + center - 0.00569 - 0.00478*math.Sin(omega*rad)
+	// obliquity of the ecliptic
+	epsilon := 23 + (26+(21.448-t*(46.815+t*(0.00059-t*0.001813)))/60)/60 + 0.00256*math.Cos(omega*rad)
+
+	declination = math.Asin(math.Sin(epsilon*rad)*math.Sin(lambda*rad)) / rad
+
+	y := math.Pow(math.Tan(epsilon*rad/2), 2)
+	eot = 4 / rad * (y*math.Sin(2*l0*rad) - 2*e*math.Sin(m*rad) + 4*e*y*math.Sin(m*rad)*math.Cos(2*l0*rad) -
+		0.5*y*y*math.Sin(4*l0*rad) - 1.25*e*e*math.Sin(2*m*rad))
+	return
+}
+
+// gets the phase angle of the moon in degrees at the julian day, which is 0 at the full moon and 180 at the new moon,
+// it follows the chapter "Illuminated Fraction of the Moon's Disk" of Jean Meeus's Astronomical Algorithms.
+func getMoonPhaseAngle(jd float64) float64 {
+	t := (jd - j2000) / daysPerJulianCentury
+	rad := math.Pi / 180
+
+	// mean elongation of the moon, mean anomaly of the sun and mean anomaly of the moon
+	d := 297.8501921 + t*(445267.1114034+t*(-0.0018819+t*(1.0/545868-t/113065000)))
+	m := 357.5291092 + t*(35999.0502909+t*(-0.0001536+t/24490000))
+	mp := 134.9633964 + t*(477198.8675055+t*(0.0087414+t*(1.0/69699-t/14712000)))
+
+	i := 180 - d - 6.289*math.Sin(mp*rad) + 2.100*math.Sin(m*rad) - 1.274*math.Sin((2*d-mp)*rad) -
+		0.658*math.Sin(2*d*rad) - 0.214*math.Sin(2*mp*rad) - 0.110*math.Sin(d*rad)
+	return normalizeDegrees(i)
+}
+
+// normalizes the angle in degrees to [0, 360).
+func normalizeDegrees(degrees float64) float64 {
+	degrees = math.Mod(degrees, 360)
+	if degrees < 0 {
+		degrees += 360
+	}
+	return degrees
+}
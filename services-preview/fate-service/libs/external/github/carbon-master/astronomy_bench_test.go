@@ -0,0 +1,108 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+)
+
+func BenchmarkCarbon_SunTimes(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.SunTimes(39.9042, 116.4074)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.SunTimes(39.9042, 116.4074)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.SunTimes(39.9042, 116.4074)
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_MoonPhase(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.MoonPhase()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.MoonPhase()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.MoonPhase()
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_MoonIllumination(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.MoonIllumination()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.MoonIllumination()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.MoonIllumination()
+			}
+		})
+	})
+}
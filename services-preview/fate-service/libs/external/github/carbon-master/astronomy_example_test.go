@@ -0,0 +1,41 @@
+package carbon_test
+
+import (
+	"fmt"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func ExampleCarbon_SunTimes() {
+	s := carbon.Parse("2020-06-21", "Europe/London").SunTimes(51.5074, -0.1278)
+	fmt.Println("sunrise:", s.Sunrise.ToTimeString())
+	fmt.Println("solar noon:", s.SolarNoon.ToTimeString())
+	fmt.Println("sunset:", s.Sunset.ToTimeString())
+	fmt.Println("civil dusk:", s.CivilDusk.ToTimeString())
+	fmt.Println("astronomical dusk:", s.AstronomicalDusk == nil)
+
+	// Output:
+	// sunrise: 04:43:11
+	// solar noon: 13:02:25
+	// sunset: 21:21:38
+	// civil dusk: 22:09:24
+	// astronomical dusk: true
+}
+
+func ExampleCarbon_MoonPhase() {
+	fmt.Printf("%.2f\n", carbon.Parse("2020-08-03 15:59:00").MoonPhase())
+	fmt.Printf("%.2f\n", carbon.Parse("2020-08-19 02:42:00").MoonPhase())
+
+	// Output:
+	// 0.50
+	// 0.00
+}
+
+func ExampleCarbon_MoonIllumination() {
+	fmt.Printf("%.2f\n", carbon.Parse("2020-08-03 15:59:00").MoonIllumination())
+	fmt.Printf("%.2f\n", carbon.Parse("2020-08-11 16:45:00").MoonIllumination())
+
+	// Output:
+	// 1.00
+	// 0.50
+}
@@ -0,0 +1,176 @@
+package carbon
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AstronomySuite struct {
+	suite.Suite
+}
+
+func TestAstronomySuite(t *testing.T) {
+	suite.Run(t, new(AstronomySuite))
+}
+
+func (s *AstronomySuite) TestCarbon_SunTimes() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Nil(c.SunTimes(39.9042, 116.4074))
+	})
+
+	s.Run("zero carbon", func() {
+		st := NewCarbon().SunTimes(39.9042, 116.4074)
+		s.Nil(st.Error)
+		s.Nil(st.Sunrise)
+		s.Nil(st.SolarNoon)
+	})
+
+	s.Run("empty carbon", func() {
+		st := Parse("").SunTimes(39.9042, 116.4074)
+		s.Nil(st.Error)
+		s.Nil(st.Sunrise)
+		s.Nil(st.SolarNoon)
+	})
+
+	s.Run("error carbon", func() {
+		st := Parse("xxx").SunTimes(39.9042, 116.4074)
+		s.Error(st.Error)
+		s.Nil(st.Sunrise)
+	})
+
+	s.Run("invalid coordinate", func() {
+		s.Equal(ErrInvalidCoordinate(91, 0), Parse("2020-08-05").SunTimes(91, 0).Error)
+		s.Error(Parse("2020-08-05").SunTimes(0, -181).Error)
+		s.Error(Parse("2020-08-05").SunTimes(math.NaN(), 0).Error)
+	})
+
+	s.Run("valid carbon", func() {
+		// Beijing
+		st := Parse("2020-08-05 13:14:15", PRC).SunTimes(39.9042, 116.4074)
+		s.Nil(st.Error)
+		s.Equal("2020-08-05 03:30:17", st.AstronomicalDawn.ToDateTimeString())
+		s.Equal("2020-08-05 04:10:12", st.NauticalDawn.ToDateTimeString())
+		s.Equal("2020-08-05 04:46:49", st.CivilDawn.ToDateTimeString())
+		s.Equal("2020-08-05 05:16:42", st.Sunrise.ToDateTimeString())
+		s.Equal("2020-08-05 12:20:22", st.SolarNoon.ToDateTimeString())
+		s.Equal("2020-08-05 19:23:25", st.Sunset.ToDateTimeString())
+		s.Equal("2020-08-05 19:53:12", st.CivilDusk.ToDateTimeString())
+		s.Equal("2020-08-05 20:29:40", st.NauticalDusk.ToDateTimeString())
+		s.Equal("2020-08-05 21:09:19", st.AstronomicalDusk.ToDateTimeString())
+		s.Equal(PRC, st.Sunrise.Timezone())
+
+		// New York
+		st = Parse("2020-08-05", "America/New_York").SunTimes(40.7128, -74.0060)
+		s.Equal("2020-08-05 05:57:03 -0400 EDT", st.Sunrise.ToString())
+		s.Equal("2020-08-05 13:01:58 -0400 EDT", st.SolarNoon.ToString())
+		s.Equal("2020-08-05 20:06:15 -0400 EDT", st.Sunset.ToString())
+
+		// Auckland
+		st = Parse("2020-08-05", "Pacific/Auckland").SunTimes(-36.85, 174.76)
+		s.Equal("2020-08-05 07:15:22 +1200 NZST", st.Sunrise.ToString())
+		s.Equal("2020-08-05 17:38:59 +1200 NZST", st.Sunset.ToString())
+
+		// equator at the march equinox
+		st = Parse("2020-03-20", UTC).SunTimes(0, 0)
+		s.Equal("2020-03-20 06:04:03 +0000 UTC", st.Sunrise.ToString())
+		s.Equal("2020-03-20 12:07:18 +0000 UTC", st.SolarNoon.ToString())
+		s.Equal("2020-03-20 18:10:33 +0000 UTC", st.Sunset.ToString())
+	})
+
+	s.Run("white night", func() {
+		st := Parse("2020-06-21", "Europe/London").SunTimes(51.5074, -0.1278)
+		s.Equal("2020-06-21 04:43:11 +0100 BST", st.Sunrise.ToString())
+		s.Equal("2020-06-21 13:02:25 +0100 BST", st.SolarNoon.ToString())
+		s.Equal("2020-06-21 21:21:38 +0100 BST", st.Sunset.ToString())
+		s.NotNil(st.NauticalDawn)
+		s.NotNil(st.NauticalDusk)
+		s.Nil(st.AstronomicalDawn)
+		s.Nil(st.AstronomicalDusk)
+	})
+
+	s.Run("polar day", func() {
+		st := Parse("2020-06-21", "Europe/Oslo").SunTimes(69.6492, 18.9553)
+		s.Equal("2020-06-21 12:46:05", st.SolarNoon.ToDateTimeString())
+		s.Nil(st.Sunrise)
+		s.Nil(st.Sunset)
+		s.Nil(st.CivilDawn)
+		s.Nil(st.AstronomicalDusk)
+	})
+
+	s.Run("polar night", func() {
+		st := Parse("2020-12-21", "Europe/Oslo").SunTimes(69.6492, 18.9553)
+		s.Equal("2020-12-21 11:42:28", st.SolarNoon.ToDateTimeString())
+		s.Nil(st.Sunrise)
+		s.Nil(st.Sunset)
+		s.Equal("2020-12-21 09:31:28", st.CivilDawn.ToDateTimeString())
+		s.Equal("2020-12-21 13:53:28", st.CivilDusk.ToDateTimeString())
+	})
+
+	s.Run("immutable carbon", func() {
+		c := Parse("2020-08-05 13:14:15", PRC)
+		st := c.SunTimes(39.9042, 116.4074)
+		st.Sunrise.AddDay()
+		s.Equal("2020-08-05 13:14:15", c.ToDateTimeString())
+	})
+}
+
+func (s *AstronomySuite) TestCarbon_MoonPhase() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Zero(c.MoonPhase())
+	})
+
+	s.Run("zero carbon", func() {
+		s.Zero(NewCarbon().MoonPhase())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Zero(Parse("").MoonPhase())
+	})
+
+	s.Run("error carbon", func() {
+		s.Zero(Parse("xxx").MoonPhase())
+	})
+
+	s.Run("valid carbon", func() {
+		// new moon, first quarter, full moon and last quarter of August 2020
+		s.InDelta(0, Parse("2020-08-19 02:42:00", UTC).MoonPhase(), 0.001)
+		s.InDelta(0.25, Parse("2020-08-25 17:58:00", UTC).MoonPhase(), 0.001)
+		s.InDelta(0.5, Parse("2020-08-03 15:59:00", UTC).MoonPhase(), 0.001)
+		s.InDelta(0.75, Parse("2020-08-11 16:45:00", UTC).MoonPhase(), 0.001)
+		s.InDelta(0.5435, Parse("2020-08-05 08:00:00", PRC).MoonPhase(), 0.0001)
+	})
+}
+
+func (s *AstronomySuite) TestCarbon_MoonIllumination() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Zero(c.MoonIllumination())
+	})
+
+	s.Run("zero carbon", func() {
+		s.Zero(NewCarbon().MoonIllumination())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Zero(Parse("").MoonIllumination())
+	})
+
+	s.Run("error carbon", func() {
+		s.Zero(Parse("xxx").MoonIllumination())
+	})
+
+	s.Run("valid carbon", func() {
+		s.InDelta(0, Parse("2020-08-19 02:42:00", UTC).MoonIllumination(), 0.001)
+		s.InDelta(0.5, Parse("2020-08-25 17:58:00", UTC).MoonIllumination(), 0.005)
+		s.InDelta(1, Parse("2020-08-03 15:59:00", UTC).MoonIllumination(), 0.001)
+		s.InDelta(0.5, Parse("2020-08-11 16:45:00", UTC).MoonIllumination(), 0.005)
+		s.InDelta(0.9814, Parse("2020-08-05 08:00:00", PRC).MoonIllumination(), 0.0001)
+	})
+}
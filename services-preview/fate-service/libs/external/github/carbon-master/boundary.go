@@ -110,6 +110,22 @@ func (c *Carbon) EndOfWeek() *Carbon {
 	return c.Copy().AddDays(int(DaysPerWeek-dayOfWeek+weekEndsAt) % DaysPerWeek).EndOfDay()
 }
 
+// StartOfISOWeek returns a Carbon instance for start of the ISO 8601 week, which always starts on Monday.
+func (c *Carbon) StartOfISOWeek() *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	return c.Copy().SubDays(c.ISOWeekday() - 1).StartOfDay()
+}
+
+// EndOfISOWeek returns a Carbon instance for end of the ISO 8601 week, which always ends on Sunday.
+func (c *Carbon) EndOfISOWeek() *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	return c.Copy().AddDays(DaysPerWeek - c.ISOWeekday()).EndOfDay()
+}
+
 // StartOfDay returns a Carbon instance for start of the day.
 func (c *Carbon) StartOfDay() *Carbon {
 	if c.IsInvalid() {
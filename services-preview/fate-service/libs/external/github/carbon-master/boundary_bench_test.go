@@ -414,6 +414,74 @@ func BenchmarkCarbon_EndOfWeek(b *testing.B) {
 	})
 }
 
+func BenchmarkCarbon_StartOfISOWeek(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.StartOfISOWeek()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.StartOfISOWeek()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.StartOfISOWeek()
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_EndOfISOWeek(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.EndOfISOWeek()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.EndOfISOWeek()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.EndOfISOWeek()
+			}
+		})
+	})
+}
+
 func BenchmarkCarbon_StartOfDay(b *testing.B) {
 	b.Run("sequential", func(b *testing.B) {
 		c := Now()
@@ -162,6 +162,24 @@ func ExampleCarbon_EndOfWeek() {
 	// 2021-01-03 23:59:59.999999999 +0000 UTC
 }
 
+func ExampleCarbon_StartOfISOWeek() {
+	fmt.Println(carbon.Parse("2020-01-01 00:00:00").StartOfISOWeek().ToString())
+	fmt.Println(carbon.Parse("2020-08-16 12:30:30").SetWeekStartsAt(carbon.Sunday).StartOfISOWeek().ToString())
+
+	// Output:
+	// 2019-12-30 00:00:00 +0000 UTC
+	// 2020-08-10 00:00:00 +0000 UTC
+}
+
+func ExampleCarbon_EndOfISOWeek() {
+	fmt.Println(carbon.Parse("2020-01-01 00:00:00").EndOfISOWeek().ToString())
+	fmt.Println(carbon.Parse("2020-08-16 12:30:30").SetWeekStartsAt(carbon.Sunday).EndOfISOWeek().ToString())
+
+	// Output:
+	// 2020-01-05 23:59:59.999999999 +0000 UTC
+	// 2020-08-16 23:59:59.999999999 +0000 UTC
+}
+
 func ExampleCarbon_StartOfDay() {
 	fmt.Println(carbon.NewCarbon().StartOfDay().ToString())
 	fmt.Println(carbon.Parse("2020-01-01 00:00:00").StartOfDay().ToString())
@@ -424,6 +424,76 @@ func (s *BoundarySuite) TestCarbon_EndOfWeek() {
 	})
 }
 
+func (s *BoundarySuite) TestCarbon_StartOfISOWeek() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		c = c.StartOfISOWeek()
+		s.False(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("zero carbon", func() {
+		c := NewCarbon().StartOfISOWeek()
+		s.False(c.HasError())
+		s.Equal("0001-01-01 00:00:00 +0000 UTC", c.ToString())
+	})
+
+	s.Run("empty carbon", func() {
+		c := Parse("").StartOfISOWeek()
+		s.False(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("error carbon", func() {
+		c := Parse("xxx").StartOfISOWeek()
+		s.True(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("2019-12-30 00:00:00 +0000 UTC", Parse("2020-01-01 00:00:00").StartOfISOWeek().ToString())
+		s.Equal("2020-08-10 00:00:00 +0000 UTC", Parse("2020-08-16 12:30:30").StartOfISOWeek().ToString())
+		s.Equal("2020-08-10 00:00:00 +0000 UTC", Parse("2020-08-16 12:30:30").SetWeekStartsAt(Sunday).StartOfISOWeek().ToString())
+		s.Equal("2025-04-07 00:00:00 +0000 UTC", Parse("2025-04-07 00:00:00").StartOfISOWeek().ToString())
+	})
+}
+
+func (s *BoundarySuite) TestCarbon_EndOfISOWeek() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		c = c.EndOfISOWeek()
+		s.False(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("zero carbon", func() {
+		c := NewCarbon().EndOfISOWeek()
+		s.False(c.HasError())
+		s.Equal("0001-01-07 23:59:59.999999999 +0000 UTC", c.ToString())
+	})
+
+	s.Run("empty carbon", func() {
+		c := Parse("").EndOfISOWeek()
+		s.False(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("error carbon", func() {
+		c := Parse("xxx").EndOfISOWeek()
+		s.True(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("2020-01-05 23:59:59.999999999 +0000 UTC", Parse("2020-01-01 00:00:00").EndOfISOWeek().ToString())
+		s.Equal("2020-08-16 23:59:59.999999999 +0000 UTC", Parse("2020-08-16 12:30:30").EndOfISOWeek().ToString())
+		s.Equal("2020-08-16 23:59:59.999999999 +0000 UTC", Parse("2020-08-16 12:30:30").SetWeekStartsAt(Sunday).EndOfISOWeek().ToString())
+		s.Equal("2021-01-03 23:59:59.999999999 +0000 UTC", Parse("2020-12-31 23:59:59").EndOfISOWeek().ToString())
+	})
+}
+
 func (s *BoundarySuite) TestCarbon_StartOfDay() {
 	s.Run("nil carbon", func() {
 		var c *Carbon
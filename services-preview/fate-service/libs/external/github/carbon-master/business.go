@@ -0,0 +1,146 @@
+package carbon
+
+// HolidayProvider defines a HolidayProvider interface which provides holidays and make-up workdays.
+type HolidayProvider interface {
+	// IsHoliday reports whether the date is a holiday.
+	IsHoliday(c *Carbon) bool
+	// IsWorkday reports whether the date is a make-up workday, which is a workday even if it is weekend.
+	IsWorkday(c *Carbon) bool
+}
+
+// BusinessCalendar defines a BusinessCalendar struct.
+type BusinessCalendar struct {
+	providers []HolidayProvider
+}
+
+// NewBusinessCalendar returns a new BusinessCalendar instance, a business day is neither weekend nor holiday
+// unless it is a make-up workday, weekend days follow the Carbon instance and holidays follow the providers.
+func NewBusinessCalendar(providers ...HolidayProvider) *BusinessCalendar {
+	bc := &BusinessCalendar{providers: make([]HolidayProvider, 0, len(providers))}
+	for _, p := range providers {
+		if p != nil {
+			bc.providers = append(bc.providers, p)
+		}
+	}
+	return bc
+}
+
+// IsBusinessDay reports whether it is a business day.
+func (bc *BusinessCalendar) IsBusinessDay(c *Carbon) bool {
+	if c.IsInvalid() {
+		return false
+	}
+	if bc.isHoliday(c) {
+		return false
+	}
+	if bc.isWorkday(c) {
+		return true
+	}
+	return !c.IsWeekend()
+}
+
+// AddBusinessDays adds some business days, the time of day is kept.
+func (bc *BusinessCalendar) AddBusinessDays(c *Carbon, days int) *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	step := 1
+	if days < 0 {
+		days, step = -days, -1
+	}
+	result := c.Copy()
+	for i := 0; i < days; i++ {
+		if !bc.move(result, step) {
+			result.Error = ErrNoBusinessDay(c)
+			return result
+		}
+	}
+	return result
+}
+
+// AddBusinessDay adds one business day.
+func (bc *BusinessCalendar) AddBusinessDay(c *Carbon) *Carbon {
+	return bc.AddBusinessDays(c, 1)
+}
+
+// SubBusinessDays subtracts some business days, the time of day is kept.
+func (bc *BusinessCalendar) SubBusinessDays(c *Carbon, days int) *Carbon {
+	return bc.AddBusinessDays(c, -days)
+}
+
+// SubBusinessDay subtracts one business day.
+func (bc *BusinessCalendar) SubBusinessDay(c *Carbon) *Carbon {
+	return bc.SubBusinessDays(c, 1)
+}
+
+// NextBusinessDay returns the first business day after the given date.
+func (bc *BusinessCalendar) NextBusinessDay(c *Carbon) *Carbon {
+	return bc.AddBusinessDays(c, 1)
+}
+
+// PrevBusinessDay returns the last business day before the given date.
+func (bc *BusinessCalendar) PrevBusinessDay(c *Carbon) *Carbon {
+	return bc.SubBusinessDays(c, 1)
+}
+
+// DiffInBusinessDays gets the difference in business days, which is the number of business days passed
+// from start to end, the start date is excluded and the end date is included, and vice versa if end is before start.
+func (bc *BusinessCalendar) DiffInBusinessDays(start, end *Carbon) int64 {
+	if start.IsInvalid() || end.IsInvalid() {
+		return 0
+	}
+	// noon is used to avoid the date being changed by daylight saving time
+	from := start.Copy().SetTime(12, 0, 0)
+	year, month, day := end.StdTime().In(start.StdTime().Location()).Date()
+	to := from.Copy().SetDate(year, int(month), day)
+	step, sign := 1, int64(1)
+	if from.Gt(to) {
+		step, sign = -1, -1
+	}
+	var days int64
+	for !from.Eq(to) {
+		from.time = from.StdTime().AddDate(0, 0, step)
+		if bc.IsBusinessDay(from) {
+			days++
+		}
+	}
+	return days * sign
+}
+
+// move moves the Carbon instance to the next business day in the direction of step,
+// it gives up if there isn't any business day within a year.
+func (bc *BusinessCalendar) move(c *Carbon, step int) bool {
+	for i := 0; i < DaysPerLeapYear; i++ {
+		c.time = c.StdTime().AddDate(0, 0, step)
+		if bc.IsBusinessDay(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// isHoliday reports whether any provider regards the date as a holiday.
+func (bc *BusinessCalendar) isHoliday(c *Carbon) bool {
+	if bc == nil {
+		return false
+	}
+	for _, p := range bc.providers {
+		if p.IsHoliday(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWorkday reports whether any provider regards the date as a make-up workday.
+func (bc *BusinessCalendar) isWorkday(c *Carbon) bool {
+	if bc == nil {
+		return false
+	}
+	for _, p := range bc.providers {
+		if p.IsWorkday(c) {
+			return true
+		}
+	}
+	return false
+}
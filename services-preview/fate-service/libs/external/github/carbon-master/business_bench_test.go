@@ -0,0 +1,108 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+)
+
+func BenchmarkBusinessCalendar_IsBusinessDay(b *testing.B) {
+	bc := NewBusinessCalendar(testHolidayProvider{})
+	c := Parse("2020-10-01")
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			bc.IsBusinessDay(c)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				bc.IsBusinessDay(c)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				bc.IsBusinessDay(c)
+			}
+		})
+	})
+}
+
+func BenchmarkBusinessCalendar_AddBusinessDays(b *testing.B) {
+	bc := NewBusinessCalendar(testHolidayProvider{})
+	c := Parse("2020-09-30")
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			bc.AddBusinessDays(c, 10)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				bc.AddBusinessDays(c, 10)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				bc.AddBusinessDays(c, 10)
+			}
+		})
+	})
+}
+
+func BenchmarkBusinessCalendar_DiffInBusinessDays(b *testing.B) {
+	bc := NewBusinessCalendar(testHolidayProvider{})
+	start, end := Parse("2020-09-30"), Parse("2020-10-30")
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			bc.DiffInBusinessDays(start, end)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				bc.DiffInBusinessDays(start, end)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				bc.DiffInBusinessDays(start, end)
+			}
+		})
+	})
+}
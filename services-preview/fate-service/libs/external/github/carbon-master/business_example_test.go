@@ -0,0 +1,66 @@
+package carbon_test
+
+import (
+	"fmt"
+
+	"github.com/dromara/carbon/v2"
+	"github.com/dromara/carbon/v2/holiday"
+)
+
+func ExampleBusinessCalendar_IsBusinessDay() {
+	bc := carbon.NewBusinessCalendar(holiday.NewChina())
+	fmt.Println(bc.IsBusinessDay(carbon.Parse("2025-01-24")))
+	fmt.Println(bc.IsBusinessDay(carbon.Parse("2025-01-25")))
+	fmt.Println(bc.IsBusinessDay(carbon.Parse("2025-01-26")))
+	fmt.Println(bc.IsBusinessDay(carbon.Parse("2025-01-28")))
+
+	// Output:
+	// true
+	// false
+	// true
+	// false
+}
+
+func ExampleBusinessCalendar_AddBusinessDays() {
+	bc := carbon.NewBusinessCalendar(holiday.NewChina())
+	fmt.Println(bc.AddBusinessDays(carbon.Parse("2025-01-24 13:14:15"), 3).ToString())
+	fmt.Println(bc.AddBusinessDays(carbon.Parse("2025-01-24 13:14:15"), -3).ToString())
+
+	// Output:
+	// 2025-02-05 13:14:15 +0000 UTC
+	// 2025-01-21 13:14:15 +0000 UTC
+}
+
+func ExampleBusinessCalendar_SubBusinessDays() {
+	bc := carbon.NewBusinessCalendar()
+	fmt.Println(bc.SubBusinessDays(carbon.Parse("2025-01-27 13:14:15"), 1).ToString())
+
+	// Output:
+	// 2025-01-24 13:14:15 +0000 UTC
+}
+
+func ExampleBusinessCalendar_NextBusinessDay() {
+	bc := carbon.NewBusinessCalendar(holiday.NewChina())
+	fmt.Println(bc.NextBusinessDay(carbon.Parse("2025-01-27")).ToDateString())
+
+	// Output:
+	// 2025-02-05
+}
+
+func ExampleBusinessCalendar_PrevBusinessDay() {
+	bc := carbon.NewBusinessCalendar(holiday.NewChina())
+	fmt.Println(bc.PrevBusinessDay(carbon.Parse("2025-02-05")).ToDateString())
+
+	// Output:
+	// 2025-01-27
+}
+
+func ExampleBusinessCalendar_DiffInBusinessDays() {
+	bc := carbon.NewBusinessCalendar(holiday.NewChina())
+	fmt.Println(bc.DiffInBusinessDays(carbon.Parse("2025-01-24"), carbon.Parse("2025-02-08")))
+	fmt.Println(bc.DiffInBusinessDays(carbon.Parse("2025-02-08"), carbon.Parse("2025-01-24")))
+
+	// Output:
+	// 6
+	// -6
+}
@@ -0,0 +1,175 @@
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// testHolidayProvider is the National Day holidays of China in 2020.
+type testHolidayProvider struct{}
+
+func (testHolidayProvider) IsHoliday(c *Carbon) bool {
+	date := c.ToDateString()
+	return date >= "2020-10-01" && date <= "2020-10-08"
+}
+
+func (testHolidayProvider) IsWorkday(c *Carbon) bool {
+	date := c.ToDateString()
+	return date == "2020-09-27" || date == "2020-10-10"
+}
+
+type BusinessSuite struct {
+	suite.Suite
+}
+
+func TestBusinessSuite(t *testing.T) {
+	suite.Run(t, new(BusinessSuite))
+}
+
+func (s *BusinessSuite) TestBusinessCalendar_IsBusinessDay() {
+	bc := NewBusinessCalendar(testHolidayProvider{}, nil)
+
+	s.Run("invalid carbon", func() {
+		s.False(bc.IsBusinessDay(nil))
+		s.False(bc.IsBusinessDay(Parse("")))
+		s.False(bc.IsBusinessDay(Parse("xxx")))
+	})
+
+	s.Run("valid carbon", func() {
+		s.True(bc.IsBusinessDay(Parse("2020-09-30")))
+		s.False(bc.IsBusinessDay(Parse("2020-10-01")))
+		s.False(bc.IsBusinessDay(Parse("2020-10-03")))
+		s.True(bc.IsBusinessDay(Parse("2020-09-27")))
+		s.True(bc.IsBusinessDay(Parse("2020-10-10")))
+		s.False(bc.IsBusinessDay(Parse("2020-10-11")))
+		s.True(bc.IsBusinessDay(Parse("2020-10-12")))
+	})
+
+	s.Run("without provider", func() {
+		s.True(NewBusinessCalendar().IsBusinessDay(Parse("2020-10-01")))
+		s.False(NewBusinessCalendar().IsBusinessDay(Parse("2020-10-10")))
+
+		var nilCalendar *BusinessCalendar
+		s.True(nilCalendar.IsBusinessDay(Parse("2020-10-01")))
+		s.False(nilCalendar.IsBusinessDay(Parse("2020-10-10")))
+	})
+
+	s.Run("customized weekend days", func() {
+		c := Parse("2020-10-09").SetWeekendDays([]Weekday{Friday})
+		s.False(bc.IsBusinessDay(c))
+		s.True(bc.IsBusinessDay(c.AddDay()))
+	})
+}
+
+func (s *BusinessSuite) TestBusinessCalendar_AddBusinessDays() {
+	bc := NewBusinessCalendar(testHolidayProvider{})
+
+	s.Run("invalid carbon", func() {
+		s.True(bc.AddBusinessDays(nil, 1).IsNil())
+		s.True(bc.AddBusinessDays(Parse(""), 1).IsEmpty())
+		s.Error(bc.AddBusinessDays(Parse("xxx"), 1).Error)
+	})
+
+	s.Run("valid carbon", func() {
+		c := Parse("2020-09-30 13:14:15")
+		s.Equal("2020-09-30 13:14:15", bc.AddBusinessDays(c, 0).ToDateTimeString())
+		s.Equal("2020-10-09 13:14:15", bc.AddBusinessDays(c, 1).ToDateTimeString())
+		s.Equal("2020-10-10 13:14:15", bc.AddBusinessDays(c, 2).ToDateTimeString())
+		s.Equal("2020-10-12 13:14:15", bc.AddBusinessDays(c, 3).ToDateTimeString())
+		s.Equal("2020-09-29 13:14:15", bc.AddBusinessDays(c, -1).ToDateTimeString())
+		s.Equal("2020-09-27 13:14:15", bc.AddBusinessDays(c, -3).ToDateTimeString())
+		s.Equal("2020-09-25 13:14:15", bc.AddBusinessDays(c, -4).ToDateTimeString())
+		s.Equal("2020-10-10 13:14:15", bc.AddBusinessDay(c.AddDays(9)).ToDateTimeString())
+		s.Equal("2020-09-30 13:14:15", c.ToDateTimeString())
+	})
+
+	s.Run("no business day", func() {
+		c := Parse("2020-08-05").SetWeekendDays([]Weekday{Sunday, Monday, Tuesday, Wednesday, Thursday, Friday, Saturday})
+		s.Error(NewBusinessCalendar().AddBusinessDays(c, 1).Error)
+		s.Error(NewBusinessCalendar().SubBusinessDays(c, 1).Error)
+		s.Equal("2020-09-27", bc.AddBusinessDays(c, 1).ToDateString())
+		s.Nil(c.Error)
+	})
+}
+
+func (s *BusinessSuite) TestBusinessCalendar_SubBusinessDays() {
+	bc := NewBusinessCalendar(testHolidayProvider{})
+
+	s.Run("invalid carbon", func() {
+		s.True(bc.SubBusinessDays(nil, 1).IsNil())
+		s.True(bc.SubBusinessDays(Parse(""), 1).IsEmpty())
+		s.Error(bc.SubBusinessDays(Parse("xxx"), 1).Error)
+	})
+
+	s.Run("valid carbon", func() {
+		c := Parse("2020-10-12")
+		s.Equal("2020-10-10", bc.SubBusinessDays(c, 1).ToDateString())
+		s.Equal("2020-09-30", bc.SubBusinessDays(c, 3).ToDateString())
+		s.Equal("2020-10-14", bc.SubBusinessDays(c, -2).ToDateString())
+		s.Equal("2020-10-09", bc.SubBusinessDay(Parse("2020-10-10")).ToDateString())
+	})
+}
+
+func (s *BusinessSuite) TestBusinessCalendar_NextBusinessDay() {
+	bc := NewBusinessCalendar(testHolidayProvider{})
+
+	s.Run("invalid carbon", func() {
+		s.True(bc.NextBusinessDay(nil).IsNil())
+		s.Error(bc.NextBusinessDay(Parse("xxx")).Error)
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("2020-09-27", bc.NextBusinessDay(Parse("2020-09-25")).ToDateString())
+		s.Equal("2020-10-09", bc.NextBusinessDay(Parse("2020-09-30")).ToDateString())
+		s.Equal("2020-10-09", bc.NextBusinessDay(Parse("2020-10-05")).ToDateString())
+		s.Equal("2020-10-12", bc.NextBusinessDay(Parse("2020-10-10")).ToDateString())
+	})
+}
+
+func (s *BusinessSuite) TestBusinessCalendar_PrevBusinessDay() {
+	bc := NewBusinessCalendar(testHolidayProvider{})
+
+	s.Run("invalid carbon", func() {
+		s.True(bc.PrevBusinessDay(nil).IsNil())
+		s.Error(bc.PrevBusinessDay(Parse("xxx")).Error)
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("2020-09-25", bc.PrevBusinessDay(Parse("2020-09-27")).ToDateString())
+		s.Equal("2020-09-30", bc.PrevBusinessDay(Parse("2020-10-05")).ToDateString())
+		s.Equal("2020-10-10", bc.PrevBusinessDay(Parse("2020-10-11")).ToDateString())
+	})
+}
+
+func (s *BusinessSuite) TestBusinessCalendar_DiffInBusinessDays() {
+	bc := NewBusinessCalendar(testHolidayProvider{})
+
+	s.Run("invalid carbon", func() {
+		s.Zero(bc.DiffInBusinessDays(nil, Parse("2020-10-12")))
+		s.Zero(bc.DiffInBusinessDays(Parse("2020-10-12"), Parse("")))
+		s.Zero(bc.DiffInBusinessDays(Parse("xxx"), Parse("2020-10-12")))
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal(int64(0), bc.DiffInBusinessDays(Parse("2020-09-30"), Parse("2020-09-30 23:59:59")))
+		s.Equal(int64(3), bc.DiffInBusinessDays(Parse("2020-09-30"), Parse("2020-10-12")))
+		s.Equal(int64(-3), bc.DiffInBusinessDays(Parse("2020-10-12"), Parse("2020-09-30")))
+		s.Equal(int64(1), bc.DiffInBusinessDays(Parse("2020-10-03"), Parse("2020-10-09")))
+		s.Equal(int64(0), bc.DiffInBusinessDays(Parse("2020-10-09"), Parse("2020-10-03")))
+		s.Equal(int64(-1), bc.DiffInBusinessDays(Parse("2020-10-09"), Parse("2020-09-30")))
+	})
+
+	s.Run("different timezones", func() {
+		start := Parse("2020-09-30", PRC)
+		s.Equal(int64(3), bc.DiffInBusinessDays(start, Parse("2020-10-11 20:00:00", UTC)))
+		s.Equal(int64(2), bc.DiffInBusinessDays(start, Parse("2020-10-11 15:00:00", UTC)))
+	})
+
+	s.Run("inverse of add business days", func() {
+		c := Parse("2020-09-26 13:14:15")
+		for days := -20; days <= 20; days++ {
+			s.Equal(int64(days), bc.DiffInBusinessDays(c, bc.AddBusinessDays(c, days)))
+		}
+	})
+}
@@ -1,14 +1,21 @@
 package carbon
 
 import (
+	"strings"
+	"time"
+
+	"github.com/dromara/carbon/v2/calendar"
+	"github.com/dromara/carbon/v2/calendar/coptic"
+	"github.com/dromara/carbon/v2/calendar/ethiopian"
 	"github.com/dromara/carbon/v2/calendar/hebrew"
+	"github.com/dromara/carbon/v2/calendar/hijri"
 	"github.com/dromara/carbon/v2/calendar/julian"
 	"github.com/dromara/carbon/v2/calendar/lunar"
 	"github.com/dromara/carbon/v2/calendar/persian"
 )
 
-// Lunar converts Carbon instance to Lunar instance.
-func (c *Carbon) Lunar() *lunar.Lunar {
+// Lunar converts Carbon instance to Lunar instance, meridian is lunar.ChinaMeridian by default.
+func (c *Carbon) Lunar(meridian ...lunar.Meridian) *lunar.Lunar {
 	if c.IsNil() {
 		return nil
 	}
@@ -18,18 +25,50 @@ func (c *Carbon) Lunar() *lunar.Lunar {
 	if c.HasError() {
 		return &lunar.Lunar{Error: c.Error}
 	}
-	return lunar.FromStdTime(c.StdTime())
+	return lunar.FromStdTime(c.StdTime(), meridian...)
 }
 
-// CreateFromLunar creates a Carbon instance from Lunar date.
-func CreateFromLunar(year, month, day int, isLeapMonth bool) *Carbon {
-	l := lunar.NewLunar(year, month, day, isLeapMonth)
+// CreateFromLunar creates a Carbon instance from Lunar date, meridian is lunar.ChinaMeridian by default.
+func CreateFromLunar(year, month, day int, isLeapMonth bool, meridian ...lunar.Meridian) *Carbon {
+	l := lunar.NewLunar(year, month, day, isLeapMonth, meridian...)
 	if l.Error != nil {
 		return &Carbon{Error: l.Error}
 	}
 	return NewCarbon(l.ToGregorian(DefaultTimezone).Time)
 }
 
+// ToLunarMonthString outputs a string in lunar month format like "正月", meridian is lunar.ChinaMeridian by default,
+// i18n is supported by the "lunar_months" and "lunar_leap_month" resources, or chinese is used.
+func (c *Carbon) ToLunarMonthString(meridian ...lunar.Meridian) string {
+	if c.IsInvalid() {
+		return ""
+	}
+	l := c.Lunar(meridian...)
+	if !l.IsValid() {
+		return ""
+	}
+
+	lang := c.lang
+	if lang == nil {
+		return l.ToMonthString()
+	}
+
+	lang.rw.RLock()
+	defer lang.rw.RUnlock()
+
+	if resources, ok := lang.resources["lunar_months"]; ok {
+		slice := strings.Split(resources, "|")
+		if len(slice) == MonthsPerYear {
+			month := slice[l.Month()-1]
+			if format := lang.resources["lunar_leap_month"]; l.IsLeapMonth() && format != "" {
+				return strings.Replace(format, "%s", month, 1)
+			}
+			return month
+		}
+	}
+	return l.ToMonthString()
+}
+
 // Julian converts Carbon instance to Julian instance.
 func (c *Carbon) Julian() *julian.Julian {
 	if c.IsNil() {
@@ -94,3 +133,104 @@ func CreateFromHebrew(year, month, day int) *Carbon {
 	}
 	return NewCarbon(h.ToGregorian(DefaultTimezone).Time)
 }
+
+// Hijri converts Carbon instance to Hijri instance, variant is hijri.Tabular by default.
+func (c *Carbon) Hijri(variant ...hijri.Variant) *hijri.Hijri {
+	if c.IsNil() {
+		return nil
+	}
+	if c.IsZero() || c.IsEmpty() {
+		return &hijri.Hijri{}
+	}
+	if c.HasError() {
+		return &hijri.Hijri{Error: c.Error}
+	}
+	return hijri.FromStdTime(c.StdTime(), variant...)
+}
+
+// CreateFromHijri creates a Carbon instance from Hijri date, variant is hijri.Tabular by default.
+func CreateFromHijri(year, month, day int, variant ...hijri.Variant) *Carbon {
+	h := hijri.NewHijri(year, month, day, variant...)
+	if h.Error != nil {
+		return &Carbon{Error: h.Error}
+	}
+	return NewCarbon(h.ToGregorian(DefaultTimezone).Time)
+}
+
+// Ethiopian converts Carbon instance to Ethiopian instance, era is ethiopian.AmeteMihret by default.
+func (c *Carbon) Ethiopian(era ...ethiopian.Era) *ethiopian.Ethiopian {
+	if c.IsNil() {
+		return nil
+	}
+	if c.IsZero() || c.IsEmpty() {
+		return &ethiopian.Ethiopian{}
+	}
+	if c.HasError() {
+		return &ethiopian.Ethiopian{Error: c.Error}
+	}
+	return ethiopian.FromStdTime(c.StdTime(), era...)
+}
+
+// CreateFromEthiopian creates a Carbon instance from Ethiopian date, era is ethiopian.AmeteMihret by default.
+func CreateFromEthiopian(year, month, day int, era ...ethiopian.Era) *Carbon {
+	e := ethiopian.NewEthiopian(year, month, day, era...)
+	if e.Error != nil {
+		return &Carbon{Error: e.Error}
+	}
+	return NewCarbon(e.ToGregorian(DefaultTimezone).Time)
+}
+
+// Coptic converts Carbon instance to Coptic instance.
+func (c *Carbon) Coptic() *coptic.Coptic {
+	if c.IsNil() {
+		return nil
+	}
+	if c.IsZero() || c.IsEmpty() {
+		return &coptic.Coptic{}
+	}
+	if c.HasError() {
+		return &coptic.Coptic{Error: c.Error}
+	}
+	return coptic.FromStdTime(c.StdTime())
+}
+
+// CreateFromCoptic creates a Carbon instance from Coptic date.
+func CreateFromCoptic(year, month, day int) *Carbon {
+	c := coptic.NewCoptic(year, month, day)
+	if c.Error != nil {
+		return &Carbon{Error: c.Error}
+	}
+	return NewCarbon(c.ToGregorian(DefaultTimezone).Time)
+}
+
+// ToCalendar converts Carbon instance to the calendar registered by the name like "lunar", "persian", "hebrew",
+// "hijri", "ethiopian" and "coptic", see calendar.Names for all registered calendars.
+func (c *Carbon) ToCalendar(name string) (calendar.Calendar, error) {
+	if c.IsNil() {
+		return nil, ErrNilCarbon()
+	}
+	if c.HasError() {
+		return nil, c.Error
+	}
+	converter, _, ok := calendar.Lookup(name)
+	if !ok {
+		return nil, ErrNotExistCalendar(name)
+	}
+	if c.IsZero() || c.IsEmpty() {
+		return converter(time.Time{}), nil
+	}
+	return converter(c.StdTime()), nil
+}
+
+// CreateFromCalendar creates a Carbon instance from the date of the calendar registered by the name.
+func CreateFromCalendar(name string, year, month, day int) *Carbon {
+	_, creator, ok := calendar.Lookup(name)
+	if !ok {
+		return &Carbon{Error: ErrNotExistCalendar(name)}
+	}
+	cal, err := creator(year, month, day)
+	if err != nil {
+		return &Carbon{Error: err}
+	}
+	return NewCarbon(cal.ToGregorian(DefaultTimezone).Time)
+}
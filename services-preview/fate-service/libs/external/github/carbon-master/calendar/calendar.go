@@ -0,0 +1,84 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Calendar defines the common behaviour of alternate calendars like lunar, persian, hebrew and hijri.
+type Calendar interface {
+	// Year returns the year of the date.
+	Year() int
+	// Month returns the month of the date.
+	Month() int
+	// Day returns the day of the date.
+	Day() int
+	// String outputs the date in "YYYY-MM-DD" format.
+	String() string
+	// IsValid reports whether the date is valid.
+	IsValid() bool
+	// IsLeapYear reports whether the year is a leap year.
+	IsLeapYear() bool
+	// MonthName outputs the month name in the given locale, the calendar's default locale is used if locale is empty.
+	MonthName(locale string) string
+	// WeekName outputs the weekday name in the given locale, the calendar's default locale is used if locale is empty.
+	WeekName(locale string) string
+	// ToGregorian converts the date to Gregorian instance.
+	ToGregorian(timezone ...string) *Gregorian
+}
+
+// Converter converts a standard time.Time to a Calendar instance,
+// it must return an empty but non-nil Calendar instance for zero time.
+type Converter func(t time.Time) Calendar
+
+// Creator creates a Calendar instance from the given year, month and day.
+type Creator func(year, month, day int) (Calendar, error)
+
+type registration struct {
+	converter Converter
+	creator   Creator
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]registration)
+)
+
+// Register makes a calendar available by the provided name.
+// If Register is called twice with the same name or if converter or creator is nil, it panics.
+func Register(name string, converter Converter, creator Creator) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if name == "" {
+		panic("calendar: register calendar with empty name")
+	}
+	if converter == nil || creator == nil {
+		panic(fmt.Sprintf("calendar: register calendar %q with nil converter or creator", name))
+	}
+	if _, dup := registry[name]; dup {
+		panic(fmt.Sprintf("calendar: register calendar %q twice", name))
+	}
+	registry[name] = registration{converter: converter, creator: creator}
+}
+
+// Lookup returns the converter and creator of the calendar registered by the provided name.
+func Lookup(name string) (converter Converter, creator Creator, ok bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	r, ok := registry[name]
+	return r.converter, r.creator, ok
+}
+
+// Names returns a sorted list of the names of the registered calendars.
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
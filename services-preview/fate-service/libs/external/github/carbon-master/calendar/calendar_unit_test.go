@@ -0,0 +1,91 @@
+package calendar
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCalendar struct {
+	year, month, day int
+}
+
+func (c *testCalendar) Year() int                      { return c.year }
+func (c *testCalendar) Month() int                     { return c.month }
+func (c *testCalendar) Day() int                       { return c.day }
+func (c *testCalendar) String() string                 { return fmt.Sprintf("%04d-%02d-%02d", c.year, c.month, c.day) }
+func (c *testCalendar) IsValid() bool                  { return c.year > 0 }
+func (c *testCalendar) IsLeapYear() bool               { return false }
+func (c *testCalendar) MonthName(locale string) string { return "" }
+func (c *testCalendar) WeekName(locale string) string  { return "" }
+func (c *testCalendar) ToGregorian(timezone ...string) *Gregorian {
+	return &Gregorian{Time: time.Date(c.year, time.Month(c.month), c.day, 0, 0, 0, 0, time.UTC)}
+}
+
+func testConverter(t time.Time) Calendar {
+	return &testCalendar{year: t.Year(), month: int(t.Month()), day: t.Day()}
+}
+
+func testCreator(year, month, day int) (Calendar, error) {
+	return &testCalendar{year: year, month: month, day: day}, nil
+}
+
+func TestRegister(t *testing.T) {
+	t.Run("empty name", func(t *testing.T) {
+		assert.Panics(t, func() {
+			Register("", testConverter, testCreator)
+		})
+	})
+
+	t.Run("nil converter or creator", func(t *testing.T) {
+		assert.Panics(t, func() {
+			Register("test-nil", nil, testCreator)
+		})
+		assert.Panics(t, func() {
+			Register("test-nil", testConverter, nil)
+		})
+		_, _, ok := Lookup("test-nil")
+		assert.False(t, ok)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			Register("test-duplicate", testConverter, testCreator)
+		})
+		assert.Panics(t, func() {
+			Register("test-duplicate", testConverter, testCreator)
+		})
+	})
+}
+
+func TestLookup(t *testing.T) {
+	Register("test-lookup", testConverter, testCreator)
+
+	t.Run("not exist name", func(t *testing.T) {
+		converter, creator, ok := Lookup("xxx")
+		assert.False(t, ok)
+		assert.Nil(t, converter)
+		assert.Nil(t, creator)
+	})
+
+	t.Run("exist name", func(t *testing.T) {
+		converter, creator, ok := Lookup("test-lookup")
+		assert.True(t, ok)
+		assert.Equal(t, "2020-08-05", converter(time.Date(2020, 8, 5, 0, 0, 0, 0, time.UTC)).String())
+
+		c, err := creator(2020, 8, 5)
+		assert.Nil(t, err)
+		assert.Equal(t, "2020-08-05 00:00:00 +0000 UTC", c.ToGregorian().String())
+	})
+}
+
+func TestNames(t *testing.T) {
+	Register("test-names-b", testConverter, testCreator)
+	Register("test-names-a", testConverter, testCreator)
+
+	names := Names()
+	assert.Subset(t, names, []string{"test-names-a", "test-names-b"})
+	assert.IsNonDecreasing(t, names)
+}
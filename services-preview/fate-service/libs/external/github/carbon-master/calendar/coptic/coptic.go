@@ -0,0 +1,267 @@
+// Package coptic is part of the carbon package.
+package coptic
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dromara/carbon/v2/calendar"
+)
+
+type Locale string
+
+const (
+	EnLocale      Locale = "en"
+	ArLocale      Locale = "ar"
+	defaultLocale        = EnLocale
+
+	// the julian day number of 1 Thout 1 in the Era of Martyrs, which is 284-08-29 in the julian calendar
+	copticEpoch = 1825030
+
+	maxYear = 9999
+)
+
+var (
+	EnMonths = []string{"Thout", "Paopi", "Hathor", "Koiak", "Tobi", "Meshir", "Paremhat", "Parmouti", "Pashons", "Paoni", "Epip", "Mesori", "Pi Kogi Enavot"}
+	ArMonths = []string{"توت", "بابه", "هاتور", "كيهك", "طوبة", "أمشير", "برمهات", "برمودة", "بشنس", "بؤونة", "أبيب", "مسرى", "نسيء"}
+
+	EnWeeks = []string{"Tkyriaki", "Pesnau", "Pshoment", "Peftoou", "Ptiou", "Psoou", "Psabbaton"}
+	ArWeeks = []string{"الأحد", "الإثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"}
+)
+
+func init() {
+	calendar.Register("coptic", func(t time.Time) calendar.Calendar {
+		if c := FromStdTime(t); c != nil {
+			return c
+		}
+		return new(Coptic)
+	}, func(year, month, day int) (calendar.Calendar, error) {
+		c := NewCoptic(year, month, day)
+		return c, c.Error
+	})
+}
+
+// Coptic defines a Coptic struct.
+type Coptic struct {
+	year, month, day int
+	Error            error
+}
+
+// NewCoptic returns a new Coptic instance, the year is counted in the Era of Martyrs.
+func NewCoptic(year, month, day int) *Coptic {
+	c := &Coptic{year: year, month: month, day: day}
+	if !c.IsValid() {
+		c.Error = fmt.Errorf("invalid coptic date: %04d-%02d-%02d", year, month, day)
+	}
+	return c
+}
+
+// FromStdTime creates a Coptic instance from standard time.Time.
+func FromStdTime(t time.Time) *Coptic {
+	if t.IsZero() {
+		return nil
+	}
+	c := new(Coptic)
+	c.year, c.month, c.day = jdn2coptic(gregorian2jdn(t.Year(), int(t.Month()), t.Day()))
+	if !c.IsValid() {
+		return new(Coptic)
+	}
+	return c
+}
+
+// ToGregorian converts Coptic instance to Gregorian instance.
+func (c *Coptic) ToGregorian(timezone ...string) *calendar.Gregorian {
+	g := new(calendar.Gregorian)
+	if !c.IsValid() {
+		return g
+	}
+	loc := time.UTC
+	if len(timezone) > 0 {
+		loc, g.Error = time.LoadLocation(timezone[0])
+	}
+	if g.Error != nil {
+		return g
+	}
+	year, month, day := jdn2gregorian(coptic2jdn(c.year, c.month, c.day))
+	g.Time = time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+	return g
+}
+
+// Year gets the Coptic year like 1736.
+func (c *Coptic) Year() int {
+	if !c.IsValid() {
+		return 0
+	}
+	return c.year
+}
+
+// Month gets the Coptic month like 13.
+func (c *Coptic) Month() int {
+	if !c.IsValid() {
+		return 0
+	}
+	return c.month
+}
+
+// Day gets the Coptic day like 5.
+func (c *Coptic) Day() int {
+	if !c.IsValid() {
+		return 0
+	}
+	return c.day
+}
+
+// DaysInMonth gets the number of days in the Coptic month like 30.
+func (c *Coptic) DaysInMonth() int {
+	if !c.IsValid() {
+		return 0
+	}
+	return getDaysInMonth(c.year, c.month)
+}
+
+// DaysInYear gets the number of days in the Coptic year like 366.
+func (c *Coptic) DaysInYear() int {
+	if !c.IsValid() {
+		return 0
+	}
+	if c.IsLeapYear() {
+		return 366
+	}
+	return 365
+}
+
+// String implements the "Stringer" interface for Coptic.
+func (c *Coptic) String() string {
+	if !c.IsValid() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", c.year, c.month, c.day)
+}
+
+// ToMonthString outputs a string in Coptic month format like "توت".
+func (c *Coptic) ToMonthString(locale ...Locale) (month string) {
+	if !c.IsValid() {
+		return ""
+	}
+	loc := defaultLocale
+	if len(locale) > 0 {
+		loc = locale[0]
+	}
+	switch loc {
+	case EnLocale:
+		return EnMonths[c.month-1]
+	case ArLocale:
+		return ArMonths[c.month-1]
+	}
+	return ""
+}
+
+// ToWeekString outputs a string in week layout like "الأحد".
+func (c *Coptic) ToWeekString(locale ...Locale) (week string) {
+	if !c.IsValid() {
+		return ""
+	}
+	loc := defaultLocale
+	if len(locale) > 0 {
+		loc = locale[0]
+	}
+	// the julian day number 0 is a Monday
+	index := (coptic2jdn(c.year, c.month, c.day) + 1) % 7
+	switch loc {
+	case EnLocale:
+		return EnWeeks[index]
+	case ArLocale:
+		return ArWeeks[index]
+	}
+	return ""
+}
+
+// MonthName implements the calendar.Calendar interface, it outputs the month name in the given locale like "Thout".
+func (c *Coptic) MonthName(locale string) string {
+	if locale == "" {
+		return c.ToMonthString()
+	}
+	return c.ToMonthString(Locale(locale))
+}
+
+// WeekName implements the calendar.Calendar interface, it outputs the week name in the given locale like "Tkyriaki".
+func (c *Coptic) WeekName(locale string) string {
+	if locale == "" {
+		return c.ToWeekString()
+	}
+	return c.ToWeekString(Locale(locale))
+}
+
+// IsValid reports whether the Coptic date is valid.
+func (c *Coptic) IsValid() bool {
+	if c == nil || c.Error != nil {
+		return false
+	}
+	if c.year < 1 || c.year > maxYear || c.month < 1 || c.month > 13 || c.day < 1 {
+		return false
+	}
+	return c.day <= getDaysInMonth(c.year, c.month)
+}
+
+// IsLeapYear reports whether the Coptic year is a leap year whose Pi Kogi Enavot has 6 days.
+func (c *Coptic) IsLeapYear() bool {
+	if !c.IsValid() {
+		return false
+	}
+	return isLeapYear(c.year)
+}
+
+// reports whether the year is a leap year, the year before the one divisible by 4 is a leap year.
+func isLeapYear(year int) bool {
+	return year%4 == 3
+}
+
+// gets the number of days in the month, the 13th month Pi Kogi Enavot has 5 days or 6 days in a leap year.
+func getDaysInMonth(year, month int) int {
+	if month < 13 {
+		return 30
+	}
+	if isLeapYear(year) {
+		return 6
+	}
+	return 5
+}
+
+// coptic2jdn converts a Coptic date to Julian Day Number.
+func coptic2jdn(year, month, day int) int {
+	return copticEpoch - 1 + 365*(year-1) + year/4 + 30*(month-1) + day
+}
+
+// jdn2coptic converts Julian Day Number to Coptic date (year, month, day),
+// the year is less than 1 if the Julian Day Number is before the epoch.
+func jdn2coptic(jdn int) (year, month, day int) {
+	if jdn < copticEpoch {
+		return
+	}
+	year = (4*(jdn-copticEpoch) + 1463) / 1461
+	month = (jdn-coptic2jdn(year, 1, 1))/30 + 1
+	day = jdn - coptic2jdn(year, month, 1) + 1
+	return
+}
+
+// gregorian2jdn converts a proleptic gregorian date to Julian Day Number.
+func gregorian2jdn(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// jdn2gregorian converts Julian Day Number to proleptic gregorian date (year, month, day).
+func jdn2gregorian(jdn int) (year, month, day int) {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+	day = e - (153*m+2)/5 + 1
+	month = m + 3 - 12*(m/10)
+	year = 100*b + d - 4800 + m/10
+	return
+}
@@ -0,0 +1,91 @@
+package coptic
+
+import (
+	"testing"
+	"time"
+)
+
+func BenchmarkFromStdTime(b *testing.B) {
+	testDates := []time.Time{
+		time.Date(2020, 8, 5, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 9, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 9, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FromStdTime(testDates[i%len(testDates)])
+	}
+}
+
+func BenchmarkToGregorian(b *testing.B) {
+	testCopticDates := []*Coptic{
+		NewCoptic(1740, 1, 1),
+		NewCoptic(1740, 4, 28),
+		NewCoptic(1739, 13, 6),
+		NewCoptic(1741, 4, 29),
+		NewCoptic(1741, 5, 11),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c := testCopticDates[i%len(testCopticDates)]
+		c.ToGregorian()
+	}
+}
+
+func BenchmarkIsLeapYear(b *testing.B) {
+	testYears := []int{1735, 1736, 1737, 1738, 1739, 1740, 1741, 1742, 1743, 1744}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		year := testYears[i%len(testYears)]
+		c := NewCoptic(year, 1, 1)
+		c.IsLeapYear()
+	}
+}
+
+func BenchmarkIsValid(b *testing.B) {
+	testDates := []*Coptic{
+		NewCoptic(1739, 13, 6), // 闰年
+		NewCoptic(1740, 13, 6), // 非闰年
+		NewCoptic(1740, 1, 1),
+		NewCoptic(0, 1, 1),     // 无效
+		NewCoptic(10000, 1, 1), // 无效
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c := testDates[i%len(testDates)]
+		c.IsValid()
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	c := NewCoptic(1740, 13, 5)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = c.String()
+	}
+}
+
+func BenchmarkToMonthString(b *testing.B) {
+	c := NewCoptic(1740, 13, 5)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.ToMonthString()
+	}
+}
+
+func BenchmarkToWeekString(b *testing.B) {
+	c := NewCoptic(1740, 13, 5)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.ToWeekString()
+	}
+}
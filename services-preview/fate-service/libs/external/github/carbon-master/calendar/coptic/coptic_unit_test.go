@@ -0,0 +1,273 @@
+package coptic
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dromara/carbon/v2/calendar"
+)
+
+func TestNewCoptic(t *testing.T) {
+	t.Run("valid date", func(t *testing.T) {
+		c := NewCoptic(1740, 13, 5)
+		assert.Nil(t, c.Error)
+		assert.Equal(t, "1740-13-05", c.String())
+	})
+
+	t.Run("invalid year", func(t *testing.T) {
+		assert.Error(t, NewCoptic(0, 1, 1).Error)
+		assert.Error(t, NewCoptic(10000, 1, 1).Error)
+	})
+
+	t.Run("invalid month", func(t *testing.T) {
+		assert.Error(t, NewCoptic(1740, 0, 1).Error)
+		assert.Error(t, NewCoptic(1740, 14, 1).Error)
+	})
+
+	t.Run("invalid day", func(t *testing.T) {
+		assert.Error(t, NewCoptic(1740, 1, 0).Error)
+		assert.Error(t, NewCoptic(1740, 1, 31).Error)
+		// 1740 is not a leap year
+		assert.Error(t, NewCoptic(1740, 13, 6).Error)
+	})
+}
+
+func TestFromStdTime(t *testing.T) {
+	loc, _ := time.LoadLocation("Africa/Cairo")
+
+	t.Run("zero time", func(t *testing.T) {
+		assert.Nil(t, FromStdTime(time.Time{}))
+		assert.Nil(t, FromStdTime(time.Time{}.In(loc)))
+	})
+
+	t.Run("valid time", func(t *testing.T) {
+		assert.Equal(t, "0001-01-01", FromStdTime(time.Date(284, 8, 29, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "1736-11-29", FromStdTime(time.Date(2020, 8, 5, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "1739-13-06", FromStdTime(time.Date(2023, 9, 11, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "1740-01-01", FromStdTime(time.Date(2023, 9, 12, 0, 0, 0, 0, loc)).String())
+	})
+
+	t.Run("before era of martyrs", func(t *testing.T) {
+		c := FromStdTime(time.Date(284, 8, 28, 0, 0, 0, 0, loc))
+		assert.NotNil(t, c)
+		assert.Empty(t, c.String())
+	})
+}
+
+func TestCoptic_ToGregorian(t *testing.T) {
+	t.Run("invalid coptic", func(t *testing.T) {
+		assert.Empty(t, new(Coptic).ToGregorian().String())
+		assert.Empty(t, NewCoptic(0, 1, 1).ToGregorian().String())
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		g := NewCoptic(1740, 1, 1).ToGregorian("xxx")
+		assert.Error(t, g.Error)
+		assert.Empty(t, g.String())
+	})
+
+	t.Run("without timezone", func(t *testing.T) {
+		assert.Equal(t, "2023-09-12 00:00:00 +0000 UTC", NewCoptic(1740, 1, 1).ToGregorian().String())
+		assert.Equal(t, "2025-01-07 00:00:00 +0000 UTC", NewCoptic(1741, 4, 29).ToGregorian().String())
+	})
+
+	t.Run("with timezone", func(t *testing.T) {
+		assert.Equal(t, "2023-09-12 00:00:00 +0300 EEST", NewCoptic(1740, 1, 1).ToGregorian("Africa/Cairo").String())
+		assert.Equal(t, "2025-01-07 00:00:00 +0200 EET", NewCoptic(1741, 4, 29).ToGregorian("Africa/Cairo").String())
+	})
+}
+
+func TestCoptic_Year(t *testing.T) {
+	assert.Zero(t, new(Coptic).Year())
+	assert.Equal(t, 1740, NewCoptic(1740, 1, 1).Year())
+}
+
+func TestCoptic_Month(t *testing.T) {
+	assert.Zero(t, new(Coptic).Month())
+	assert.Equal(t, 13, NewCoptic(1740, 13, 1).Month())
+}
+
+func TestCoptic_Day(t *testing.T) {
+	assert.Zero(t, new(Coptic).Day())
+	assert.Equal(t, 5, NewCoptic(1740, 13, 5).Day())
+}
+
+func TestCoptic_DaysInMonth(t *testing.T) {
+	assert.Zero(t, new(Coptic).DaysInMonth())
+	assert.Equal(t, 30, NewCoptic(1740, 1, 1).DaysInMonth())
+	assert.Equal(t, 30, NewCoptic(1740, 12, 1).DaysInMonth())
+	assert.Equal(t, 5, NewCoptic(1740, 13, 1).DaysInMonth())
+	assert.Equal(t, 6, NewCoptic(1739, 13, 1).DaysInMonth())
+}
+
+func TestCoptic_DaysInYear(t *testing.T) {
+	assert.Zero(t, new(Coptic).DaysInYear())
+	assert.Equal(t, 366, NewCoptic(1739, 1, 1).DaysInYear())
+	assert.Equal(t, 365, NewCoptic(1740, 1, 1).DaysInYear())
+}
+
+func TestCoptic_String(t *testing.T) {
+	assert.Empty(t, new(Coptic).String())
+	assert.Equal(t, "0001-01-01", NewCoptic(1, 1, 1).String())
+	assert.Equal(t, "1740-13-05", NewCoptic(1740, 13, 5).String())
+}
+
+func TestCoptic_ToMonthString(t *testing.T) {
+	t.Run("invalid coptic", func(t *testing.T) {
+		assert.Empty(t, new(Coptic).ToMonthString())
+	})
+
+	t.Run("invalid locale", func(t *testing.T) {
+		assert.Empty(t, NewCoptic(1740, 1, 1).ToMonthString("xxx"))
+	})
+
+	t.Run("valid coptic", func(t *testing.T) {
+		assert.Equal(t, "Thout", NewCoptic(1740, 1, 1).ToMonthString())
+		assert.Equal(t, "Mesori", NewCoptic(1740, 12, 1).ToMonthString(EnLocale))
+		assert.Equal(t, "Pi Kogi Enavot", NewCoptic(1740, 13, 1).ToMonthString(EnLocale))
+		assert.Equal(t, "توت", NewCoptic(1740, 1, 1).ToMonthString(ArLocale))
+		assert.Equal(t, "مسرى", NewCoptic(1740, 12, 1).ToMonthString(ArLocale))
+		assert.Equal(t, "نسيء", NewCoptic(1740, 13, 1).ToMonthString(ArLocale))
+	})
+}
+
+func TestCoptic_ToWeekString(t *testing.T) {
+	t.Run("invalid coptic", func(t *testing.T) {
+		assert.Empty(t, new(Coptic).ToWeekString())
+	})
+
+	t.Run("invalid locale", func(t *testing.T) {
+		assert.Empty(t, NewCoptic(1740, 1, 1).ToWeekString("xxx"))
+	})
+
+	t.Run("valid coptic", func(t *testing.T) {
+		// 2023-09-12 is a Tuesday
+		assert.Equal(t, "Pshoment", NewCoptic(1740, 1, 1).ToWeekString())
+		assert.Equal(t, "الثلاثاء", NewCoptic(1740, 1, 1).ToWeekString(ArLocale))
+		// 284-08-29 in the julian calendar is a Friday
+		assert.Equal(t, "Psoou", NewCoptic(1, 1, 1).ToWeekString(EnLocale))
+		assert.Equal(t, "الجمعة", NewCoptic(1, 1, 1).ToWeekString(ArLocale))
+	})
+}
+
+func TestCoptic_MonthName(t *testing.T) {
+	t.Run("invalid coptic", func(t *testing.T) {
+		assert.Empty(t, new(Coptic).MonthName(""))
+		assert.Empty(t, NewCoptic(1740, 1, 1).MonthName("xxx"))
+	})
+
+	t.Run("valid coptic", func(t *testing.T) {
+		c := NewCoptic(1740, 1, 1)
+		assert.Equal(t, "Thout", c.MonthName(""))
+		assert.Equal(t, "Thout", c.MonthName("en"))
+		assert.Equal(t, "توت", c.MonthName("ar"))
+	})
+}
+
+func TestCoptic_WeekName(t *testing.T) {
+	t.Run("invalid coptic", func(t *testing.T) {
+		assert.Empty(t, new(Coptic).WeekName(""))
+		assert.Empty(t, NewCoptic(1740, 1, 1).WeekName("xxx"))
+	})
+
+	t.Run("valid coptic", func(t *testing.T) {
+		c := NewCoptic(1740, 1, 1)
+		assert.Equal(t, "Pshoment", c.WeekName(""))
+		assert.Equal(t, "Pshoment", c.WeekName("en"))
+		assert.Equal(t, "الثلاثاء", c.WeekName("ar"))
+	})
+}
+
+func TestCoptic_Calendar(t *testing.T) {
+	t.Run("zero time", func(t *testing.T) {
+		converter, _, ok := calendar.Lookup("coptic")
+		assert.True(t, ok)
+		c := converter(time.Time{})
+		assert.NotNil(t, c)
+		assert.False(t, c.IsValid())
+		assert.Empty(t, c.String())
+	})
+
+	t.Run("valid time", func(t *testing.T) {
+		converter, creator, ok := calendar.Lookup("coptic")
+		assert.True(t, ok)
+		assert.Equal(t, "1739-13-06", converter(time.Date(2023, 9, 11, 0, 0, 0, 0, time.UTC)).String())
+
+		_, err := creator(1740, 13, 6)
+		assert.Error(t, err)
+
+		c, err := creator(1740, 1, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, "2023-09-12 00:00:00 +0000 UTC", c.ToGregorian().String())
+	})
+}
+
+func TestCoptic_IsValid(t *testing.T) {
+	assert.False(t, (*Coptic)(nil).IsValid())
+	assert.False(t, new(Coptic).IsValid())
+	assert.False(t, NewCoptic(1740, 14, 1).IsValid())
+	assert.False(t, NewCoptic(1740, 13, 6).IsValid())
+	assert.True(t, NewCoptic(1, 1, 1).IsValid())
+	assert.True(t, NewCoptic(9999, 12, 30).IsValid())
+	assert.True(t, NewCoptic(1739, 13, 6).IsValid())
+}
+
+func TestCoptic_IsLeapYear(t *testing.T) {
+	t.Run("invalid coptic", func(t *testing.T) {
+		assert.False(t, new(Coptic).IsLeapYear())
+		assert.False(t, NewCoptic(0, 1, 1).IsLeapYear())
+	})
+
+	t.Run("valid coptic", func(t *testing.T) {
+		for year := 1735; year <= 1744; year++ {
+			assert.Equal(t, year%4 == 3, NewCoptic(year, 1, 1).IsLeapYear(), "year %d", year)
+		}
+	})
+}
+
+// TestCopticWithAuthorityData validates Coptic calendar conversion using authoritative test data
+func TestCopticWithAuthorityData(t *testing.T) {
+	data, err := os.ReadFile("coptic_test_data.json")
+	if err != nil {
+		t.Skipf("Unable to read test data file: %v", err)
+	}
+
+	var testCases []struct {
+		Description string `json:"description"`
+		Coptic      struct {
+			Year  int `json:"year"`
+			Month int `json:"month"`
+			Day   int `json:"day"`
+		} `json:"coptic"`
+		Gregorian struct {
+			Year  int `json:"year"`
+			Month int `json:"month"`
+			Day   int `json:"day"`
+		} `json:"gregorian"`
+	}
+
+	if err := json.Unmarshal(data, &testCases); err != nil {
+		t.Fatalf("Failed to parse test data: %v", err)
+	}
+
+	t.Logf("Loaded %d authoritative test cases", len(testCases))
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Authority_Data_%d_%s", i+1, tc.Description), func(t *testing.T) {
+			c := NewCoptic(tc.Coptic.Year, tc.Coptic.Month, tc.Coptic.Day)
+			if !assert.True(t, c.IsValid(), "Coptic date is invalid") {
+				return
+			}
+			expected := time.Date(tc.Gregorian.Year, time.Month(tc.Gregorian.Month), tc.Gregorian.Day, 0, 0, 0, 0, time.UTC)
+			assert.Equal(t, expected, c.ToGregorian().Time, "Coptic %s to Gregorian", c)
+
+			actual := FromStdTime(expected)
+			assert.Equal(t, c.String(), actual.String(), "Gregorian %s to Coptic", expected.Format("2006-01-02"))
+		})
+	}
+}
@@ -0,0 +1,336 @@
+// Package ethiopian is part of the carbon package.
+package ethiopian
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dromara/carbon/v2/calendar"
+)
+
+type Locale string
+
+// Era defines the era of the Ethiopian calendar.
+type Era string
+
+const (
+	EnLocale      Locale = "en"
+	AmLocale      Locale = "am"
+	defaultLocale        = EnLocale
+
+	// AmeteMihret is the Era of Mercy, its first year begins on 8-08-27 in the gregorian calendar.
+	AmeteMihret Era = "amete-mihret"
+	// AmeteAlem is the Era of the World, its year is 5500 years ahead of the Amete Mihret year.
+	AmeteAlem  Era = "amete-alem"
+	defaultEra     = AmeteMihret
+
+	// the julian day number of 1 Meskerem 1 in the Amete Mihret era, which is 8-08-29 in the julian calendar
+	ethiopianEpoch = 1724221
+	// the number of years between the Amete Alem era and the Amete Mihret era
+	ameteAlemOffset = 5500
+	// the julian day number of 1 Meskerem 1 in the Amete Alem era
+	ameteAlemEpoch = ethiopianEpoch - ameteAlemOffset*365 - ameteAlemOffset/4
+
+	maxYear = 9999
+)
+
+var (
+	EnMonths = []string{"Meskerem", "Tikimt", "Hidar", "Tahsas", "Tir", "Yekatit", "Megabit", "Miyazya", "Ginbot", "Sene", "Hamle", "Nehase", "Pagume"}
+	AmMonths = []string{"መስከረም", "ጥቅምት", "ኅዳር", "ታኅሣሥ", "ጥር", "የካቲት", "መጋቢት", "ሚያዝያ", "ግንቦት", "ሰኔ", "ሐምሌ", "ነሐሴ", "ጳጉሜን"}
+
+	EnWeeks = []string{"Ehud", "Segno", "Maksegno", "Rob", "Hamus", "Arb", "Kidame"}
+	AmWeeks = []string{"እሑድ", "ሰኞ", "ማክሰኞ", "ረቡዕ", "ሐሙስ", "ዓርብ", "ቅዳሜ"}
+)
+
+func init() {
+	for name, era := range map[string]Era{"ethiopian": AmeteMihret, "ethiopian-amete-alem": AmeteAlem} {
+		era := era
+		calendar.Register(name, func(t time.Time) calendar.Calendar {
+			if e := FromStdTime(t, era); e != nil {
+				return e
+			}
+			return &Ethiopian{era: era}
+		}, func(year, month, day int) (calendar.Calendar, error) {
+			e := NewEthiopian(year, month, day, era)
+			return e, e.Error
+		})
+	}
+}
+
+// Ethiopian defines a Ethiopian struct.
+type Ethiopian struct {
+	year, month, day int
+	era              Era
+	Error            error
+}
+
+// NewEthiopian returns a new Ethiopian instance, the year is counted in the era which is AmeteMihret by default.
+func NewEthiopian(year, month, day int, era ...Era) *Ethiopian {
+	e := &Ethiopian{year: year, month: month, day: day, era: defaultEra}
+	if len(era) > 0 {
+		e.era = era[0]
+	}
+	if !e.IsValid() {
+		e.Error = fmt.Errorf("invalid ethiopian date: %04d-%02d-%02d", year, month, day)
+	}
+	return e
+}
+
+// FromStdTime creates a Ethiopian instance from standard time.Time, era is AmeteMihret by default.
+func FromStdTime(t time.Time, era ...Era) *Ethiopian {
+	if t.IsZero() {
+		return nil
+	}
+	e := &Ethiopian{era: defaultEra}
+	if len(era) > 0 {
+		e.era = era[0]
+	}
+	year, month, day := jdn2ethiopian(gregorian2jdn(t.Year(), int(t.Month()), t.Day()))
+	if e.era == AmeteMihret {
+		year -= ameteAlemOffset
+	}
+	e.year, e.month, e.day = year, month, day
+	if !e.IsValid() {
+		return &Ethiopian{era: e.era}
+	}
+	return e
+}
+
+// ToGregorian converts Ethiopian instance to Gregorian instance.
+func (e *Ethiopian) ToGregorian(timezone ...string) *calendar.Gregorian {
+	g := new(calendar.Gregorian)
+	if !e.IsValid() {
+		return g
+	}
+	loc := time.UTC
+	if len(timezone) > 0 {
+		loc, g.Error = time.LoadLocation(timezone[0])
+	}
+	if g.Error != nil {
+		return g
+	}
+	year, month, day := jdn2gregorian(e.jdn())
+	g.Time = time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+	return g
+}
+
+// Year gets the Ethiopian year in its era like 2012.
+func (e *Ethiopian) Year() int {
+	if !e.IsValid() {
+		return 0
+	}
+	return e.year
+}
+
+// Month gets the Ethiopian month like 13.
+func (e *Ethiopian) Month() int {
+	if !e.IsValid() {
+		return 0
+	}
+	return e.month
+}
+
+// Day gets the Ethiopian day like 5.
+func (e *Ethiopian) Day() int {
+	if !e.IsValid() {
+		return 0
+	}
+	return e.day
+}
+
+// Era gets the Ethiopian era like "amete-mihret".
+func (e *Ethiopian) Era() Era {
+	if !e.IsValid() {
+		return ""
+	}
+	return e.era
+}
+
+// ToEra converts Ethiopian instance to the same date in the given era.
+func (e *Ethiopian) ToEra(era Era) *Ethiopian {
+	if !e.IsValid() {
+		return &Ethiopian{era: era}
+	}
+	year := e.ameteAlemYear()
+	if era == AmeteMihret {
+		year -= ameteAlemOffset
+	}
+	return NewEthiopian(year, e.month, e.day, era)
+}
+
+// DaysInMonth gets the number of days in the Ethiopian month like 30.
+func (e *Ethiopian) DaysInMonth() int {
+	if !e.IsValid() {
+		return 0
+	}
+	return getDaysInMonth(e.ameteAlemYear(), e.month)
+}
+
+// DaysInYear gets the number of days in the Ethiopian year like 366.
+func (e *Ethiopian) DaysInYear() int {
+	if !e.IsValid() {
+		return 0
+	}
+	if e.IsLeapYear() {
+		return 366
+	}
+	return 365
+}
+
+// String implements the "Stringer" interface for Ethiopian.
+func (e *Ethiopian) String() string {
+	if !e.IsValid() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", e.year, e.month, e.day)
+}
+
+// ToMonthString outputs a string in Ethiopian month format like "መስከረም".
+func (e *Ethiopian) ToMonthString(locale ...Locale) (month string) {
+	if !e.IsValid() {
+		return ""
+	}
+	loc := defaultLocale
+	if len(locale) > 0 {
+		loc = locale[0]
+	}
+	switch loc {
+	case EnLocale:
+		return EnMonths[e.month-1]
+	case AmLocale:
+		return AmMonths[e.month-1]
+	}
+	return ""
+}
+
+// ToWeekString outputs a string in week layout like "እሑድ".
+func (e *Ethiopian) ToWeekString(locale ...Locale) (week string) {
+	if !e.IsValid() {
+		return ""
+	}
+	loc := defaultLocale
+	if len(locale) > 0 {
+		loc = locale[0]
+	}
+	// the julian day number 0 is a Monday
+	index := (e.jdn() + 1) % 7
+	switch loc {
+	case EnLocale:
+		return EnWeeks[index]
+	case AmLocale:
+		return AmWeeks[index]
+	}
+	return ""
+}
+
+// MonthName implements the calendar.Calendar interface, it outputs the month name in the given locale like "Meskerem".
+func (e *Ethiopian) MonthName(locale string) string {
+	if locale == "" {
+		return e.ToMonthString()
+	}
+	return e.ToMonthString(Locale(locale))
+}
+
+// WeekName implements the calendar.Calendar interface, it outputs the week name in the given locale like "Ehud".
+func (e *Ethiopian) WeekName(locale string) string {
+	if locale == "" {
+		return e.ToWeekString()
+	}
+	return e.ToWeekString(Locale(locale))
+}
+
+// IsValid reports whether the Ethiopian date is valid.
+func (e *Ethiopian) IsValid() bool {
+	if e == nil || e.Error != nil {
+		return false
+	}
+	switch e.era {
+	case AmeteMihret:
+		if e.year < 1 || e.year > maxYear {
+			return false
+		}
+	case AmeteAlem:
+		if e.year < 1 || e.year > maxYear+ameteAlemOffset {
+			return false
+		}
+	default:
+		return false
+	}
+	if e.month < 1 || e.month > 13 || e.day < 1 {
+		return false
+	}
+	return e.day <= getDaysInMonth(e.ameteAlemYear(), e.month)
+}
+
+// IsLeapYear reports whether the Ethiopian year is a leap year whose Pagume has 6 days.
+func (e *Ethiopian) IsLeapYear() bool {
+	if !e.IsValid() {
+		return false
+	}
+	return isLeapYear(e.ameteAlemYear())
+}
+
+// gets the year in the Amete Alem era.
+func (e *Ethiopian) ameteAlemYear() int {
+	if e.era == AmeteMihret {
+		return e.year + ameteAlemOffset
+	}
+	return e.year
+}
+
+// gets the julian day number of the Ethiopian date.
+func (e *Ethiopian) jdn() int {
+	return ethiopian2jdn(e.ameteAlemYear(), e.month, e.day)
+}
+
+// reports whether the year is a leap year, the year before the one divisible by 4 is a leap year,
+// which is the same in both eras since 5500 is divisible by 4.
+func isLeapYear(year int) bool {
+	return year%4 == 3
+}
+
+// gets the number of days in the month, the 13th month Pagume has 5 days or 6 days in a leap year.
+func getDaysInMonth(year, month int) int {
+	if month < 13 {
+		return 30
+	}
+	if isLeapYear(year) {
+		return 6
+	}
+	return 5
+}
+
+// ethiopian2jdn converts an Amete Alem date to Julian Day Number.
+func ethiopian2jdn(year, month, day int) int {
+	return ameteAlemEpoch - 1 + 365*(year-1) + year/4 + 30*(month-1) + day
+}
+
+// jdn2ethiopian converts Julian Day Number to Amete Alem date (year, month, day).
+func jdn2ethiopian(jdn int) (year, month, day int) {
+	year = (4*(jdn-ameteAlemEpoch) + 1463) / 1461
+	month = (jdn-ethiopian2jdn(year, 1, 1))/30 + 1
+	day = jdn - ethiopian2jdn(year, month, 1) + 1
+	return
+}
+
+// gregorian2jdn converts a proleptic gregorian date to Julian Day Number.
+func gregorian2jdn(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// jdn2gregorian converts Julian Day Number to proleptic gregorian date (year, month, day).
+func jdn2gregorian(jdn int) (year, month, day int) {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+	day = e - (153*m+2)/5 + 1
+	month = m + 3 - 12*(m/10)
+	year = 100*b + d - 4800 + m/10
+	return
+}
@@ -0,0 +1,99 @@
+package ethiopian
+
+import (
+	"testing"
+	"time"
+)
+
+func BenchmarkFromStdTime(b *testing.B) {
+	testDates := []time.Time{
+		time.Date(2020, 8, 5, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 9, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 9, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	b.Run("amete mihret", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			FromStdTime(testDates[i%len(testDates)])
+		}
+	})
+
+	b.Run("amete alem", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			FromStdTime(testDates[i%len(testDates)], AmeteAlem)
+		}
+	})
+}
+
+func BenchmarkToGregorian(b *testing.B) {
+	testEthiopianDates := []*Ethiopian{
+		NewEthiopian(2016, 1, 1),
+		NewEthiopian(2016, 4, 28),
+		NewEthiopian(2015, 13, 6),
+		NewEthiopian(7516, 1, 1, AmeteAlem),
+		NewEthiopian(7516, 4, 28, AmeteAlem),
+		NewEthiopian(7515, 13, 6, AmeteAlem),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e := testEthiopianDates[i%len(testEthiopianDates)]
+		e.ToGregorian()
+	}
+}
+
+func BenchmarkIsLeapYear(b *testing.B) {
+	testYears := []int{2011, 2012, 2013, 2014, 2015, 2016, 2017, 2018, 2019, 2020}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		year := testYears[i%len(testYears)]
+		e := NewEthiopian(year, 1, 1)
+		e.IsLeapYear()
+	}
+}
+
+func BenchmarkIsValid(b *testing.B) {
+	testDates := []*Ethiopian{
+		NewEthiopian(2015, 13, 6), // 闰年
+		NewEthiopian(2016, 13, 6), // 非闰年
+		NewEthiopian(7516, 1, 1, AmeteAlem),
+		NewEthiopian(0, 1, 1),                // 无效
+		NewEthiopian(15500, 1, 1, AmeteAlem), // 无效
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e := testDates[i%len(testDates)]
+		e.IsValid()
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	e := NewEthiopian(2016, 13, 5)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = e.String()
+	}
+}
+
+func BenchmarkToMonthString(b *testing.B) {
+	e := NewEthiopian(2016, 13, 5)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.ToMonthString()
+	}
+}
+
+func BenchmarkToWeekString(b *testing.B) {
+	e := NewEthiopian(2016, 13, 5)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.ToWeekString()
+	}
+}
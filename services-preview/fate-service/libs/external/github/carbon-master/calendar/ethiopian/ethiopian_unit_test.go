@@ -0,0 +1,347 @@
+package ethiopian
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dromara/carbon/v2/calendar"
+)
+
+func TestNewEthiopian(t *testing.T) {
+	t.Run("valid date", func(t *testing.T) {
+		e := NewEthiopian(2016, 13, 5)
+		assert.Nil(t, e.Error)
+		assert.Equal(t, "2016-13-05", e.String())
+		assert.Equal(t, AmeteMihret, e.Era())
+
+		e = NewEthiopian(7516, 13, 5, AmeteAlem)
+		assert.Nil(t, e.Error)
+		assert.Equal(t, "7516-13-05", e.String())
+		assert.Equal(t, AmeteAlem, e.Era())
+	})
+
+	t.Run("invalid year", func(t *testing.T) {
+		assert.Error(t, NewEthiopian(0, 1, 1).Error)
+		assert.Error(t, NewEthiopian(10000, 1, 1).Error)
+		assert.Error(t, NewEthiopian(0, 1, 1, AmeteAlem).Error)
+		assert.Error(t, NewEthiopian(15500, 1, 1, AmeteAlem).Error)
+	})
+
+	t.Run("invalid month", func(t *testing.T) {
+		assert.Error(t, NewEthiopian(2016, 0, 1).Error)
+		assert.Error(t, NewEthiopian(2016, 14, 1).Error)
+	})
+
+	t.Run("invalid day", func(t *testing.T) {
+		assert.Error(t, NewEthiopian(2016, 1, 0).Error)
+		assert.Error(t, NewEthiopian(2016, 1, 31).Error)
+		// 2016 is not a leap year
+		assert.Error(t, NewEthiopian(2016, 13, 6).Error)
+		assert.Error(t, NewEthiopian(7516, 13, 6, AmeteAlem).Error)
+	})
+
+	t.Run("invalid era", func(t *testing.T) {
+		e := NewEthiopian(2016, 1, 1, "xxx")
+		assert.Error(t, e.Error)
+		assert.Empty(t, e.String())
+	})
+}
+
+func TestFromStdTime(t *testing.T) {
+	loc, _ := time.LoadLocation("Africa/Addis_Ababa")
+
+	t.Run("zero time", func(t *testing.T) {
+		assert.Nil(t, FromStdTime(time.Time{}))
+		assert.Nil(t, FromStdTime(time.Time{}.In(loc), AmeteAlem))
+	})
+
+	t.Run("amete mihret", func(t *testing.T) {
+		assert.Equal(t, "0001-01-01", FromStdTime(time.Date(8, 8, 27, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "2012-11-29", FromStdTime(time.Date(2020, 8, 5, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "2015-13-06", FromStdTime(time.Date(2023, 9, 11, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "2016-01-01", FromStdTime(time.Date(2023, 9, 12, 0, 0, 0, 0, loc)).String())
+	})
+
+	t.Run("amete alem", func(t *testing.T) {
+		assert.Equal(t, "5501-01-01", FromStdTime(time.Date(8, 8, 27, 0, 0, 0, 0, loc), AmeteAlem).String())
+		assert.Equal(t, "5500-13-05", FromStdTime(time.Date(8, 8, 26, 0, 0, 0, 0, loc), AmeteAlem).String())
+		assert.Equal(t, "7512-11-29", FromStdTime(time.Date(2020, 8, 5, 0, 0, 0, 0, loc), AmeteAlem).String())
+	})
+
+	t.Run("before amete mihret", func(t *testing.T) {
+		e := FromStdTime(time.Date(8, 8, 26, 0, 0, 0, 0, loc))
+		assert.NotNil(t, e)
+		assert.Empty(t, e.String())
+	})
+
+	t.Run("invalid era", func(t *testing.T) {
+		assert.Empty(t, FromStdTime(time.Date(2020, 8, 5, 0, 0, 0, 0, loc), "xxx").String())
+	})
+}
+
+func TestEthiopian_ToGregorian(t *testing.T) {
+	t.Run("invalid ethiopian", func(t *testing.T) {
+		assert.Empty(t, new(Ethiopian).ToGregorian().String())
+		assert.Empty(t, NewEthiopian(0, 1, 1).ToGregorian().String())
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		g := NewEthiopian(2016, 1, 1).ToGregorian("xxx")
+		assert.Error(t, g.Error)
+		assert.Empty(t, g.String())
+	})
+
+	t.Run("without timezone", func(t *testing.T) {
+		assert.Equal(t, "2023-09-12 00:00:00 +0000 UTC", NewEthiopian(2016, 1, 1).ToGregorian().String())
+		assert.Equal(t, "2023-09-12 00:00:00 +0000 UTC", NewEthiopian(7516, 1, 1, AmeteAlem).ToGregorian().String())
+	})
+
+	t.Run("with timezone", func(t *testing.T) {
+		assert.Equal(t, "2023-09-12 00:00:00 +0300 EAT", NewEthiopian(2016, 1, 1).ToGregorian("Africa/Addis_Ababa").String())
+		assert.Equal(t, "2023-09-12 00:00:00 +0300 EAT", NewEthiopian(7516, 1, 1, AmeteAlem).ToGregorian("Africa/Addis_Ababa").String())
+	})
+}
+
+func TestEthiopian_Year(t *testing.T) {
+	assert.Zero(t, new(Ethiopian).Year())
+	assert.Equal(t, 2016, NewEthiopian(2016, 1, 1).Year())
+	assert.Equal(t, 7516, NewEthiopian(7516, 1, 1, AmeteAlem).Year())
+}
+
+func TestEthiopian_Month(t *testing.T) {
+	assert.Zero(t, new(Ethiopian).Month())
+	assert.Equal(t, 13, NewEthiopian(2016, 13, 1).Month())
+}
+
+func TestEthiopian_Day(t *testing.T) {
+	assert.Zero(t, new(Ethiopian).Day())
+	assert.Equal(t, 5, NewEthiopian(2016, 13, 5).Day())
+}
+
+func TestEthiopian_Era(t *testing.T) {
+	assert.Empty(t, new(Ethiopian).Era())
+	assert.Equal(t, AmeteMihret, NewEthiopian(2016, 1, 1).Era())
+	assert.Equal(t, AmeteAlem, NewEthiopian(7516, 1, 1, AmeteAlem).Era())
+}
+
+func TestEthiopian_ToEra(t *testing.T) {
+	t.Run("invalid ethiopian", func(t *testing.T) {
+		assert.Empty(t, new(Ethiopian).ToEra(AmeteAlem).String())
+		assert.Empty(t, NewEthiopian(2016, 1, 1).ToEra("xxx").String())
+	})
+
+	t.Run("out of era", func(t *testing.T) {
+		assert.Error(t, NewEthiopian(5500, 1, 1, AmeteAlem).ToEra(AmeteMihret).Error)
+	})
+
+	t.Run("valid ethiopian", func(t *testing.T) {
+		assert.Equal(t, "7516-13-05", NewEthiopian(2016, 13, 5).ToEra(AmeteAlem).String())
+		assert.Equal(t, "2016-13-05", NewEthiopian(7516, 13, 5, AmeteAlem).ToEra(AmeteMihret).String())
+		assert.Equal(t, "2016-13-05", NewEthiopian(2016, 13, 5).ToEra(AmeteMihret).String())
+	})
+}
+
+func TestEthiopian_DaysInMonth(t *testing.T) {
+	assert.Zero(t, new(Ethiopian).DaysInMonth())
+	assert.Equal(t, 30, NewEthiopian(2016, 1, 1).DaysInMonth())
+	assert.Equal(t, 30, NewEthiopian(2016, 12, 1).DaysInMonth())
+	assert.Equal(t, 5, NewEthiopian(2016, 13, 1).DaysInMonth())
+	assert.Equal(t, 6, NewEthiopian(2015, 13, 1).DaysInMonth())
+	assert.Equal(t, 6, NewEthiopian(7515, 13, 1, AmeteAlem).DaysInMonth())
+}
+
+func TestEthiopian_DaysInYear(t *testing.T) {
+	assert.Zero(t, new(Ethiopian).DaysInYear())
+	assert.Equal(t, 366, NewEthiopian(2015, 1, 1).DaysInYear())
+	assert.Equal(t, 365, NewEthiopian(2016, 1, 1).DaysInYear())
+}
+
+func TestEthiopian_String(t *testing.T) {
+	assert.Empty(t, new(Ethiopian).String())
+	assert.Equal(t, "0001-01-01", NewEthiopian(1, 1, 1).String())
+	assert.Equal(t, "2016-13-05", NewEthiopian(2016, 13, 5).String())
+}
+
+func TestEthiopian_ToMonthString(t *testing.T) {
+	t.Run("invalid ethiopian", func(t *testing.T) {
+		assert.Empty(t, new(Ethiopian).ToMonthString())
+	})
+
+	t.Run("invalid locale", func(t *testing.T) {
+		assert.Empty(t, NewEthiopian(2016, 1, 1).ToMonthString("xxx"))
+	})
+
+	t.Run("valid ethiopian", func(t *testing.T) {
+		assert.Equal(t, "Meskerem", NewEthiopian(2016, 1, 1).ToMonthString())
+		assert.Equal(t, "Nehase", NewEthiopian(2016, 12, 1).ToMonthString(EnLocale))
+		assert.Equal(t, "Pagume", NewEthiopian(2016, 13, 1).ToMonthString(EnLocale))
+		assert.Equal(t, "መስከረም", NewEthiopian(2016, 1, 1).ToMonthString(AmLocale))
+		assert.Equal(t, "ነሐሴ", NewEthiopian(2016, 12, 1).ToMonthString(AmLocale))
+		assert.Equal(t, "ጳጉሜን", NewEthiopian(2016, 13, 1).ToMonthString(AmLocale))
+	})
+}
+
+func TestEthiopian_ToWeekString(t *testing.T) {
+	t.Run("invalid ethiopian", func(t *testing.T) {
+		assert.Empty(t, new(Ethiopian).ToWeekString())
+	})
+
+	t.Run("invalid locale", func(t *testing.T) {
+		assert.Empty(t, NewEthiopian(2016, 1, 1).ToWeekString("xxx"))
+	})
+
+	t.Run("valid ethiopian", func(t *testing.T) {
+		// 2023-09-12 is a Tuesday
+		assert.Equal(t, "Maksegno", NewEthiopian(2016, 1, 1).ToWeekString())
+		assert.Equal(t, "ማክሰኞ", NewEthiopian(2016, 1, 1).ToWeekString(AmLocale))
+		// 2020-08-05 is a Wednesday
+		assert.Equal(t, "Rob", NewEthiopian(7512, 11, 29, AmeteAlem).ToWeekString(EnLocale))
+		assert.Equal(t, "ረቡዕ", NewEthiopian(7512, 11, 29, AmeteAlem).ToWeekString(AmLocale))
+	})
+}
+
+func TestEthiopian_MonthName(t *testing.T) {
+	t.Run("invalid ethiopian", func(t *testing.T) {
+		assert.Empty(t, new(Ethiopian).MonthName(""))
+		assert.Empty(t, NewEthiopian(2016, 1, 1).MonthName("xxx"))
+	})
+
+	t.Run("valid ethiopian", func(t *testing.T) {
+		e := NewEthiopian(2016, 13, 1)
+		assert.Equal(t, "Pagume", e.MonthName(""))
+		assert.Equal(t, "Pagume", e.MonthName("en"))
+		assert.Equal(t, "ጳጉሜን", e.MonthName("am"))
+	})
+}
+
+func TestEthiopian_WeekName(t *testing.T) {
+	t.Run("invalid ethiopian", func(t *testing.T) {
+		assert.Empty(t, new(Ethiopian).WeekName(""))
+		assert.Empty(t, NewEthiopian(2016, 1, 1).WeekName("xxx"))
+	})
+
+	t.Run("valid ethiopian", func(t *testing.T) {
+		e := NewEthiopian(2016, 1, 1)
+		assert.Equal(t, "Maksegno", e.WeekName(""))
+		assert.Equal(t, "Maksegno", e.WeekName("en"))
+		assert.Equal(t, "ማክሰኞ", e.WeekName("am"))
+	})
+}
+
+func TestEthiopian_Calendar(t *testing.T) {
+	t.Run("zero time", func(t *testing.T) {
+		for _, name := range []string{"ethiopian", "ethiopian-amete-alem"} {
+			converter, _, ok := calendar.Lookup(name)
+			assert.True(t, ok)
+			c := converter(time.Time{})
+			assert.NotNil(t, c)
+			assert.False(t, c.IsValid())
+			assert.Empty(t, c.String())
+		}
+	})
+
+	t.Run("amete mihret", func(t *testing.T) {
+		converter, creator, ok := calendar.Lookup("ethiopian")
+		assert.True(t, ok)
+		assert.Equal(t, "2015-13-06", converter(time.Date(2023, 9, 11, 0, 0, 0, 0, time.UTC)).String())
+
+		_, err := creator(2016, 13, 6)
+		assert.Error(t, err)
+
+		c, err := creator(2016, 1, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, "2023-09-12 00:00:00 +0000 UTC", c.ToGregorian().String())
+	})
+
+	t.Run("amete alem", func(t *testing.T) {
+		converter, creator, ok := calendar.Lookup("ethiopian-amete-alem")
+		assert.True(t, ok)
+		assert.Equal(t, "7515-13-06", converter(time.Date(2023, 9, 11, 0, 0, 0, 0, time.UTC)).String())
+
+		_, err := creator(7516, 13, 6)
+		assert.Error(t, err)
+
+		c, err := creator(7516, 1, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, "2023-09-12 00:00:00 +0000 UTC", c.ToGregorian().String())
+	})
+}
+
+func TestEthiopian_IsValid(t *testing.T) {
+	assert.False(t, (*Ethiopian)(nil).IsValid())
+	assert.False(t, new(Ethiopian).IsValid())
+	assert.False(t, NewEthiopian(2016, 14, 1).IsValid())
+	assert.False(t, NewEthiopian(2016, 13, 6).IsValid())
+	assert.True(t, NewEthiopian(1, 1, 1).IsValid())
+	assert.True(t, NewEthiopian(9999, 12, 30).IsValid())
+	assert.True(t, NewEthiopian(2015, 13, 6).IsValid())
+	assert.True(t, NewEthiopian(1, 1, 1, AmeteAlem).IsValid())
+	assert.True(t, NewEthiopian(15499, 13, 6, AmeteAlem).IsValid())
+}
+
+func TestEthiopian_IsLeapYear(t *testing.T) {
+	t.Run("invalid ethiopian", func(t *testing.T) {
+		assert.False(t, new(Ethiopian).IsLeapYear())
+		assert.False(t, NewEthiopian(0, 1, 1).IsLeapYear())
+	})
+
+	t.Run("amete mihret", func(t *testing.T) {
+		for year := 2011; year <= 2020; year++ {
+			assert.Equal(t, year%4 == 3, NewEthiopian(year, 1, 1).IsLeapYear(), "year %d", year)
+		}
+	})
+
+	t.Run("amete alem", func(t *testing.T) {
+		for year := 2011; year <= 2020; year++ {
+			assert.Equal(t, NewEthiopian(year, 1, 1).IsLeapYear(), NewEthiopian(year+5500, 1, 1, AmeteAlem).IsLeapYear(), "year %d", year)
+		}
+	})
+}
+
+// TestEthiopianWithAuthorityData validates Ethiopian calendar conversion using authoritative test data
+func TestEthiopianWithAuthorityData(t *testing.T) {
+	data, err := os.ReadFile("ethiopian_test_data.json")
+	if err != nil {
+		t.Skipf("Unable to read test data file: %v", err)
+	}
+
+	var testCases []struct {
+		Description string `json:"description"`
+		Era         Era    `json:"era"`
+		Ethiopian   struct {
+			Year  int `json:"year"`
+			Month int `json:"month"`
+			Day   int `json:"day"`
+		} `json:"ethiopian"`
+		Gregorian struct {
+			Year  int `json:"year"`
+			Month int `json:"month"`
+			Day   int `json:"day"`
+		} `json:"gregorian"`
+	}
+
+	if err := json.Unmarshal(data, &testCases); err != nil {
+		t.Fatalf("Failed to parse test data: %v", err)
+	}
+
+	t.Logf("Loaded %d authoritative test cases", len(testCases))
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Authority_Data_%d_%s", i+1, tc.Description), func(t *testing.T) {
+			e := NewEthiopian(tc.Ethiopian.Year, tc.Ethiopian.Month, tc.Ethiopian.Day, tc.Era)
+			if !assert.True(t, e.IsValid(), "Ethiopian date is invalid") {
+				return
+			}
+			expected := time.Date(tc.Gregorian.Year, time.Month(tc.Gregorian.Month), tc.Gregorian.Day, 0, 0, 0, 0, time.UTC)
+			assert.Equal(t, expected, e.ToGregorian().Time, "Ethiopian %s (%s) to Gregorian", e, tc.Era)
+
+			actual := FromStdTime(expected, tc.Era)
+			assert.Equal(t, e.String(), actual.String(), "Gregorian %s to Ethiopian (%s)", expected.Format("2006-01-02"), tc.Era)
+		})
+	}
+}
@@ -24,6 +24,18 @@ var (
 	HeWeeks  = []string{"ראשון", "שני", "שלישי", "רביעי", "חמישי", "שישי", "שבת"}
 )
 
+func init() {
+	calendar.Register("hebrew", func(t time.Time) calendar.Calendar {
+		if h := FromStdTime(t); h != nil {
+			return h
+		}
+		return new(Hebrew)
+	}, func(year, month, day int) (calendar.Calendar, error) {
+		h := NewHebrew(year, month, day)
+		return h, h.Error
+	})
+}
+
 type Hebrew struct {
 	year, month, day int
 	Error            error
@@ -177,6 +189,22 @@ func (h *Hebrew) ToWeekString(locale ...Locale) string {
 	return ""
 }
 
+// MonthName implements the calendar.Calendar interface, it outputs the month name in the given locale like "Nisan".
+func (h *Hebrew) MonthName(locale string) string {
+	if locale == "" {
+		return h.ToMonthString()
+	}
+	return h.ToMonthString(Locale(locale))
+}
+
+// WeekName implements the calendar.Calendar interface, it outputs the week name in the given locale like "Sunday".
+func (h *Hebrew) WeekName(locale string) string {
+	if locale == "" {
+		return h.ToWeekString()
+	}
+	return h.ToWeekString(Locale(locale))
+}
+
 // gregorian2jdn converts Gregorian date to Julian Day Number
 func gregorian2jdn(year, month, day int) float64 {
 	if month <= 2 {
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/dromara/carbon/v2/calendar"
 )
 
 func TestFromStdTime(t *testing.T) {
@@ -192,6 +194,67 @@ func TestHebrew_ToWeekString(t *testing.T) {
 	})
 }
 
+func TestHebrew_MonthName(t *testing.T) {
+	t.Run("invalid hebrew", func(t *testing.T) {
+		assert.Empty(t, new(Hebrew).MonthName(""))
+		assert.Empty(t, NewHebrew(5780, 10, 7).MonthName("xxx"))
+	})
+
+	t.Run("valid hebrew", func(t *testing.T) {
+		h := NewHebrew(5780, 10, 7)
+		assert.Equal(t, "Teveth", h.MonthName(""))
+		assert.Equal(t, "Teveth", h.MonthName("en"))
+		assert.Equal(t, "טבת", h.MonthName("he"))
+	})
+}
+
+func TestHebrew_WeekName(t *testing.T) {
+	t.Run("invalid hebrew", func(t *testing.T) {
+		assert.Empty(t, new(Hebrew).WeekName(""))
+		assert.Empty(t, NewHebrew(5780, 10, 7).WeekName("xxx"))
+	})
+
+	t.Run("valid hebrew", func(t *testing.T) {
+		h := NewHebrew(5780, 10, 7)
+		assert.Equal(t, "Saturday", h.WeekName(""))
+		assert.Equal(t, "Saturday", h.WeekName("en"))
+		assert.Equal(t, "שבת", h.WeekName("he"))
+	})
+}
+
+func TestHebrew_Calendar(t *testing.T) {
+	converter, creator, ok := calendar.Lookup("hebrew")
+	assert.True(t, ok)
+
+	t.Run("zero time", func(t *testing.T) {
+		c := converter(time.Time{})
+		assert.NotNil(t, c)
+		assert.False(t, c.IsValid())
+		assert.Empty(t, c.String())
+	})
+
+	t.Run("valid time", func(t *testing.T) {
+		c := converter(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		assert.Equal(t, "5784-10-20", c.String())
+		assert.Equal(t, 5784, c.Year())
+		assert.Equal(t, 10, c.Month())
+		assert.Equal(t, 20, c.Day())
+		assert.True(t, c.IsLeapYear())
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		_, err := creator(5784, 14, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("valid date", func(t *testing.T) {
+		c, err := creator(5784, 10, 20)
+		assert.Nil(t, err)
+		assert.Equal(t, "Teveth", c.MonthName("en"))
+		assert.Equal(t, NewHebrew(5784, 10, 20).ToGregorian().String(), c.ToGregorian().String())
+	})
+}
+
 func TestHebrew_IsLeapYear(t *testing.T) {
 	t.Run("invalid hebrew", func(t *testing.T) {
 		assert.False(t, new(Hebrew).IsLeapYear())
@@ -0,0 +1,410 @@
+// Package hijri is part of the carbon package.
+package hijri
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dromara/carbon/v2/calendar"
+)
+
+type Locale string
+
+// Variant defines the variant of the Hijri calendar.
+type Variant string
+
+const (
+	EnLocale      Locale = "en"
+	ArLocale      Locale = "ar"
+	defaultLocale        = EnLocale
+
+	// Tabular is the tabular (civil) Hijri calendar with 11 leap years in a 30-year cycle.
+	Tabular Variant = "tabular"
+	// UmmAlQura is the Umm al-Qura Hijri calendar of Saudi Arabia, which is supported from 1300 to 1500.
+	UmmAlQura      Variant = "umm-al-qura"
+	defaultVariant         = Tabular
+
+	// the julian day number of 1 Muharram 1 in the tabular calendar, which is 622-07-16 in the julian calendar
+	hijriEpoch = 1948440
+
+	// the julian day number of 1 Muharram 1300 in the Umm al-Qura calendar, which is 1882-11-12
+	ummAlQuraEpoch   = 2408762
+	ummAlQuraMinYear = 1300
+	ummAlQuraMaxYear = 1500
+)
+
+var (
+	EnMonths = []string{"Muharram", "Safar", "Rabi' al-Awwal", "Rabi' al-Thani", "Jumada al-Awwal", "Jumada al-Thani", "Rajab", "Sha'ban", "Ramadan", "Shawwal", "Dhu al-Qi'dah", "Dhu al-Hijjah"}
+	ArMonths = []string{"محرم", "صفر", "ربيع الأول", "ربيع الآخر", "جمادى الأولى", "جمادى الآخرة", "رجب", "شعبان", "رمضان", "شوال", "ذو القعدة", "ذو الحجة"}
+
+	EnWeeks = []string{"Al-Ahad", "Al-Ithnayn", "Ath-Thulatha", "Al-Arbia", "Al-Khamis", "Al-Jumuah", "As-Sabt"}
+	ArWeeks = []string{"الأحد", "الإثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"}
+
+	// month lengths of the Umm al-Qura calendar from 1300 to 1500, a set bit from the highest means a month of 30 days
+	ummAlQuraYears = []int{
+		0xaaa, 0xd54, 0xec9, 0x6d4, 0x6ea, 0x36c, // 1300-1305
+		0xaad, 0x555, 0x6a9, 0x792, 0xba9, 0x5d4, // 1306-1311
+		0xada, 0x55c, 0xd2d, 0x695, 0x74a, 0xb54, // 1312-1317
+		0xb6a, 0x5ad, 0x4ae, 0xa4f, 0x517, 0x68b, // 1318-1323
+		0x6a5, 0xad5, 0x2d6, 0x95b, 0x49d, 0xa4d, // 1324-1329
+		0xd26, 0xd95, 0x5ac, 0x9b6, 0x2ba, 0xa5b, // 1330-1335
+		0x52b, 0xa95, 0x6ca, 0xae9, 0x2f4, 0x976, // 1336-1341
+		0x2b6, 0x956, 0xaca, 0xba4, 0xbd2, 0x5d9, // 1342-1347
+		0x2dc, 0x96d, 0x54d, 0xaa5, 0xb52, 0xba5, // 1348-1353
+		0x5b4, 0x9b6, 0x557, 0x297, 0x54b, 0x6a3, // 1354-1359
+		0x752, 0xb65, 0x56a, 0xaab, 0x52b, 0xc95, // 1360-1365
+		0xd4a, 0xda5, 0x5ca, 0xad6, 0x957, 0x4ab, // 1366-1371
+		0x94b, 0xaa5, 0xb52, 0xb6a, 0x575, 0x276, // 1372-1377
+		0x8b7, 0x45b, 0x555, 0x5a9, 0x5b4, 0x9da, // 1378-1383
+		0x4dd, 0x26e, 0x936, 0xaaa, 0xd54, 0xdb2, // 1384-1389
+		0x5d5, 0x2da, 0x95b, 0x4ab, 0xa55, 0xb49, // 1390-1395
+		0xb64, 0xb71, 0x5b4, 0xab5, 0xa55, 0xd25, // 1396-1401
+		0xe92, 0xec9, 0x6d4, 0xae9, 0x96b, 0x4ab, // 1402-1407
+		0xa93, 0xd49, 0xda4, 0xdb2, 0xab9, 0x4ba, // 1408-1413
+		0xa5b, 0x52b, 0xa95, 0xb2a, 0xb55, 0x55c, // 1414-1419
+		0x4bd, 0x23d, 0x91d, 0xa95, 0xb4a, 0xb5a, // 1420-1425
+		0x56d, 0x2b6, 0x93b, 0x49b, 0x655, 0x6a9, // 1426-1431
+		0x754, 0xb6a, 0x56c, 0xaad, 0x555, 0xb29, // 1432-1437
+		0xb92, 0xba9, 0x5d4, 0xada, 0x55a, 0xaab, // 1438-1443
+		0x595, 0x749, 0x764, 0xbaa, 0x5b5, 0x2b6, // 1444-1449
+		0xa56, 0xe4d, 0xb25, 0xb52, 0xb6a, 0x5ad, // 1450-1455
+		0x2ae, 0x92f, 0x497, 0x64b, 0x6a5, 0x6ac, // 1456-1461
+		0xad6, 0x55d, 0x49d, 0xa4d, 0xd16, 0xd95, // 1462-1467
+		0x5aa, 0x5b5, 0x2da, 0x95b, 0x4ad, 0x595, // 1468-1473
+		0x6ca, 0x6e4, 0xaea, 0x4f5, 0x2b6, 0x956, // 1474-1479
+		0xaaa, 0xb54, 0xbd2, 0x5d9, 0x2ea, 0x96d, // 1480-1485
+		0x4ad, 0xa95, 0xb4a, 0xba5, 0x5b2, 0x9b5, // 1486-1491
+		0x4d6, 0xa97, 0x547, 0x693, 0x749, 0xb55, // 1492-1497
+		0x56a, 0xa6b, 0x52b, // 1498-1500
+	}
+
+	// the julian day numbers of the first days of Umm al-Qura years, the last one is the end of the table
+	ummAlQuraYearStarts = func() []int {
+		starts := make([]int, len(ummAlQuraYears)+1)
+		starts[0] = ummAlQuraEpoch
+		for i := range ummAlQuraYears {
+			starts[i+1] = starts[i] + getUmmAlQuraDaysInYear(ummAlQuraMinYear+i)
+		}
+		return starts
+	}()
+)
+
+func init() {
+	for name, variant := range map[string]Variant{"hijri": Tabular, "hijri-umm-al-qura": UmmAlQura} {
+		variant := variant
+		calendar.Register(name, func(t time.Time) calendar.Calendar {
+			if h := FromStdTime(t, variant); h != nil {
+				return h
+			}
+			return &Hijri{variant: variant}
+		}, func(year, month, day int) (calendar.Calendar, error) {
+			h := NewHijri(year, month, day, variant)
+			return h, h.Error
+		})
+	}
+}
+
+// Hijri defines a Hijri struct.
+type Hijri struct {
+	year, month, day int
+	variant          Variant
+	Error            error
+}
+
+// NewHijri returns a new Hijri instance, variant is Tabular by default.
+func NewHijri(year, month, day int, variant ...Variant) *Hijri {
+	h := &Hijri{year: year, month: month, day: day, variant: defaultVariant}
+	if len(variant) > 0 {
+		h.variant = variant[0]
+	}
+	if !h.IsValid() {
+		h.Error = fmt.Errorf("invalid hijri date: %04d-%02d-%02d", year, month, day)
+	}
+	return h
+}
+
+// FromStdTime creates a Hijri instance from standard time.Time, variant is Tabular by default.
+func FromStdTime(t time.Time, variant ...Variant) *Hijri {
+	if t.IsZero() {
+		return nil
+	}
+	h := &Hijri{variant: defaultVariant}
+	if len(variant) > 0 {
+		h.variant = variant[0]
+	}
+	jdn := gregorian2jdn(t.Year(), int(t.Month()), t.Day())
+	switch h.variant {
+	case Tabular:
+		h.year, h.month, h.day = jdn2tabular(jdn)
+	case UmmAlQura:
+		h.year, h.month, h.day = jdn2ummAlQura(jdn)
+	}
+	return h
+}
+
+// ToGregorian converts Hijri instance to Gregorian instance.
+func (h *Hijri) ToGregorian(timezone ...string) *calendar.Gregorian {
+	g := new(calendar.Gregorian)
+	if !h.IsValid() {
+		return g
+	}
+	loc := time.UTC
+	if len(timezone) > 0 {
+		loc, g.Error = time.LoadLocation(timezone[0])
+	}
+	if g.Error != nil {
+		return g
+	}
+	year, month, day := jdn2gregorian(h.jdn())
+	g.Time = time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+	return g
+}
+
+// Year gets the Hijri year like 1446.
+func (h *Hijri) Year() int {
+	if !h.IsValid() {
+		return 0
+	}
+	return h.year
+}
+
+// Month gets the Hijri month like 9.
+func (h *Hijri) Month() int {
+	if !h.IsValid() {
+		return 0
+	}
+	return h.month
+}
+
+// Day gets the Hijri day like 5.
+func (h *Hijri) Day() int {
+	if !h.IsValid() {
+		return 0
+	}
+	return h.day
+}
+
+// Variant gets the Hijri calendar variant like "tabular".
+func (h *Hijri) Variant() Variant {
+	if !h.IsValid() {
+		return ""
+	}
+	return h.variant
+}
+
+// DaysInMonth gets the number of days in the Hijri month like 30.
+func (h *Hijri) DaysInMonth() int {
+	if !h.IsValid() {
+		return 0
+	}
+	return getDaysInMonth(h.variant, h.year, h.month)
+}
+
+// DaysInYear gets the number of days in the Hijri year like 355.
+func (h *Hijri) DaysInYear() int {
+	if !h.IsValid() {
+		return 0
+	}
+	if h.variant == UmmAlQura {
+		return getUmmAlQuraDaysInYear(h.year)
+	}
+	if isTabularLeapYear(h.year) {
+		return 355
+	}
+	return 354
+}
+
+// String implements the "Stringer" interface for Hijri.
+func (h *Hijri) String() string {
+	if !h.IsValid() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", h.year, h.month, h.day)
+}
+
+// ToMonthString outputs a string in Hijri month format like "رمضان".
+func (h *Hijri) ToMonthString(locale ...Locale) (month string) {
+	if !h.IsValid() {
+		return ""
+	}
+	loc := defaultLocale
+	if len(locale) > 0 {
+		loc = locale[0]
+	}
+	switch loc {
+	case EnLocale:
+		return EnMonths[h.month-1]
+	case ArLocale:
+		return ArMonths[h.month-1]
+	}
+	return ""
+}
+
+// ToWeekString outputs a string in week layout like "الجمعة".
+func (h *Hijri) ToWeekString(locale ...Locale) (week string) {
+	if !h.IsValid() {
+		return ""
+	}
+	loc := defaultLocale
+	if len(locale) > 0 {
+		loc = locale[0]
+	}
+	// the julian day number 0 is a Monday
+	index := (h.jdn() + 1) % 7
+	switch loc {
+	case EnLocale:
+		return EnWeeks[index]
+	case ArLocale:
+		return ArWeeks[index]
+	}
+	return ""
+}
+
+// MonthName implements the calendar.Calendar interface, it outputs the month name in the given locale like "Muharram".
+func (h *Hijri) MonthName(locale string) string {
+	if locale == "" {
+		return h.ToMonthString()
+	}
+	return h.ToMonthString(Locale(locale))
+}
+
+// WeekName implements the calendar.Calendar interface, it outputs the week name in the given locale like "Al-Ahad".
+func (h *Hijri) WeekName(locale string) string {
+	if locale == "" {
+		return h.ToWeekString()
+	}
+	return h.ToWeekString(Locale(locale))
+}
+
+// IsValid reports whether the Hijri date is valid.
+func (h *Hijri) IsValid() bool {
+	if h == nil || h.Error != nil {
+		return false
+	}
+	switch h.variant {
+	case Tabular:
+		if h.year < 1 || h.year > 9999 {
+			return false
+		}
+	case UmmAlQura:
+		if h.year < ummAlQuraMinYear || h.year > ummAlQuraMaxYear {
+			return false
+		}
+	default:
+		return false
+	}
+	if h.month < 1 || h.month > 12 || h.day < 1 {
+		return false
+	}
+	return h.day <= getDaysInMonth(h.variant, h.year, h.month)
+}
+
+// IsLeapYear reports whether the Hijri year is a leap year of 355 days.
+func (h *Hijri) IsLeapYear() bool {
+	if !h.IsValid() {
+		return false
+	}
+	return h.DaysInYear() == 355
+}
+
+// gets the julian day number of the Hijri date.
+func (h *Hijri) jdn() int {
+	if h.variant == UmmAlQura {
+		jdn := ummAlQuraYearStarts[h.year-ummAlQuraMinYear]
+		for m := 1; m < h.month; m++ {
+			jdn += getDaysInMonth(UmmAlQura, h.year, m)
+		}
+		return jdn + h.day - 1
+	}
+	return tabular2jdn(h.year, h.month, h.day)
+}
+
+// reports whether the year is a leap year in the tabular calendar,
+// leap years are 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of a 30-year cycle.
+func isTabularLeapYear(year int) bool {
+	return (14+11*year)%30 < 11
+}
+
+// gets the number of days in the month of the variant.
+func getDaysInMonth(variant Variant, year, month int) int {
+	if variant == UmmAlQura {
+		if ummAlQuraYears[year-ummAlQuraMinYear]&(0x800>>uint(month-1)) != 0 {
+			return 30
+		}
+		return 29
+	}
+	if month == 12 && isTabularLeapYear(year) {
+		return 30
+	}
+	// odd months have 30 days and even months have 29 days
+	return 30 - (month+1)%2
+}
+
+// gets the number of days in the Umm al-Qura year.
+func getUmmAlQuraDaysInYear(year int) int {
+	days := 0
+	for m := 1; m <= 12; m++ {
+		days += getDaysInMonth(UmmAlQura, year, m)
+	}
+	return days
+}
+
+// tabular2jdn converts a tabular Hijri date to Julian Day Number.
+func tabular2jdn(year, month, day int) int {
+	return day + (59*(month-1)+1)/2 + (year-1)*354 + (3+11*year)/30 + hijriEpoch - 1
+}
+
+// jdn2tabular converts Julian Day Number to tabular Hijri date (year, month, day).
+func jdn2tabular(jdn int) (year, month, day int) {
+	year = (30*(jdn-hijriEpoch) + 10646) / 10631
+	month = 12
+	for m := 1; m < 12; m++ {
+		if jdn < tabular2jdn(year, m+1, 1) {
+			month = m
+			break
+		}
+	}
+	day = jdn - tabular2jdn(year, month, 1) + 1
+	return
+}
+
+// jdn2ummAlQura converts Julian Day Number to Umm al-Qura Hijri date (year, month, day),
+// which is zero date if out of the table.
+func jdn2ummAlQura(jdn int) (year, month, day int) {
+	starts := ummAlQuraYearStarts
+	if jdn < starts[0] || jdn >= starts[len(starts)-1] {
+		return
+	}
+	i := 0
+	for jdn >= starts[i+1] {
+		i++
+	}
+	year, month, day = ummAlQuraMinYear+i, 1, jdn-starts[i]+1
+	for day > getDaysInMonth(UmmAlQura, year, month) {
+		day -= getDaysInMonth(UmmAlQura, year, month)
+		month++
+	}
+	return
+}
+
+// gregorian2jdn converts a proleptic gregorian date to Julian Day Number.
+func gregorian2jdn(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// jdn2gregorian converts Julian Day Number to proleptic gregorian date (year, month, day).
+func jdn2gregorian(jdn int) (year, month, day int) {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+	day = e - (153*m+2)/5 + 1
+	month = m + 3 - 12*(m/10)
+	year = 100*b + d - 4800 + m/10
+	return
+}
@@ -0,0 +1,99 @@
+package hijri
+
+import (
+	"testing"
+	"time"
+)
+
+func BenchmarkFromStdTime(b *testing.B) {
+	testDates := []time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 7, 7, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC),
+	}
+
+	b.Run("tabular", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			FromStdTime(testDates[i%len(testDates)])
+		}
+	})
+
+	b.Run("umm al-qura", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			FromStdTime(testDates[i%len(testDates)], UmmAlQura)
+		}
+	})
+}
+
+func BenchmarkToGregorian(b *testing.B) {
+	testHijriDates := []*Hijri{
+		NewHijri(1446, 1, 1),
+		NewHijri(1446, 9, 1),
+		NewHijri(1446, 10, 1),
+		NewHijri(1446, 1, 1, UmmAlQura),
+		NewHijri(1446, 9, 1, UmmAlQura),
+		NewHijri(1446, 10, 1, UmmAlQura),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h := testHijriDates[i%len(testHijriDates)]
+		h.ToGregorian()
+	}
+}
+
+func BenchmarkIsLeapYear(b *testing.B) {
+	testYears := []int{1440, 1441, 1442, 1443, 1444, 1445, 1446, 1447, 1448, 1449}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		year := testYears[i%len(testYears)]
+		h := NewHijri(year, 1, 1)
+		h.IsLeapYear()
+	}
+}
+
+func BenchmarkIsValid(b *testing.B) {
+	testDates := []*Hijri{
+		NewHijri(1445, 12, 30), // 闰年
+		NewHijri(1446, 12, 30), // 非闰年
+		NewHijri(1446, 9, 1, UmmAlQura),
+		NewHijri(0, 1, 1),               // 无效
+		NewHijri(1501, 1, 1, UmmAlQura), // 无效
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h := testDates[i%len(testDates)]
+		h.IsValid()
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	h := NewHijri(1446, 9, 1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = h.String()
+	}
+}
+
+func BenchmarkToMonthString(b *testing.B) {
+	h := NewHijri(1446, 9, 1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.ToMonthString()
+	}
+}
+
+func BenchmarkToWeekString(b *testing.B) {
+	h := NewHijri(1446, 9, 1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.ToWeekString()
+	}
+}
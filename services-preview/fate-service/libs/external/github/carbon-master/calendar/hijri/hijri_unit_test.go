@@ -0,0 +1,329 @@
+package hijri
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dromara/carbon/v2/calendar"
+)
+
+func TestNewHijri(t *testing.T) {
+	t.Run("valid date", func(t *testing.T) {
+		h := NewHijri(1446, 9, 1)
+		assert.Nil(t, h.Error)
+		assert.Equal(t, "1446-09-01", h.String())
+		assert.Equal(t, Tabular, h.Variant())
+
+		h = NewHijri(1446, 9, 1, UmmAlQura)
+		assert.Nil(t, h.Error)
+		assert.Equal(t, "1446-09-01", h.String())
+		assert.Equal(t, UmmAlQura, h.Variant())
+	})
+
+	t.Run("invalid year", func(t *testing.T) {
+		assert.Error(t, NewHijri(0, 1, 1).Error)
+		assert.Error(t, NewHijri(10000, 1, 1).Error)
+		assert.Error(t, NewHijri(1299, 1, 1, UmmAlQura).Error)
+		assert.Error(t, NewHijri(1501, 1, 1, UmmAlQura).Error)
+	})
+
+	t.Run("invalid month", func(t *testing.T) {
+		assert.Error(t, NewHijri(1446, 0, 1).Error)
+		assert.Error(t, NewHijri(1446, 13, 1).Error)
+	})
+
+	t.Run("invalid day", func(t *testing.T) {
+		assert.Error(t, NewHijri(1446, 1, 0).Error)
+		assert.Error(t, NewHijri(1446, 2, 30).Error)
+		// 1446 is not a leap year in the tabular calendar
+		assert.Error(t, NewHijri(1446, 12, 30).Error)
+		// Ramadan 1446 has 29 days in the Umm al-Qura calendar
+		assert.Error(t, NewHijri(1446, 9, 30, UmmAlQura).Error)
+	})
+
+	t.Run("invalid variant", func(t *testing.T) {
+		h := NewHijri(1446, 1, 1, "xxx")
+		assert.Error(t, h.Error)
+		assert.Empty(t, h.String())
+	})
+}
+
+func TestFromStdTime(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Riyadh")
+
+	t.Run("zero time", func(t *testing.T) {
+		assert.Nil(t, FromStdTime(time.Time{}))
+		assert.Nil(t, FromStdTime(time.Time{}.In(loc), UmmAlQura))
+	})
+
+	t.Run("tabular", func(t *testing.T) {
+		assert.Equal(t, "0001-01-01", FromStdTime(time.Date(622, 7, 19, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "1445-12-30", FromStdTime(time.Date(2024, 7, 7, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "1446-01-01", FromStdTime(time.Date(2024, 7, 8, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "1446-09-01", FromStdTime(time.Date(2025, 3, 1, 0, 0, 0, 0, loc)).String())
+	})
+
+	t.Run("umm al-qura", func(t *testing.T) {
+		assert.Equal(t, "1446-01-01", FromStdTime(time.Date(2024, 7, 7, 0, 0, 0, 0, loc), UmmAlQura).String())
+		assert.Equal(t, "1446-09-01", FromStdTime(time.Date(2025, 3, 1, 0, 0, 0, 0, loc), UmmAlQura).String())
+		assert.Equal(t, "1300-01-01", FromStdTime(time.Date(1882, 11, 12, 0, 0, 0, 0, loc), UmmAlQura).String())
+		assert.Equal(t, "1500-12-30", FromStdTime(time.Date(2077, 11, 16, 0, 0, 0, 0, loc), UmmAlQura).String())
+	})
+
+	t.Run("out of umm al-qura", func(t *testing.T) {
+		assert.Empty(t, FromStdTime(time.Date(1882, 11, 11, 0, 0, 0, 0, loc), UmmAlQura).String())
+		assert.Empty(t, FromStdTime(time.Date(2077, 11, 17, 0, 0, 0, 0, loc), UmmAlQura).String())
+	})
+}
+
+func TestHijri_ToGregorian(t *testing.T) {
+	t.Run("invalid hijri", func(t *testing.T) {
+		assert.Empty(t, new(Hijri).ToGregorian().String())
+		assert.Empty(t, NewHijri(0, 1, 1).ToGregorian().String())
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		g := NewHijri(1446, 1, 1).ToGregorian("xxx")
+		assert.Error(t, g.Error)
+		assert.Empty(t, g.String())
+	})
+
+	t.Run("without timezone", func(t *testing.T) {
+		assert.Equal(t, "2024-07-08 00:00:00 +0000 UTC", NewHijri(1446, 1, 1).ToGregorian().String())
+		assert.Equal(t, "2024-07-07 00:00:00 +0000 UTC", NewHijri(1446, 1, 1, UmmAlQura).ToGregorian().String())
+	})
+
+	t.Run("with timezone", func(t *testing.T) {
+		assert.Equal(t, "2024-07-08 00:00:00 +0300 +03", NewHijri(1446, 1, 1).ToGregorian("Asia/Riyadh").String())
+		assert.Equal(t, "2024-07-07 00:00:00 +0300 +03", NewHijri(1446, 1, 1, UmmAlQura).ToGregorian("Asia/Riyadh").String())
+	})
+}
+
+func TestHijri_Year(t *testing.T) {
+	assert.Zero(t, new(Hijri).Year())
+	assert.Equal(t, 1446, NewHijri(1446, 9, 1).Year())
+}
+
+func TestHijri_Month(t *testing.T) {
+	assert.Zero(t, new(Hijri).Month())
+	assert.Equal(t, 9, NewHijri(1446, 9, 1).Month())
+}
+
+func TestHijri_Day(t *testing.T) {
+	assert.Zero(t, new(Hijri).Day())
+	assert.Equal(t, 1, NewHijri(1446, 9, 1).Day())
+}
+
+func TestHijri_Variant(t *testing.T) {
+	assert.Empty(t, new(Hijri).Variant())
+	assert.Equal(t, Tabular, NewHijri(1446, 9, 1).Variant())
+	assert.Equal(t, UmmAlQura, NewHijri(1446, 9, 1, UmmAlQura).Variant())
+}
+
+func TestHijri_DaysInMonth(t *testing.T) {
+	assert.Zero(t, new(Hijri).DaysInMonth())
+	assert.Equal(t, 30, NewHijri(1446, 1, 1).DaysInMonth())
+	assert.Equal(t, 29, NewHijri(1446, 2, 1).DaysInMonth())
+	assert.Equal(t, 29, NewHijri(1446, 12, 1).DaysInMonth())
+	assert.Equal(t, 30, NewHijri(1445, 12, 1).DaysInMonth())
+	assert.Equal(t, 29, NewHijri(1446, 9, 1, UmmAlQura).DaysInMonth())
+	assert.Equal(t, 30, NewHijri(1445, 9, 1, UmmAlQura).DaysInMonth())
+}
+
+func TestHijri_DaysInYear(t *testing.T) {
+	assert.Zero(t, new(Hijri).DaysInYear())
+	assert.Equal(t, 355, NewHijri(1445, 1, 1).DaysInYear())
+	assert.Equal(t, 354, NewHijri(1446, 1, 1).DaysInYear())
+	assert.Equal(t, 354, NewHijri(1445, 1, 1, UmmAlQura).DaysInYear())
+}
+
+func TestHijri_String(t *testing.T) {
+	assert.Empty(t, new(Hijri).String())
+	assert.Equal(t, "0001-01-01", NewHijri(1, 1, 1).String())
+	assert.Equal(t, "1446-09-01", NewHijri(1446, 9, 1).String())
+}
+
+func TestHijri_ToMonthString(t *testing.T) {
+	t.Run("invalid hijri", func(t *testing.T) {
+		assert.Empty(t, new(Hijri).ToMonthString())
+	})
+
+	t.Run("invalid locale", func(t *testing.T) {
+		assert.Empty(t, NewHijri(1446, 9, 1).ToMonthString("xxx"))
+	})
+
+	t.Run("valid hijri", func(t *testing.T) {
+		assert.Equal(t, "Muharram", NewHijri(1446, 1, 1).ToMonthString())
+		assert.Equal(t, "Ramadan", NewHijri(1446, 9, 1).ToMonthString(EnLocale))
+		assert.Equal(t, "Dhu al-Hijjah", NewHijri(1446, 12, 1).ToMonthString(EnLocale))
+		assert.Equal(t, "محرم", NewHijri(1446, 1, 1).ToMonthString(ArLocale))
+		assert.Equal(t, "رمضان", NewHijri(1446, 9, 1).ToMonthString(ArLocale))
+		assert.Equal(t, "ذو الحجة", NewHijri(1446, 12, 1).ToMonthString(ArLocale))
+	})
+}
+
+func TestHijri_ToWeekString(t *testing.T) {
+	t.Run("invalid hijri", func(t *testing.T) {
+		assert.Empty(t, new(Hijri).ToWeekString())
+	})
+
+	t.Run("invalid locale", func(t *testing.T) {
+		assert.Empty(t, NewHijri(1446, 9, 1).ToWeekString("xxx"))
+	})
+
+	t.Run("valid hijri", func(t *testing.T) {
+		// 2025-03-01 is a Saturday
+		assert.Equal(t, "As-Sabt", NewHijri(1446, 9, 1).ToWeekString())
+		assert.Equal(t, "السبت", NewHijri(1446, 9, 1).ToWeekString(ArLocale))
+		// 622-07-16 in the julian calendar is a Friday
+		assert.Equal(t, "Al-Jumuah", NewHijri(1, 1, 1).ToWeekString(EnLocale))
+		assert.Equal(t, "الجمعة", NewHijri(1, 1, 1).ToWeekString(ArLocale))
+	})
+}
+
+func TestHijri_MonthName(t *testing.T) {
+	t.Run("invalid hijri", func(t *testing.T) {
+		assert.Empty(t, new(Hijri).MonthName(""))
+		assert.Empty(t, NewHijri(1446, 9, 1).MonthName("xxx"))
+	})
+
+	t.Run("valid hijri", func(t *testing.T) {
+		h := NewHijri(1446, 9, 1)
+		assert.Equal(t, "Ramadan", h.MonthName(""))
+		assert.Equal(t, "Ramadan", h.MonthName("en"))
+		assert.Equal(t, "رمضان", h.MonthName("ar"))
+	})
+}
+
+func TestHijri_WeekName(t *testing.T) {
+	t.Run("invalid hijri", func(t *testing.T) {
+		assert.Empty(t, new(Hijri).WeekName(""))
+		assert.Empty(t, NewHijri(1446, 9, 1).WeekName("xxx"))
+	})
+
+	t.Run("valid hijri", func(t *testing.T) {
+		h := NewHijri(1446, 9, 1)
+		assert.Equal(t, "As-Sabt", h.WeekName(""))
+		assert.Equal(t, "As-Sabt", h.WeekName("en"))
+		assert.Equal(t, "السبت", h.WeekName("ar"))
+	})
+}
+
+func TestHijri_Calendar(t *testing.T) {
+	t.Run("zero time", func(t *testing.T) {
+		for _, name := range []string{"hijri", "hijri-umm-al-qura"} {
+			converter, _, ok := calendar.Lookup(name)
+			assert.True(t, ok)
+			c := converter(time.Time{})
+			assert.NotNil(t, c)
+			assert.False(t, c.IsValid())
+			assert.Empty(t, c.String())
+		}
+	})
+
+	t.Run("tabular", func(t *testing.T) {
+		converter, creator, ok := calendar.Lookup("hijri")
+		assert.True(t, ok)
+		assert.Equal(t, "1445-12-30", converter(time.Date(2024, 7, 7, 0, 0, 0, 0, time.UTC)).String())
+
+		_, err := creator(1446, 12, 30)
+		assert.Error(t, err)
+
+		c, err := creator(1446, 1, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, "2024-07-08 00:00:00 +0000 UTC", c.ToGregorian().String())
+	})
+
+	t.Run("umm al-qura", func(t *testing.T) {
+		converter, creator, ok := calendar.Lookup("hijri-umm-al-qura")
+		assert.True(t, ok)
+		assert.Equal(t, "1446-01-01", converter(time.Date(2024, 7, 7, 0, 0, 0, 0, time.UTC)).String())
+
+		_, err := creator(1501, 1, 1)
+		assert.Error(t, err)
+
+		c, err := creator(1446, 1, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, "2024-07-07 00:00:00 +0000 UTC", c.ToGregorian().String())
+	})
+}
+
+func TestHijri_IsValid(t *testing.T) {
+	assert.False(t, (*Hijri)(nil).IsValid())
+	assert.False(t, new(Hijri).IsValid())
+	assert.False(t, NewHijri(1446, 13, 1).IsValid())
+	assert.False(t, NewHijri(1501, 1, 1, UmmAlQura).IsValid())
+	assert.True(t, NewHijri(1, 1, 1).IsValid())
+	assert.True(t, NewHijri(9999, 12, 29).IsValid())
+	assert.True(t, NewHijri(1445, 12, 30).IsValid())
+	assert.True(t, NewHijri(1500, 12, 30, UmmAlQura).IsValid())
+}
+
+func TestHijri_IsLeapYear(t *testing.T) {
+	t.Run("invalid hijri", func(t *testing.T) {
+		assert.False(t, new(Hijri).IsLeapYear())
+		assert.False(t, NewHijri(0, 1, 1).IsLeapYear())
+	})
+
+	t.Run("tabular", func(t *testing.T) {
+		// leap years are 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of a 30-year cycle
+		leapYears := map[int]bool{2: true, 5: true, 7: true, 10: true, 13: true, 16: true, 18: true, 21: true, 24: true, 26: true, 29: true}
+		for year := 1; year <= 30; year++ {
+			assert.Equal(t, leapYears[year], NewHijri(year, 1, 1).IsLeapYear(), "year %d", year)
+			assert.Equal(t, leapYears[year], NewHijri(year+1440, 1, 1).IsLeapYear(), "year %d", year+1440)
+		}
+	})
+
+	t.Run("umm al-qura", func(t *testing.T) {
+		assert.True(t, NewHijri(1443, 1, 1, UmmAlQura).IsLeapYear())
+		assert.False(t, NewHijri(1445, 1, 1, UmmAlQura).IsLeapYear())
+	})
+}
+
+// TestHijriWithAuthorityData validates Hijri calendar conversion using authoritative test data
+func TestHijriWithAuthorityData(t *testing.T) {
+	data, err := os.ReadFile("hijri_test_data.json")
+	if err != nil {
+		t.Skipf("Unable to read test data file: %v", err)
+	}
+
+	var testCases []struct {
+		Description string  `json:"description"`
+		Variant     Variant `json:"variant"`
+		Hijri       struct {
+			Year  int `json:"year"`
+			Month int `json:"month"`
+			Day   int `json:"day"`
+		} `json:"hijri"`
+		Gregorian struct {
+			Year  int `json:"year"`
+			Month int `json:"month"`
+			Day   int `json:"day"`
+		} `json:"gregorian"`
+	}
+
+	if err := json.Unmarshal(data, &testCases); err != nil {
+		t.Fatalf("Failed to parse test data: %v", err)
+	}
+
+	t.Logf("Loaded %d authoritative test cases", len(testCases))
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Authority_Data_%d_%s", i+1, tc.Description), func(t *testing.T) {
+			h := NewHijri(tc.Hijri.Year, tc.Hijri.Month, tc.Hijri.Day, tc.Variant)
+			if !assert.True(t, h.IsValid(), "Hijri date is invalid") {
+				return
+			}
+			expected := time.Date(tc.Gregorian.Year, time.Month(tc.Gregorian.Month), tc.Gregorian.Day, 0, 0, 0, 0, time.UTC)
+			assert.Equal(t, expected, h.ToGregorian().Time, "Hijri %s (%s) to Gregorian", h, tc.Variant)
+
+			actual := FromStdTime(expected, tc.Variant)
+			assert.Equal(t, h.String(), actual.String(), "Gregorian %s to Hijri (%s)", expected.Format("2006-01-02"), tc.Variant)
+		})
+	}
+}
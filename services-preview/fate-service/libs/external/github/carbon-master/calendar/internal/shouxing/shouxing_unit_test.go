@@ -0,0 +1,76 @@
+package shouxing
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSunLonDays(t *testing.T) {
+	t.Run("vernal equinox", func(t *testing.T) {
+		// 1999-03-21 01:46 UTC
+		assert.InDelta(t, -286.4265, SunLonDays(0), 0.0001)
+		// 2020-03-20 03:49 UTC
+		assert.InDelta(t, 7383.6595, SunLonDays(21*2*math.Pi), 0.0001)
+	})
+}
+
+func TestShuo(t *testing.T) {
+	t.Run("modern new moon", func(t *testing.T) {
+		// 2020-01-25, the Spring Festival of 2020
+		assert.Equal(t, float64(7329), Shuo(7320))
+		assert.Equal(t, float64(7329), Shuo(7329))
+		assert.Equal(t, float64(7329), Shuo(7335))
+	})
+
+	t.Run("historical new moon", func(t *testing.T) {
+		assert.Equal(t, float64(-100014), Shuo(-100000))
+		assert.Equal(t, float64(-500007), Shuo(-500000))
+	})
+}
+
+func TestQi(t *testing.T) {
+	t.Run("modern solar term", func(t *testing.T) {
+		// 2020-12-21, the winter solstice of 2020
+		assert.Equal(t, float64(7660), Qi(7655))
+		assert.Equal(t, float64(7660), Qi(7660))
+		assert.Equal(t, float64(7660), Qi(7665))
+	})
+
+	t.Run("historical solar term", func(t *testing.T) {
+		assert.Equal(t, float64(-99997), Qi(-100000))
+	})
+}
+
+func TestShuoAt(t *testing.T) {
+	t.Run("beijing time", func(t *testing.T) {
+		for jd := float64(-14000); jd < 80000; jd += 97 {
+			assert.Equal(t, Shuo(jd), ShuoAt(jd, oneThird), "jd %v", jd)
+		}
+	})
+
+	t.Run("other meridians", func(t *testing.T) {
+		// the new moon of 2007-02-17 16:14 UTC, which is 2007-02-18 in Beijing time but 2007-02-17 in Hanoi time
+		assert.Equal(t, float64(2605), ShuoAt(2600, float64(8)/24))
+		assert.Equal(t, float64(2604), ShuoAt(2600, float64(7)/24))
+		// the new moon of 1997-02-07 15:06 UTC, which is 1997-02-07 in Beijing time but 1997-02-08 in Seoul time
+		assert.Equal(t, float64(-1058), ShuoAt(-1060, float64(8)/24))
+		assert.Equal(t, float64(-1057), ShuoAt(-1060, float64(9)/24))
+	})
+}
+
+func TestQiAt(t *testing.T) {
+	t.Run("beijing time", func(t *testing.T) {
+		for jd := float64(-14000); jd < 80000; jd += 97 {
+			assert.Equal(t, Qi(jd), QiAt(jd, oneThird), "jd %v", jd)
+		}
+	})
+
+	t.Run("other meridians", func(t *testing.T) {
+		// the winter solstice of 2020-12-21 10:02 UTC
+		assert.Equal(t, float64(7660), QiAt(7655, float64(7)/24))
+		assert.Equal(t, float64(7660), QiAt(7655, float64(9)/24))
+		assert.Equal(t, float64(7659), QiAt(7655, float64(-11)/24))
+	})
+}
@@ -0,0 +1,261 @@
+package lunar
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dromara/carbon/v2/calendar/internal/shouxing"
+)
+
+const (
+	// the julian day number of 1970-01-01
+	unixEpochJDN = 2440588
+
+	// max lunar years cached by astronomicalYears
+	maxCachedAstronomicalYears = 4096
+)
+
+var (
+	// lunar years whose leap month is the 11th month in historical calendars, which are sorted in ascending order
+	leap11Years = []int{
+		75, 94, 170, 265, 322, 398, 469, 553, 583, 610, 678, 735, 754, 773, 849, 887, 936, 1050, 1069, 1126, 1145,
+		1164, 1183, 1259, 1278, 1308, 1373, 1403, 1441, 1460, 1498, 1555, 1593, 1612, 1631, 1642, 2033, 2128, 2147,
+		2242, 2614, 2728, 2910, 3062, 3244, 3339, 3616, 3711, 3730, 3825, 4007, 4159, 4197, 4322, 4341, 4379, 4417,
+		4531, 4599, 4694, 4713, 4789, 4808, 4971, 5085, 5104, 5161, 5180, 5199, 5294, 5305, 5476, 5677, 5696, 5772,
+		5791, 5848, 5886, 6049, 6068, 6144, 6163, 6258, 6402, 6440, 6497, 6516, 6630, 6641, 6660, 6679, 6736, 6774,
+		6850, 6869, 6899, 6918, 6994, 7013, 7032, 7051, 7070, 7089, 7108, 7127, 7146, 7222, 7271, 7290, 7309, 7366,
+		7385, 7404, 7442, 7461, 7480, 7491, 7499, 7594, 7624, 7643, 7662, 7681, 7719, 7738, 7814, 7863, 7882, 7901,
+		7939, 7958, 7977, 7996, 8034, 8053, 8072, 8091, 8121, 8159, 8186, 8216, 8235, 8254, 8273, 8311, 8330, 8341,
+		8349, 8368, 8444, 8463, 8474, 8493, 8531, 8569, 8588, 8626, 8664, 8683, 8694, 8702, 8713, 8721, 8751, 8789,
+		8808, 8816, 8827, 8846, 8884, 8903, 8922, 8941, 8971, 9036, 9066, 9085, 9104, 9123, 9142, 9161, 9180, 9199,
+		9218, 9256, 9294, 9313, 9324, 9343, 9362, 9381, 9419, 9438, 9476, 9514, 9533, 9544, 9552, 9563, 9571, 9582,
+		9601, 9639, 9658, 9666, 9677, 9696, 9734, 9753, 9772, 9791, 9802, 9821, 9886, 9897, 9916, 9935, 9954, 9973,
+		9992,
+	}
+
+	// lunar years whose leap month is the 12th month in historical calendars, which are sorted in ascending order
+	leap12Years = []int{
+		37, 56, 113, 132, 151, 189, 208, 227, 246, 284, 303, 341, 360, 379, 417, 436, 458, 477, 496, 515, 534, 572,
+		591, 629, 648, 667, 697, 716, 792, 811, 830, 868, 906, 925, 944, 963, 982, 1001, 1020, 1039, 1058, 1088, 1153,
+		1202, 1221, 1240, 1297, 1335, 1392, 1411, 1422, 1430, 1517, 1525, 1536, 1574, 3358, 3472, 3806, 3988, 4751,
+		4941, 5066, 5123, 5275, 5343, 5438, 5457, 5495, 5533, 5552, 5715, 5810, 5829, 5905, 5924, 6421, 6535, 6793,
+		6812, 6888, 6907, 7002, 7184, 7260, 7279, 7374, 7556, 7746, 7757, 7776, 7833, 7852, 7871, 7966, 8015, 8110,
+		8129, 8148, 8224, 8243, 8338, 8406, 8425, 8482, 8501, 8520, 8558, 8596, 8607, 8615, 8645, 8740, 8778, 8835,
+		8865, 8930, 8960, 8979, 8998, 9017, 9055, 9074, 9093, 9112, 9150, 9188, 9237, 9275, 9332, 9351, 9370, 9408,
+		9427, 9446, 9457, 9465, 9495, 9560, 9590, 9628, 9647, 9685, 9715, 9742, 9780, 9810, 9818, 9829, 9848, 9867,
+		9905, 9924, 9943, 9962, 10000,
+	}
+
+	// month numbers in order starting from the 11th month that contains the winter solstice
+	monthsFromSolstice = []int{11, 12, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	// caches months of lunar years computed from astronomical new moons by astronomicalYear,
+	// astronomicalYearCount counts them
+	astronomicalYears     sync.Map
+	astronomicalYearCount int32
+)
+
+// astronomicalYear defines the key of lunar years computed from astronomical new moons at the meridian.
+type astronomicalYear struct {
+	year     int
+	meridian Meridian
+}
+
+// astronomicalMonth defines a lunar month computed from astronomical new moons.
+type astronomicalMonth struct {
+	month       int
+	isLeapMonth bool
+	days        int
+	// julian day number of the first day
+	jdn int
+}
+
+// gets months of the lunar year computed from astronomical new moons and solar terms in the local time of the meridian,
+// the leap month is the first month without a major solar term in a year of 13 months.
+func getAstronomicalMonths(year int, meridian Meridian) []astronomicalMonth {
+	key := astronomicalYear{year: year, meridian: meridian}
+	if months, ok := astronomicalYears.Load(key); ok {
+		return months.([]astronomicalMonth)
+	}
+	months := make([]astronomicalMonth, 0, 13)
+	for _, m := range computeMonthsFromSolstice(year, meridian) {
+		if m.year == year {
+			months = append(months, m.astronomicalMonth)
+		}
+	}
+	// meridians and years may come from users, so only the named meridians are cached and the cache is bounded
+	if meridian.isNamed() && atomic.LoadInt32(&astronomicalYearCount) < maxCachedAstronomicalYears {
+		if _, loaded := astronomicalYears.LoadOrStore(key, months); !loaded {
+			atomic.AddInt32(&astronomicalYearCount, 1)
+		}
+	}
+	return months
+}
+
+// yearMonth defines an astronomical month with the lunar year it belongs to.
+type yearMonth struct {
+	year int
+	astronomicalMonth
+}
+
+// computes 15 months starting from the 11th month of the previous lunar year, ported from 6tail/lunar-go,
+// the historical chinese calendars are only followed at ChinaMeridian.
+func computeMonthsFromSolstice(year int, meridian Meridian) []yearMonth {
+	historical := meridian == ChinaMeridian
+	qi, shuo := shouxing.Qi, shouxing.Shuo
+	if !historical {
+		offset := float64(meridian) / 360
+		qi = func(jd float64) float64 { return shouxing.QiAt(jd, offset) }
+		shuo = func(jd float64) float64 { return shouxing.ShuoAt(jd, offset) }
+	}
+
+	// solar terms from the winter solstice of the previous year
+	jq := make([]float64, 26)
+	// new moons, the first days of months
+	hs := make([]float64, 16)
+	months := make([]int, 15)
+	if !historical {
+		// another year is needed to find the leap month following the winter solstice of this year
+		jq, hs = make([]float64, 50), make([]float64, 29)
+	}
+
+	jd := math.Floor(float64(year-2000)*365.2422 + 180)
+	// 355 is the winter solstice of 2000
+	w := math.Floor((jd-355+183)/365.2422)*365.2422 + 355
+	if qi(w) > jd {
+		w -= 365.2422
+	}
+	for i := range jq {
+		jq[i] = qi(w + 15.2184*float64(i))
+	}
+	// the new moon before the winter solstice
+	w = shuo(jq[0])
+	if w > jq[0] {
+		w -= 29.53
+	}
+	for i := range hs {
+		hs[i] = shuo(w + 29.5306*float64(i))
+	}
+	for i := 0; i < 15; i++ {
+		months[i] = i
+	}
+
+	leapIndex := 16
+	switch {
+	case historical && containsYear(leap11Years, year):
+		leapIndex = 13
+	case historical && containsYear(leap12Years, year):
+		leapIndex = 14
+	case hs[13] <= jq[24]:
+		leapIndex = getLeapIndex(hs, jq)
+	case !historical:
+		// the leap month of the next year of 13 months belongs to this lunar year if it's the 11th or 12th month
+		if i := getLeapIndex(hs[12:], jq[24:]); i == 1 || i == 2 {
+			leapIndex = 12 + i
+		}
+	}
+	for i := leapIndex; i < 15; i++ {
+		months[i]--
+	}
+
+	result := make([]yearMonth, 15)
+	prev, y := -1, year-1
+	for i := 0; i < 15; i++ {
+		dm := hs[i] + shouxing.J2000
+		mc := monthsFromSolstice[months[i]%12]
+		// the calendars of Wang Mang and Emperor Ming of Wei started years from the 12th month
+		if historical {
+			switch {
+			case 1724360 <= dm && dm < 1729794, 1807724 <= dm && dm < 1808699:
+				mc = monthsFromSolstice[(months[i]+1)%12]
+			case dm == 1729794 || dm == 1808699:
+				mc = 12
+			}
+		}
+		if prev != -1 && mc < prev {
+			y++
+		}
+		prev = mc
+		m := astronomicalMonth{month: mc, isLeapMonth: i == leapIndex, days: int(hs[i+1] - hs[i]), jdn: int(dm)}
+		if historical && !m.isLeapMonth && (dm == 1729794 || dm == 1808699) {
+			m.month, m.isLeapMonth = 11, true
+		}
+		result[i] = yearMonth{year: y, astronomicalMonth: m}
+	}
+	return result
+}
+
+// gets the index of the leap month in 13 months from the month containing the winter solstice jq[0],
+// which is the first month without a major solar term, or 0 if there are only 12 months until the next winter solstice.
+func getLeapIndex(hs, jq []float64) int {
+	if hs[13] > jq[24] {
+		return 0
+	}
+	i := 1
+	for hs[i+1] > jq[2*i] && i < 13 {
+		i++
+	}
+	return i
+}
+
+// creates a Lunar instance from the julian day number of a date in the gregorian year by astronomical months.
+func fromAstronomicalJDN(year, jdn int, meridian Meridian) *Lunar {
+	for _, y := range []int{year, year - 1, year + 1} {
+		for _, m := range getAstronomicalMonths(y, meridian) {
+			if jdn >= m.jdn && jdn < m.jdn+m.days {
+				return &Lunar{year: y, month: m.month, day: jdn - m.jdn + 1, isLeapMonth: m.isLeapMonth, meridian: meridian}
+			}
+		}
+	}
+	return &Lunar{year: year, meridian: meridian}
+}
+
+// gets the julian day number of the lunar date by astronomical months, which is false if the month doesn't exist.
+func (l *Lunar) astronomicalJDN() (int, bool) {
+	for _, m := range getAstronomicalMonths(l.year, l.meridian) {
+		if m.month == l.month && m.isLeapMonth == l.isLeapMonth {
+			return m.jdn + l.day - 1, true
+		}
+	}
+	return 0, false
+}
+
+// gets the leap month of the lunar year by astronomical months, which is 0 if the year has no leap month.
+func getAstronomicalLeapMonth(year int, meridian Meridian) int {
+	for _, m := range getAstronomicalMonths(year, meridian) {
+		if m.isLeapMonth {
+			return m.month
+		}
+	}
+	return 0
+}
+
+// reports whether the lunar date is computed from the table rather than astronomical new moons.
+func (l *Lunar) inTable() bool {
+	return l.meridian == ChinaMeridian && l.year >= minYear && l.year <= maxYear
+}
+
+// gets the offset of the local time of the meridian from UTC in seconds.
+func (m Meridian) offset() int64 {
+	return int64(math.Round(float64(m) * 240))
+}
+
+// reports whether the meridian is ChinaMeridian, VietnamMeridian or KoreaMeridian.
+func (m Meridian) isNamed() bool {
+	return m == ChinaMeridian || m == VietnamMeridian || m == KoreaMeridian
+}
+
+// reports whether the year is in the sorted years.
+func containsYear(years []int, year int) bool {
+	i := sort.SearchInts(years, year)
+	return i < len(years) && years[i] == year
+}
+
+// gets the julian day number of the date of the standard time.Time in its location.
+func getJDN(t time.Time) int {
+	y, m, d := t.Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix()/86400) + unixEpochJDN
+}
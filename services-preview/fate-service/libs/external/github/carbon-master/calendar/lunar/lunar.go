@@ -55,35 +55,84 @@ var (
 		0x0d520, // 2100
 	}
 
+	// the range of years in the table, other years are computed from astronomical new moons
 	maxYear = 2100
 	minYear = 1900
+
+	// the range of supported years
+	maxValidYear = 9999
+	minValidYear = 1
+
+	// the julian day numbers of the first days of the table, which are 1900-01-31 and 2101-01-29
+	minTableJDN = 2415051
+	maxTableJDN = minTableJDN + getOffsetInMonth(maxYear+1)
 )
 
+// Meridian defines the meridian in degrees east of Greenwich at which new moons and solar terms are reckoned.
+type Meridian float64
+
+const (
+	// ChinaMeridian is the meridian of the chinese lunar calendar in Beijing time (UTC+8).
+	ChinaMeridian Meridian = 120
+	// VietnamMeridian is the meridian of the vietnamese lunar calendar (âm lịch) in Indochina time (UTC+7).
+	VietnamMeridian Meridian = 105
+	// KoreaMeridian is the meridian of the korean lunar calendar (음력) in Korea standard time (UTC+9).
+	KoreaMeridian Meridian = 135
+
+	defaultMeridian = ChinaMeridian
+)
+
+func init() {
+	for name, meridian := range map[string]Meridian{"lunar": ChinaMeridian, "lunar-vietnam": VietnamMeridian, "lunar-korea": KoreaMeridian} {
+		meridian := meridian
+		calendar.Register(name, func(t time.Time) calendar.Calendar {
+			if l := FromStdTime(t, meridian); l != nil {
+				return l
+			}
+			return &Lunar{meridian: meridian}
+		}, func(year, month, day int) (calendar.Calendar, error) {
+			l := NewLunar(year, month, day, false, meridian)
+			return l, l.Error
+		})
+	}
+}
+
 // Lunar defines a Lunar struct.
 type Lunar struct {
 	year, month, day int
 	isLeapMonth      bool
+	meridian         Meridian
 	Error            error
 }
 
-// NewLunar returns a new Lunar instance.
-func NewLunar(year, month, day int, isLeapMonth bool) *Lunar {
+// NewLunar returns a new Lunar instance, meridian is ChinaMeridian by default.
+func NewLunar(year, month, day int, isLeapMonth bool, meridian ...Meridian) *Lunar {
 	l := new(Lunar)
-	l.year, l.month, l.day, l.isLeapMonth = year, month, day, isLeapMonth
+	l.year, l.month, l.day, l.isLeapMonth, l.meridian = year, month, day, isLeapMonth, defaultMeridian
+	if len(meridian) > 0 {
+		l.meridian = meridian[0]
+	}
 	if !l.IsValid() {
 		if !l.IsValid() {
-			l.Error = fmt.Errorf("invalid persian date: %04d-%02d-%02d", year, month, day)
+			l.Error = fmt.Errorf("invalid lunar date: %04d-%02d-%02d", year, month, day)
 		}
 	}
 	return l
 }
 
-// FromStdTime creates a Lunar instance from standard time.Time.
-func FromStdTime(t time.Time) *Lunar {
-	l := new(Lunar)
+// FromStdTime creates a Lunar instance from standard time.Time, meridian is ChinaMeridian by default.
+// Lunar dates at other meridians are always computed from astronomical new moons and solar terms.
+func FromStdTime(t time.Time, meridian ...Meridian) *Lunar {
+	l := &Lunar{meridian: defaultMeridian}
 	if t.IsZero() {
 		return nil
 	}
+	if len(meridian) > 0 {
+		l.meridian = meridian[0]
+	}
+	if jdn := getJDN(t); l.meridian != ChinaMeridian || jdn < minTableJDN || jdn >= maxTableJDN {
+		return fromAstronomicalJDN(t.Year(), jdn, l.meridian)
+	}
 	daysInYear, daysInMonth, leapMonth := 365, 30, 0
 
 	offset := int(t.Truncate(time.Hour).Sub(time.Date(minYear, 1, 31, 0, 0, 0, 0, t.Location())).Hours() / 24)
@@ -138,6 +187,13 @@ func (l *Lunar) ToGregorian(timezone ...string) *calendar.Gregorian {
 	if g.Error != nil {
 		return g
 	}
+	if !l.inTable() {
+		jdn, ok := l.astronomicalJDN()
+		if ok {
+			g.Time = time.Unix(int64(jdn-unixEpochJDN)*86400-l.meridian.offset(), 0).In(loc)
+		}
+		return g
+	}
 	days := getDaysInMonth(l.year, l.month)
 	offset := getOffsetInYear(l.year, l.month)
 	offset += getOffsetInMonth(l.year)
@@ -197,9 +253,20 @@ func (l *Lunar) LeapMonth() int {
 	if !l.IsValid() {
 		return 0
 	}
+	if l.meridian != ChinaMeridian {
+		return getAstronomicalLeapMonth(l.year, l.meridian)
+	}
 	return getLeapMonth(l.year)
 }
 
+// Meridian gets the meridian at which the lunar date is reckoned like 120.
+func (l *Lunar) Meridian() Meridian {
+	if !l.IsValid() {
+		return 0
+	}
+	return l.meridian
+}
+
 // String implements "Stringer" interface for Lunar.
 func (l *Lunar) String() string {
 	if !l.IsValid() {
@@ -240,6 +307,18 @@ func (l *Lunar) ToWeekString() (month string) {
 	return weeks[l.ToGregorian().Time.Weekday()]
 }
 
+// MonthName implements the calendar.Calendar interface, it outputs the month name like "正月",
+// only chinese is supported so the locale is ignored.
+func (l *Lunar) MonthName(locale string) string {
+	return l.ToMonthString()
+}
+
+// WeekName implements the calendar.Calendar interface, it outputs the week name like "周一",
+// only chinese is supported so the locale is ignored.
+func (l *Lunar) WeekName(locale string) string {
+	return l.ToWeekString()
+}
+
 // ToDayString outputs a string in lunar day format like "廿一".
 func (l *Lunar) ToDayString() (day string) {
 	if !l.IsValid() {
@@ -277,7 +356,7 @@ func (l *Lunar) IsValid() bool {
 	if l == nil || l.Error != nil {
 		return false
 	}
-	if l.year >= minYear && l.year <= maxYear {
+	if l.year >= minValidYear && l.year <= maxValidYear {
 		return true
 	}
 	return false
@@ -504,7 +583,10 @@ func getDaysInLeapMonth(year int) int {
 // getLeapMonth determines which month is the leap month in a lunar year.
 // Returns 0 if the year has no leap month, or the month number (1-12) if a leap month exists.
 // The leap month information is stored in the lower 4 bits of the lunar calendar data.
-// Returns 0 for years outside the supported range (1900-2100).
+// Years outside the table (1900-2100) are computed from astronomical new moons.
 func getLeapMonth(year int) int {
+	if year < minYear || year > maxYear {
+		return getAstronomicalLeapMonth(year, ChinaMeridian)
+	}
 	return years[year-minYear] & 0xf
 }
@@ -37,6 +37,42 @@ func BenchmarkToGregorian(b *testing.B) {
 	}
 }
 
+func BenchmarkFromStdTimeOutOfTable(b *testing.B) {
+	testDates := []time.Time{
+		time.Date(1644, 2, 8, 0, 0, 0, 0, time.UTC),
+		time.Date(1800, 5, 24, 0, 0, 0, 0, time.UTC), // 闰月
+		time.Date(2200, 2, 15, 0, 0, 0, 0, time.UTC), // 春节
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		date := testDates[i%len(testDates)]
+		FromStdTime(date)
+	}
+}
+
+func BenchmarkFromStdTimeAtMeridian(b *testing.B) {
+	t := time.Date(2020, 8, 5, 0, 0, 0, 0, time.UTC)
+	b.Run("vietnam", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			FromStdTime(t, VietnamMeridian)
+		}
+	})
+
+	b.Run("korea", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			FromStdTime(t, KoreaMeridian)
+		}
+	})
+}
+
+func BenchmarkComputeMonthsFromSolstice(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		computeMonthsFromSolstice(1800+i%400, ChinaMeridian)
+	}
+}
+
 func BenchmarkIsLeapYear(b *testing.B) {
 	testYears := []int{2020, 2021, 2022, 2023, 2024, 2025, 2026, 2027, 2028, 2029}
 
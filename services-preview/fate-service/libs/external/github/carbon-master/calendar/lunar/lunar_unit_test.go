@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/dromara/carbon/v2/calendar"
 )
 
 func TestFromStdTime(t *testing.T) {
@@ -26,13 +28,29 @@ func TestFromStdTime(t *testing.T) {
 		assert.Equal(t, "2023-02-11", FromStdTime(time.Date(2023, 3, 2, 0, 0, 0, 0, loc)).String())
 		assert.Equal(t, "2023-02-11", FromStdTime(time.Date(2023, 4, 1, 0, 0, 0, 0, loc)).String())
 	})
+
+	t.Run("out of table", func(t *testing.T) {
+		assert.Equal(t, "1899-12-30", FromStdTime(time.Date(1900, 1, 30, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "1800-01-01", FromStdTime(time.Date(1800, 1, 25, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "2100-12-29", FromStdTime(time.Date(2101, 1, 28, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "2101-01-01", FromStdTime(time.Date(2101, 1, 29, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "2199-12-27", FromStdTime(time.Date(2200, 2, 11, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "0001-01-06", FromStdTime(time.Date(1, 2, 15, 0, 0, 0, 0, time.UTC)).String())
+		assert.Equal(t, "9999-12-02", FromStdTime(time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)).String())
+		assert.Empty(t, FromStdTime(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)).String())
+
+		l := FromStdTime(time.Date(1800, 5, 24, 0, 0, 0, 0, loc))
+		assert.Equal(t, "1800-04-01", l.String())
+		assert.True(t, l.IsLeapMonth())
+		assert.Equal(t, 4, l.LeapMonth())
+	})
 }
 
 func TestLunar_Gregorian(t *testing.T) {
 	t.Run("invalid lunar", func(t *testing.T) {
 		assert.Empty(t, new(Lunar).ToGregorian().String())
-		assert.Error(t, NewLunar(1800, 1, 1, false).Error)
-		assert.Empty(t, NewLunar(1800, 1, 1, false).ToGregorian().String())
+		assert.Error(t, NewLunar(10000, 1, 1, false).Error)
+		assert.Empty(t, NewLunar(10000, 1, 1, false).ToGregorian().String())
 	})
 
 	t.Run("invalid timezone", func(t *testing.T) {
@@ -49,6 +67,13 @@ func TestLunar_Gregorian(t *testing.T) {
 		assert.Equal(t, "2023-03-02 00:00:00 +0800 CST", NewLunar(2023, 2, 11, false).ToGregorian("PRC").String())
 		assert.Equal(t, "2023-04-01 00:00:00 +0800 CST", NewLunar(2023, 2, 11, true).ToGregorian("PRC").String())
 	})
+
+	t.Run("out of table", func(t *testing.T) {
+		assert.Equal(t, "1800-05-23 16:00:00 +0000 UTC", NewLunar(1800, 4, 1, true).ToGregorian().String())
+		assert.Equal(t, "1800-05-24 00:05:43 +0805 LMT", NewLunar(1800, 4, 1, true).ToGregorian("PRC").String())
+		assert.Equal(t, "2200-02-15 00:00:00 +0800 CST", NewLunar(2200, 1, 1, false).ToGregorian("PRC").String())
+		assert.Empty(t, NewLunar(1800, 5, 1, true).ToGregorian().String())
+	})
 }
 
 func TestLunar_Animal(t *testing.T) {
@@ -56,7 +81,7 @@ func TestLunar_Animal(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.Empty(t, new(Lunar).Animal())
-		assert.Empty(t, NewLunar(1800, 1, 1, false).Animal())
+		assert.Empty(t, NewLunar(10000, 1, 1, false).Animal())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -80,7 +105,7 @@ func TestLunar_Festival(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.Empty(t, new(Lunar).Festival())
-		assert.Empty(t, NewLunar(1800, 1, 1, false).Festival())
+		assert.Empty(t, NewLunar(10000, 1, 1, false).Festival())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -102,7 +127,7 @@ func TestLunar_Year(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.Empty(t, new(Lunar).Year())
-		assert.Empty(t, NewLunar(1800, 1, 1, false).Year())
+		assert.Empty(t, NewLunar(10000, 1, 1, false).Year())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -117,7 +142,7 @@ func TestLunar_Month(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.Empty(t, new(Lunar).Month())
-		assert.Empty(t, NewLunar(1800, 1, 1, false).Month())
+		assert.Empty(t, NewLunar(10000, 1, 1, false).Month())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -132,7 +157,7 @@ func TestLunar_LeapMonth(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.Empty(t, new(Lunar).LeapMonth())
-		assert.Empty(t, NewLunar(1800, 1, 1, false).LeapMonth())
+		assert.Empty(t, NewLunar(10000, 1, 1, false).LeapMonth())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -146,7 +171,7 @@ func TestLunar_Day(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.Empty(t, new(Lunar).Day())
-		assert.Empty(t, NewLunar(1800, 1, 1, false).Day())
+		assert.Empty(t, NewLunar(10000, 1, 1, false).Day())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -161,7 +186,7 @@ func TestLunar_ToYearString(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.Empty(t, new(Lunar).ToYearString())
-		assert.Empty(t, NewLunar(1800, 1, 1, false).ToYearString())
+		assert.Empty(t, NewLunar(10000, 1, 1, false).ToYearString())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -176,7 +201,7 @@ func TestLunar_ToMonthString(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.Empty(t, new(Lunar).ToMonthString())
-		assert.Empty(t, NewLunar(1800, 1, 1, false).ToMonthString())
+		assert.Empty(t, NewLunar(10000, 1, 1, false).ToMonthString())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -195,7 +220,7 @@ func TestLunar_ToWeekString(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.Empty(t, new(Lunar).ToWeekString())
-		assert.Empty(t, NewLunar(1800, 1, 1, false).ToWeekString())
+		assert.Empty(t, NewLunar(10000, 1, 1, false).ToWeekString())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -205,12 +230,90 @@ func TestLunar_ToWeekString(t *testing.T) {
 	})
 }
 
+func TestLunar_MonthName(t *testing.T) {
+	loc, _ := time.LoadLocation("PRC")
+
+	t.Run("invalid time", func(t *testing.T) {
+		assert.Empty(t, new(Lunar).MonthName(""))
+	})
+
+	t.Run("valid time", func(t *testing.T) {
+		l := FromStdTime(time.Date(2023, 4, 1, 0, 0, 0, 0, loc))
+		assert.Equal(t, "闰二月", l.MonthName(""))
+		assert.Equal(t, "闰二月", l.MonthName("en"))
+	})
+}
+
+func TestLunar_WeekName(t *testing.T) {
+	loc, _ := time.LoadLocation("PRC")
+
+	t.Run("invalid time", func(t *testing.T) {
+		assert.Empty(t, new(Lunar).WeekName(""))
+	})
+
+	t.Run("valid time", func(t *testing.T) {
+		l := FromStdTime(time.Date(2020, 8, 5, 0, 0, 0, 0, loc))
+		assert.Equal(t, "周二", l.WeekName(""))
+		assert.Equal(t, "周二", l.WeekName("en"))
+	})
+}
+
+func TestLunar_Calendar(t *testing.T) {
+	loc, _ := time.LoadLocation("PRC")
+	converter, creator, ok := calendar.Lookup("lunar")
+	assert.True(t, ok)
+
+	t.Run("zero time", func(t *testing.T) {
+		c := converter(time.Time{})
+		assert.NotNil(t, c)
+		assert.False(t, c.IsValid())
+		assert.Empty(t, c.String())
+	})
+
+	t.Run("valid time", func(t *testing.T) {
+		c := converter(time.Date(2020, 8, 5, 0, 0, 0, 0, loc))
+		assert.Equal(t, "2020-06-16", c.String())
+		assert.Equal(t, 2020, c.Year())
+		assert.Equal(t, 6, c.Month())
+		assert.Equal(t, 16, c.Day())
+		assert.True(t, c.IsLeapYear())
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		_, err := creator(10000, 1, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("valid date", func(t *testing.T) {
+		c, err := creator(2020, 6, 16)
+		assert.Nil(t, err)
+		assert.Equal(t, "六月", c.MonthName(""))
+		assert.Equal(t, "2020-08-05 00:00:00 +0800 CST", c.ToGregorian("PRC").String())
+	})
+
+	t.Run("other meridians", func(t *testing.T) {
+		converter, creator, ok := calendar.Lookup("lunar-vietnam")
+		assert.True(t, ok)
+		assert.Equal(t, "1985-01-01", converter(time.Date(1985, 1, 21, 0, 0, 0, 0, time.UTC)).String())
+		c, err := creator(1985, 1, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, "1985-01-21 00:00:00 +0700 +07", c.ToGregorian("Asia/Ho_Chi_Minh").String())
+
+		converter, creator, ok = calendar.Lookup("lunar-korea")
+		assert.True(t, ok)
+		assert.Equal(t, "1997-01-01", converter(time.Date(1997, 2, 8, 0, 0, 0, 0, time.UTC)).String())
+		c, err = creator(1997, 1, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, "1997-02-08 00:00:00 +0900 KST", c.ToGregorian("Asia/Seoul").String())
+	})
+}
+
 func TestLunar_ToDayString(t *testing.T) {
 	loc, _ := time.LoadLocation("PRC")
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.Empty(t, new(Lunar).ToDayString())
-		assert.Empty(t, NewLunar(1800, 1, 1, false).ToDayString())
+		assert.Empty(t, NewLunar(10000, 1, 1, false).ToDayString())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -245,7 +348,7 @@ func TestLunar_ToDateString(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.Empty(t, new(Lunar).ToDateString())
-		assert.Empty(t, NewLunar(1800, 1, 1, false).ToDateString())
+		assert.Empty(t, NewLunar(10000, 1, 1, false).ToDateString())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -260,7 +363,7 @@ func TestLunar_IsValid(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsValid())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsValid())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsValid())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -275,7 +378,7 @@ func TestLunar_IsLeapYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsLeapYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsLeapYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsLeapYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -294,7 +397,7 @@ func TestLunar_IsLeapMonth(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsLeapMonth())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsLeapMonth())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsLeapMonth())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -313,7 +416,7 @@ func TestLunar_IsRatYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsRatYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsRatYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsRatYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -327,7 +430,7 @@ func TestLunar_IsOxYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsOxYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsOxYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsOxYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -341,7 +444,7 @@ func TestLunar_IsTigerYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsTigerYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsTigerYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsTigerYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -355,7 +458,7 @@ func TestLunar_IsRabbitYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsRabbitYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsRabbitYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsRabbitYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -369,7 +472,7 @@ func TestLunar_IsDragonYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsDragonYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsDragonYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsDragonYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -383,7 +486,7 @@ func TestLunar_IsSnakeYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsSnakeYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsSnakeYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsSnakeYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -397,7 +500,7 @@ func TestLunar_IsHorseYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsHorseYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsHorseYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsHorseYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -411,7 +514,7 @@ func TestLunar_IsGoatYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsGoatYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsGoatYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsGoatYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -425,7 +528,7 @@ func TestLunar_IsMonkeyYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsMonkeyYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsMonkeyYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsMonkeyYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -439,7 +542,7 @@ func TestLunar_IsRoosterYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsRoosterYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsRoosterYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsRoosterYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -453,7 +556,7 @@ func TestLunar_IsDogYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsDogYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsDogYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsDogYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -467,7 +570,7 @@ func TestLunar_IsPigYear(t *testing.T) {
 
 	t.Run("invalid time", func(t *testing.T) {
 		assert.False(t, new(Lunar).IsPigYear())
-		assert.False(t, NewLunar(1800, 1, 1, true).IsPigYear())
+		assert.False(t, NewLunar(10000, 1, 1, true).IsPigYear())
 	})
 
 	t.Run("valid time", func(t *testing.T) {
@@ -476,6 +579,135 @@ func TestLunar_IsPigYear(t *testing.T) {
 	})
 }
 
+func TestLunar_Astronomical(t *testing.T) {
+	t.Run("agree with table", func(t *testing.T) {
+		// years in which the table differs from astronomical new moons
+		excludes := map[int]bool{1954: true, 1956: true, 1978: true, 2033: true, 2060: true}
+		for year := minYear; year <= maxYear; year++ {
+			if excludes[year] {
+				continue
+			}
+			months := getAstronomicalMonths(year, ChinaMeridian)
+			assert.Equal(t, getLeapMonth(year), getAstronomicalLeapMonth(year, ChinaMeridian), "year %d", year)
+			for _, m := range months {
+				g := NewLunar(year, m.month, 1, m.isLeapMonth).ToGregorian()
+				assert.Equal(t, m.jdn, getJDN(g.Time.Add(8*time.Hour)), "year %d month %d", year, m.month)
+			}
+		}
+	})
+
+	t.Run("leap month in winter", func(t *testing.T) {
+		assert.Equal(t, 11, getAstronomicalLeapMonth(1642, ChinaMeridian))
+		assert.Equal(t, 11, getAstronomicalLeapMonth(2033, ChinaMeridian))
+	})
+
+	t.Run("bounded cache", func(t *testing.T) {
+		isCached := func(year int, meridian Meridian) bool {
+			_, ok := astronomicalYears.Load(astronomicalYear{year: year, meridian: meridian})
+			return ok
+		}
+
+		getAstronomicalMonths(2020, Meridian(100.5))
+		assert.False(t, isCached(2020, Meridian(100.5)))
+
+		getAstronomicalMonths(2020, VietnamMeridian)
+		assert.True(t, isCached(2020, VietnamMeridian))
+
+		count := astronomicalYearCount
+		defer func() { astronomicalYearCount = count }()
+		astronomicalYearCount = maxCachedAstronomicalYears
+		astronomicalYears.Delete(astronomicalYear{year: 2021, meridian: KoreaMeridian})
+		months := getAstronomicalMonths(2021, KoreaMeridian)
+		assert.NotEmpty(t, months)
+		assert.False(t, isCached(2021, KoreaMeridian))
+		assert.Equal(t, months, getAstronomicalMonths(2021, KoreaMeridian))
+	})
+}
+
+func TestLunar_Meridian(t *testing.T) {
+	t.Run("invalid time", func(t *testing.T) {
+		assert.Zero(t, new(Lunar).Meridian())
+		assert.Zero(t, NewLunar(10000, 1, 1, false, VietnamMeridian).Meridian())
+	})
+
+	t.Run("default meridian", func(t *testing.T) {
+		assert.Equal(t, ChinaMeridian, NewLunar(2020, 6, 16, false).Meridian())
+		assert.Equal(t, ChinaMeridian, FromStdTime(time.Date(2020, 8, 5, 0, 0, 0, 0, time.UTC)).Meridian())
+		assert.Equal(t, ChinaMeridian, FromStdTime(time.Date(1800, 8, 5, 0, 0, 0, 0, time.UTC)).Meridian())
+	})
+
+	t.Run("valid meridian", func(t *testing.T) {
+		assert.Equal(t, VietnamMeridian, NewLunar(2020, 6, 16, false, VietnamMeridian).Meridian())
+		assert.Equal(t, KoreaMeridian, FromStdTime(time.Date(2020, 8, 5, 0, 0, 0, 0, time.UTC), KoreaMeridian).Meridian())
+	})
+}
+
+func TestLunar_Vietnam(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+
+	t.Run("from std time", func(t *testing.T) {
+		// the new year of 1985 is a month earlier than the chinese one
+		assert.Equal(t, "1985-01-01", FromStdTime(time.Date(1985, 1, 21, 0, 0, 0, 0, loc), VietnamMeridian).String())
+		assert.Equal(t, "1984-12-01", FromStdTime(time.Date(1985, 1, 21, 0, 0, 0, 0, loc)).String())
+		// the new moon of 2007-02-17 16:14 UTC is a day earlier than the chinese one
+		assert.Equal(t, "2007-01-01", FromStdTime(time.Date(2007, 2, 17, 0, 0, 0, 0, loc), VietnamMeridian).String())
+		assert.Equal(t, "2006-12-30", FromStdTime(time.Date(2007, 2, 17, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "2030-01-01", FromStdTime(time.Date(2030, 2, 2, 0, 0, 0, 0, loc), VietnamMeridian).String())
+	})
+
+	t.Run("leap month", func(t *testing.T) {
+		l := FromStdTime(time.Date(2033, 12, 22, 0, 0, 0, 0, loc), VietnamMeridian)
+		assert.Equal(t, "2033-11-01", l.String())
+		assert.True(t, l.IsLeapMonth())
+		assert.Equal(t, 11, l.LeapMonth())
+		assert.Equal(t, "2033-12-01", FromStdTime(time.Date(2034, 1, 20, 0, 0, 0, 0, loc), VietnamMeridian).String())
+		assert.Equal(t, "2034-01-01", FromStdTime(time.Date(2034, 2, 19, 0, 0, 0, 0, loc), VietnamMeridian).String())
+	})
+
+	t.Run("to gregorian", func(t *testing.T) {
+		assert.Equal(t, "1985-01-21 00:00:00 +0700 +07", NewLunar(1985, 1, 1, false, VietnamMeridian).ToGregorian("Asia/Ho_Chi_Minh").String())
+		assert.Equal(t, "2033-12-22 00:00:00 +0700 +07", NewLunar(2033, 11, 1, true, VietnamMeridian).ToGregorian("Asia/Ho_Chi_Minh").String())
+		assert.Empty(t, NewLunar(2034, 11, 1, true, VietnamMeridian).ToGregorian().String())
+	})
+}
+
+func TestLunar_Korea(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Seoul")
+
+	t.Run("from std time", func(t *testing.T) {
+		// the new moon of 1997-02-07 15:06 UTC is a day later than the chinese one
+		assert.Equal(t, "1997-01-01", FromStdTime(time.Date(1997, 2, 8, 0, 0, 0, 0, loc), KoreaMeridian).String())
+		assert.Equal(t, "1997-01-02", FromStdTime(time.Date(1997, 2, 8, 12, 0, 0, 0, loc)).String())
+		// the new moon of 2005-12-01 15:01 UTC
+		assert.Equal(t, "2005-10-30", FromStdTime(time.Date(2005, 12, 1, 0, 0, 0, 0, loc), KoreaMeridian).String())
+		assert.Equal(t, "2005-11-01", FromStdTime(time.Date(2005, 12, 2, 0, 0, 0, 0, loc), KoreaMeridian).String())
+	})
+
+	t.Run("leap month", func(t *testing.T) {
+		// the leap month of 2017 is the 5th month rather than the 6th month in the chinese calendar
+		l := FromStdTime(time.Date(2017, 6, 24, 0, 0, 0, 0, loc), KoreaMeridian)
+		assert.Equal(t, "2017-05-01", l.String())
+		assert.True(t, l.IsLeapMonth())
+		assert.Equal(t, 5, l.LeapMonth())
+		assert.Equal(t, 6, FromStdTime(time.Date(2017, 6, 24, 0, 0, 0, 0, loc)).LeapMonth())
+	})
+
+	t.Run("to gregorian", func(t *testing.T) {
+		assert.Equal(t, "1997-02-08 00:00:00 +0900 KST", NewLunar(1997, 1, 1, false, KoreaMeridian).ToGregorian("Asia/Seoul").String())
+		assert.Equal(t, "2017-06-24 00:00:00 +0900 KST", NewLunar(2017, 5, 1, true, KoreaMeridian).ToGregorian("Asia/Seoul").String())
+		assert.Equal(t, "2017-06-23 15:00:00 +0000 UTC", NewLunar(2017, 5, 1, true, KoreaMeridian).ToGregorian().String())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// the offset of Asia/Seoul was not always +09:00, so use the fixed offset of the meridian
+		kst := time.FixedZone("KST", 9*3600)
+		for d := time.Date(1900, 1, 1, 0, 0, 0, 0, kst); d.Year() <= 2100; d = d.AddDate(0, 0, 7) {
+			l := FromStdTime(d, KoreaMeridian)
+			assert.True(t, d.Equal(NewLunar(l.Year(), l.Month(), l.Day(), l.IsLeapMonth(), KoreaMeridian).ToGregorian().Time), "date %s", d)
+		}
+	})
+}
+
 func TestLunar_AuthorityData(t *testing.T) {
 	file, err := os.Open("lunar_test_data.json")
 	if err != nil {
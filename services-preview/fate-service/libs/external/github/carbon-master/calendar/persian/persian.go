@@ -26,6 +26,18 @@ var (
 	FaWeeks = []string{"نجشنبه", "دوشنبه", "سه شنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"}
 )
 
+func init() {
+	calendar.Register("persian", func(t time.Time) calendar.Calendar {
+		if p := FromStdTime(t); p != nil {
+			return p
+		}
+		return new(Persian)
+	}, func(year, month, day int) (calendar.Calendar, error) {
+		p := NewPersian(year, month, day)
+		return p, p.Error
+	})
+}
+
 // Persian defines a Persian struct.
 type Persian struct {
 	year, month, day int
@@ -150,6 +162,22 @@ func (p *Persian) ToWeekString(locale ...Locale) (month string) {
 	return ""
 }
 
+// MonthName implements the calendar.Calendar interface, it outputs the month name in the given locale like "Farvardin".
+func (p *Persian) MonthName(locale string) string {
+	if locale == "" {
+		return p.ToMonthString()
+	}
+	return p.ToMonthString(Locale(locale))
+}
+
+// WeekName implements the calendar.Calendar interface, it outputs the week name in the given locale like "Yekshanbeh".
+func (p *Persian) WeekName(locale string) string {
+	if locale == "" {
+		return p.ToWeekString()
+	}
+	return p.ToWeekString(Locale(locale))
+}
+
 // IsValid reports whether the Persian date is valid.
 func (p *Persian) IsValid() bool {
 	if p == nil || p.Error != nil {
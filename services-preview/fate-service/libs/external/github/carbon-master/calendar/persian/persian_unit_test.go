@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/dromara/carbon/v2/calendar"
 )
 
 func TestNewPersian(t *testing.T) {
@@ -226,6 +228,67 @@ func TestPersian_ToWeekString(t *testing.T) {
 	})
 }
 
+func TestPersian_MonthName(t *testing.T) {
+	t.Run("invalid persian", func(t *testing.T) {
+		assert.Empty(t, new(Persian).MonthName(""))
+		assert.Empty(t, NewPersian(1400, 1, 1).MonthName("xxx"))
+	})
+
+	t.Run("valid persian", func(t *testing.T) {
+		p := NewPersian(1400, 1, 1)
+		assert.Equal(t, "Farvardin", p.MonthName(""))
+		assert.Equal(t, "Farvardin", p.MonthName("en"))
+		assert.Equal(t, "فروردین", p.MonthName("fa"))
+	})
+}
+
+func TestPersian_WeekName(t *testing.T) {
+	t.Run("invalid persian", func(t *testing.T) {
+		assert.Empty(t, new(Persian).WeekName(""))
+		assert.Empty(t, NewPersian(1400, 1, 1).WeekName("xxx"))
+	})
+
+	t.Run("valid persian", func(t *testing.T) {
+		p := NewPersian(1400, 1, 1)
+		assert.Equal(t, "Yekshanbeh", p.WeekName(""))
+		assert.Equal(t, "Yekshanbeh", p.WeekName("en"))
+		assert.Equal(t, "نجشنبه", p.WeekName("fa"))
+	})
+}
+
+func TestPersian_Calendar(t *testing.T) {
+	converter, creator, ok := calendar.Lookup("persian")
+	assert.True(t, ok)
+
+	t.Run("zero time", func(t *testing.T) {
+		c := converter(time.Time{})
+		assert.NotNil(t, c)
+		assert.False(t, c.IsValid())
+		assert.Empty(t, c.String())
+	})
+
+	t.Run("valid time", func(t *testing.T) {
+		c := converter(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+		assert.Equal(t, "1399-05-15", c.String())
+		assert.Equal(t, 1399, c.Year())
+		assert.Equal(t, 5, c.Month())
+		assert.Equal(t, 15, c.Day())
+		assert.True(t, c.IsLeapYear())
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		_, err := creator(1399, 13, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("valid date", func(t *testing.T) {
+		c, err := creator(1399, 5, 15)
+		assert.Nil(t, err)
+		assert.Equal(t, "Mordad", c.MonthName("en"))
+		assert.Equal(t, "2020-08-05 00:00:00 +0000 UTC", c.ToGregorian().String())
+	})
+}
+
 func TestPersian_IsValid(t *testing.T) {
 	t.Run("nil persian", func(t *testing.T) {
 		assert.False(t, (*Persian)(nil).IsValid())
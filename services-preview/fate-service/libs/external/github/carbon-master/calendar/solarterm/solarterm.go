@@ -0,0 +1,201 @@
+// Package solarterm is part of the carbon package.
+package solarterm
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/dromara/carbon/v2/calendar"
+	"github.com/dromara/carbon/v2/calendar/internal/shouxing"
+)
+
+const (
+	// TermsPerYear is the number of solar terms in a year.
+	TermsPerYear = 24
+
+	// the moment of J2000.0 in unix seconds, which is 2000-01-01 12:00:00 UTC
+	j2000Unix = 946728000
+)
+
+var (
+	// ZhNames are the chinese names of solar terms in gregorian year order, starting from Minor Cold.
+	ZhNames = []string{
+		"小寒", "大寒", "立春", "雨水", "惊蛰", "春分", "清明", "谷雨", "立夏", "小满", "芒种", "夏至",
+		"小暑", "大暑", "立秋", "处暑", "白露", "秋分", "寒露", "霜降", "立冬", "小雪", "大雪", "冬至",
+	}
+
+	// EnNames are the english names of solar terms in gregorian year order, starting from Minor Cold.
+	EnNames = []string{
+		"Minor Cold", "Major Cold", "Start of Spring", "Rain Water", "Awakening of Insects", "Spring Equinox",
+		"Pure Brightness", "Grain Rain", "Start of Summer", "Grain Full", "Grain in Ear", "Summer Solstice",
+		"Minor Heat", "Major Heat", "Start of Autumn", "End of Heat", "White Dew", "Autumn Equinox",
+		"Cold Dew", "Frost's Descent", "Start of Winter", "Minor Snow", "Major Snow", "Winter Solstice",
+	}
+)
+
+// SolarTerm defines a SolarTerm struct.
+type SolarTerm struct {
+	year, index int
+	Error       error
+}
+
+// NewSolarTerm returns a new SolarTerm instance of the gregorian year,
+// index is from 0 (Minor Cold) to 23 (Winter Solstice).
+func NewSolarTerm(year, index int) *SolarTerm {
+	s := &SolarTerm{year: year, index: index}
+	if !s.IsValid() {
+		s.Error = fmt.Errorf("invalid solar term index: %d", index)
+	}
+	return s
+}
+
+// FromName returns a new SolarTerm instance of the gregorian year by chinese or english name like "立春" or "Start of Spring".
+func FromName(year int, name string) *SolarTerm {
+	if index := IndexOf(name); index >= 0 {
+		return &SolarTerm{year: year, index: index}
+	}
+	return &SolarTerm{year: year, index: -1, Error: fmt.Errorf("invalid solar term name: %q", name)}
+}
+
+// FromStdTime creates the SolarTerm instance in effect at the standard time.Time,
+// which is the last solar term whose moment is not after it.
+func FromStdTime(t time.Time) *SolarTerm {
+	if t.IsZero() {
+		return nil
+	}
+	// estimates the index from the day of the year, Minor Cold is around January 5th
+	s := normalize(t.Year(), int(math.Floor(float64(t.YearDay()-5)/15.2184)))
+	for s.moment().After(t) {
+		s = s.Prev()
+	}
+	for next := s.Next(); !next.moment().After(t); next = next.Next() {
+		s = next
+	}
+	return s
+}
+
+// IndexOf gets the index of a solar term by chinese or english name, which is -1 if not found.
+func IndexOf(name string) int {
+	name = strings.TrimSpace(name)
+	for i := 0; i < TermsPerYear; i++ {
+		if name == ZhNames[i] || strings.EqualFold(name, EnNames[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ToGregorian converts SolarTerm instance to Gregorian instance at the exact moment of the solar term.
+func (s *SolarTerm) ToGregorian(timezone ...string) *calendar.Gregorian {
+	g := new(calendar.Gregorian)
+	if !s.IsValid() {
+		return g
+	}
+	loc := time.UTC
+	if len(timezone) > 0 {
+		loc, g.Error = time.LoadLocation(timezone[0])
+	}
+	if g.Error != nil {
+		return g
+	}
+	g.Time = s.moment().In(loc)
+	return g
+}
+
+// Year gets the gregorian year like 2020.
+func (s *SolarTerm) Year() int {
+	if !s.IsValid() {
+		return 0
+	}
+	return s.year
+}
+
+// Index gets the index from 0 (Minor Cold) to 23 (Winter Solstice).
+func (s *SolarTerm) Index() int {
+	if !s.IsValid() {
+		return -1
+	}
+	return s.index
+}
+
+// Longitude gets the apparent ecliptic longitude of the sun in degrees like 315 for Start of Spring.
+func (s *SolarTerm) Longitude() int {
+	if !s.IsValid() {
+		return 0
+	}
+	return (285 + s.index*15) % 360
+}
+
+// Prev gets the previous solar term.
+func (s *SolarTerm) Prev() *SolarTerm {
+	if !s.IsValid() {
+		return nil
+	}
+	return normalize(s.year, s.index-1)
+}
+
+// Next gets the next solar term.
+func (s *SolarTerm) Next() *SolarTerm {
+	if !s.IsValid() {
+		return nil
+	}
+	return normalize(s.year, s.index+1)
+}
+
+// ToString outputs a string in chinese like "立春".
+func (s *SolarTerm) ToString() string {
+	if !s.IsValid() {
+		return ""
+	}
+	return ZhNames[s.index]
+}
+
+// String implements "Stringer" interface for SolarTerm.
+func (s *SolarTerm) String() string {
+	return s.ToString()
+}
+
+// IsValid reports whether is a valid solar term.
+func (s *SolarTerm) IsValid() bool {
+	if s == nil || s.Error != nil {
+		return false
+	}
+	return s.index >= 0 && s.index < TermsPerYear
+}
+
+// IsSpringEquinox reports whether is Spring Equinox.
+func (s *SolarTerm) IsSpringEquinox() bool {
+	return s.IsValid() && s.index == 5
+}
+
+// IsSummerSolstice reports whether is Summer Solstice.
+func (s *SolarTerm) IsSummerSolstice() bool {
+	return s.IsValid() && s.index == 11
+}
+
+// IsAutumnEquinox reports whether is Autumn Equinox.
+func (s *SolarTerm) IsAutumnEquinox() bool {
+	return s.IsValid() && s.index == 17
+}
+
+// IsWinterSolstice reports whether is Winter Solstice.
+func (s *SolarTerm) IsWinterSolstice() bool {
+	return s.IsValid() && s.index == 23
+}
+
+// gets the exact moment of the solar term, which is rounded to the second.
+func (s *SolarTerm) moment() time.Time {
+	// the longitude accumulates from the vernal equinox of 1999, Minor Cold is 75 degrees before the vernal equinox
+	w := (float64(s.year-1999)*360 + float64(s.index*15-75)) * math.Pi / 180
+	seconds := math.Round(shouxing.SunLonDays(w) * 86400)
+	return time.Unix(j2000Unix+int64(seconds), 0).UTC()
+}
+
+// normalizes an index out of 0 to 23 into the adjacent years.
+func normalize(year, index int) *SolarTerm {
+	year += int(math.Floor(float64(index) / TermsPerYear))
+	index = (index%TermsPerYear + TermsPerYear) % TermsPerYear
+	return &SolarTerm{year: year, index: index}
+}
@@ -0,0 +1,36 @@
+package solarterm
+
+import (
+	"testing"
+	"time"
+)
+
+func BenchmarkFromStdTime(b *testing.B) {
+	testDates := []time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 9, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FromStdTime(testDates[i%len(testDates)])
+	}
+}
+
+func BenchmarkToGregorian(b *testing.B) {
+	s := NewSolarTerm(2024, 2)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.ToGregorian()
+	}
+}
+
+func BenchmarkFromName(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FromName(2024, "Winter Solstice")
+	}
+}
@@ -0,0 +1,144 @@
+package solarterm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSolarTerm(t *testing.T) {
+	t.Run("valid index", func(t *testing.T) {
+		s := NewSolarTerm(2024, 2)
+		assert.Nil(t, s.Error)
+		assert.Equal(t, "立春", s.String())
+	})
+
+	t.Run("invalid index", func(t *testing.T) {
+		assert.Error(t, NewSolarTerm(2024, -1).Error)
+		assert.Error(t, NewSolarTerm(2024, 24).Error)
+		assert.Empty(t, NewSolarTerm(2024, 24).String())
+	})
+}
+
+func TestFromName(t *testing.T) {
+	t.Run("invalid name", func(t *testing.T) {
+		assert.Error(t, FromName(2024, "").Error)
+		assert.Error(t, FromName(2024, "xxx").Error)
+		assert.False(t, FromName(2024, "xxx").IsValid())
+	})
+
+	t.Run("valid name", func(t *testing.T) {
+		assert.Equal(t, 2, FromName(2024, "立春").Index())
+		assert.Equal(t, 2, FromName(2024, "Start of Spring").Index())
+		assert.Equal(t, 23, FromName(2024, " winter solstice ").Index())
+	})
+}
+
+func TestFromStdTime(t *testing.T) {
+	loc, _ := time.LoadLocation("PRC")
+
+	t.Run("zero time", func(t *testing.T) {
+		assert.Nil(t, FromStdTime(time.Time{}))
+	})
+
+	t.Run("valid time", func(t *testing.T) {
+		assert.Equal(t, "大寒", FromStdTime(time.Date(2024, 2, 4, 16, 27, 6, 0, loc)).String())
+		assert.Equal(t, "立春", FromStdTime(time.Date(2024, 2, 4, 16, 27, 7, 0, loc)).String())
+		assert.Equal(t, "冬至", FromStdTime(time.Date(2024, 1, 1, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, 2023, FromStdTime(time.Date(2024, 1, 1, 0, 0, 0, 0, loc)).Year())
+		assert.Equal(t, "冬至", FromStdTime(time.Date(2024, 12, 31, 0, 0, 0, 0, loc)).String())
+		assert.Equal(t, "小寒", FromStdTime(time.Date(2025, 1, 5, 12, 0, 0, 0, loc)).String())
+		assert.Equal(t, "立秋", FromStdTime(time.Date(2020, 8, 7, 9, 6, 11, 0, loc)).String())
+	})
+}
+
+func TestSolarTerm_ToGregorian(t *testing.T) {
+	t.Run("invalid solar term", func(t *testing.T) {
+		assert.Empty(t, (*SolarTerm)(nil).ToGregorian().String())
+		assert.Empty(t, NewSolarTerm(2024, 24).ToGregorian().String())
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		g := NewSolarTerm(2024, 2).ToGregorian("xxx")
+		assert.Error(t, g.Error)
+		assert.Empty(t, g.String())
+	})
+
+	t.Run("valid solar term", func(t *testing.T) {
+		assert.Equal(t, "2024-02-04 08:27:07 +0000 UTC", NewSolarTerm(2024, 2).ToGregorian().String())
+		assert.Equal(t, "2024-02-04 16:27:07 +0800 CST", NewSolarTerm(2024, 2).ToGregorian("PRC").String())
+	})
+}
+
+func TestSolarTerm_Getters(t *testing.T) {
+	t.Run("invalid solar term", func(t *testing.T) {
+		var s *SolarTerm
+		assert.Zero(t, s.Year())
+		assert.Equal(t, -1, s.Index())
+		assert.Zero(t, s.Longitude())
+		assert.Nil(t, s.Prev())
+		assert.Nil(t, s.Next())
+		assert.Empty(t, s.String())
+	})
+
+	t.Run("valid solar term", func(t *testing.T) {
+		s := NewSolarTerm(2024, 0)
+		assert.Equal(t, 2024, s.Year())
+		assert.Equal(t, 285, s.Longitude())
+		assert.Equal(t, 0, NewSolarTerm(2024, 5).Longitude())
+		assert.Equal(t, 270, NewSolarTerm(2024, 23).Longitude())
+		assert.Equal(t, 2023, s.Prev().Year())
+		assert.Equal(t, "冬至", s.Prev().String())
+		assert.Equal(t, 2025, NewSolarTerm(2024, 23).Next().Year())
+		assert.Equal(t, "小寒", NewSolarTerm(2024, 23).Next().String())
+	})
+}
+
+func TestSolarTerm_IsSeasonal(t *testing.T) {
+	assert.True(t, NewSolarTerm(2024, 5).IsSpringEquinox())
+	assert.True(t, NewSolarTerm(2024, 11).IsSummerSolstice())
+	assert.True(t, NewSolarTerm(2024, 17).IsAutumnEquinox())
+	assert.True(t, NewSolarTerm(2024, 23).IsWinterSolstice())
+	assert.False(t, NewSolarTerm(2024, 2).IsSpringEquinox())
+	assert.False(t, NewSolarTerm(2024, 24).IsWinterSolstice())
+}
+
+func TestSolarTermWithAuthorityData(t *testing.T) {
+	// the moments are computed by the ShouXing astronomical calendar of 6tail/lunar-go in Beijing time
+	data, err := os.ReadFile("solarterm_test_data.json")
+	if err != nil {
+		t.Skipf("Unable to read test data file: %v", err)
+	}
+
+	var testCases []struct {
+		Description string `json:"description"`
+		Year        int    `json:"year"`
+		Index       int    `json:"index"`
+		Name        string `json:"name"`
+		Moment      string `json:"moment"`
+	}
+	if err = json.Unmarshal(data, &testCases); err != nil {
+		t.Fatalf("Failed to parse test data: %v", err)
+	}
+
+	loc, _ := time.LoadLocation("PRC")
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Authority_Data_%d_%s", i+1, tc.Description), func(t *testing.T) {
+			s := NewSolarTerm(tc.Year, tc.Index)
+			assert.Equal(t, tc.Name, s.String())
+			assert.Equal(t, s, FromName(tc.Year, tc.Name))
+
+			moment := s.ToGregorian("PRC").Time
+			assert.Equal(t, tc.Moment, moment.Format("2006-01-02 15:04:05"))
+
+			// the solar term is in effect from its moment until the next one
+			expected, _ := time.ParseInLocation("2006-01-02 15:04:05", tc.Moment, loc)
+			assert.Equal(t, s, FromStdTime(expected))
+			assert.Equal(t, s.Prev(), FromStdTime(expected.Add(-time.Second)))
+		})
+	}
+}
@@ -3,6 +3,8 @@ package carbon
 import (
 	"sync"
 	"testing"
+
+	"github.com/dromara/carbon/v2/calendar/lunar"
 )
 
 func BenchmarkCarbon_Julian(b *testing.B) {
@@ -135,6 +137,40 @@ func BenchmarkCreateFromLunar(b *testing.B) {
 	})
 }
 
+func BenchmarkCarbon_ToLunarMonthString(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Parse("2020-08-05").SetLocale("vi")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.ToLunarMonthString(lunar.VietnamMeridian)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Parse("2020-08-05").SetLocale("vi")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.ToLunarMonthString(lunar.VietnamMeridian)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Parse("2020-08-05").SetLocale("vi")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.ToLunarMonthString(lunar.VietnamMeridian)
+			}
+		})
+	})
+}
+
 func BenchmarkCarbon_Persian(b *testing.B) {
 	b.Run("sequential", func(b *testing.B) {
 		c := Parse("2020-08-05")
@@ -264,3 +300,263 @@ func BenchmarkCreateFromHebrew(b *testing.B) {
 		})
 	})
 }
+
+func BenchmarkCarbon_Hijri(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.Hijri()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.Hijri()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.Hijri()
+			}
+		})
+	})
+}
+
+func BenchmarkCreateFromHijri(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			CreateFromHijri(1446, 9, 1)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				CreateFromHijri(1446, 9, 1)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				CreateFromHijri(1446, 9, 1)
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_Ethiopian(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.Ethiopian()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.Ethiopian()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.Ethiopian()
+			}
+		})
+	})
+}
+
+func BenchmarkCreateFromEthiopian(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			CreateFromEthiopian(2016, 13, 5)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				CreateFromEthiopian(2016, 13, 5)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				CreateFromEthiopian(2016, 13, 5)
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_Coptic(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.Coptic()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.Coptic()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.Coptic()
+			}
+		})
+	})
+}
+
+func BenchmarkCreateFromCoptic(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			CreateFromCoptic(1740, 13, 5)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				CreateFromCoptic(1740, 13, 5)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				CreateFromCoptic(1740, 13, 5)
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_ToCalendar(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.ToCalendar("persian")
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.ToCalendar("persian")
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.ToCalendar("persian")
+			}
+		})
+	})
+}
+
+func BenchmarkCreateFromCalendar(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			CreateFromCalendar("persian", 1399, 5, 15)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				CreateFromCalendar("persian", 1399, 5, 15)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				CreateFromCalendar("persian", 1399, 5, 15)
+			}
+		})
+	})
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 
 	"github.com/dromara/carbon/v2"
+	"github.com/dromara/carbon/v2/calendar/ethiopian"
+	"github.com/dromara/carbon/v2/calendar/hijri"
+	"github.com/dromara/carbon/v2/calendar/lunar"
 )
 
 func ExampleCarbon_Julian() {
@@ -47,6 +50,17 @@ func ExampleCreateFromLunar() {
 	// 2023-04-01 00:00:00 +0800 CST
 }
 
+func ExampleCarbon_ToLunarMonthString() {
+	fmt.Println(carbon.Parse("2017-07-23", carbon.PRC).ToLunarMonthString())
+	fmt.Println(carbon.Parse("2017-06-24", "Asia/Seoul").SetLocale("ko").ToLunarMonthString(lunar.KoreaMeridian))
+	fmt.Println(carbon.Parse("2020-08-05", "Asia/Ho_Chi_Minh").SetLocale("vi").ToLunarMonthString(lunar.VietnamMeridian))
+
+	// Output:
+	// 闰六月
+	// 윤오월
+	// Tháng Sáu
+}
+
 func ExampleCarbon_Persian() {
 	fmt.Println(carbon.Parse("1800-01-01 00:00:00").Persian().String())
 	fmt.Println(carbon.Parse("2020-08-05 13:14:15").Persian().String())
@@ -90,3 +104,95 @@ func ExampleCreateFromHebrew() {
 	// 2024-07-21
 	// 2025-09-18
 }
+
+func ExampleCarbon_Hijri() {
+	fmt.Println(carbon.Parse("2024-01-01").Hijri().String())
+	fmt.Println(carbon.Parse("2024-07-07").Hijri().String())
+	fmt.Println(carbon.Parse("2024-07-07").Hijri(hijri.UmmAlQura).String())
+
+	// Output:
+	// 1445-06-19
+	// 1445-12-30
+	// 1446-01-01
+}
+
+func ExampleCreateFromHijri() {
+	fmt.Println(carbon.CreateFromHijri(1441, 12, 15).ToDateString())
+	fmt.Println(carbon.CreateFromHijri(1446, 1, 1).ToDateString())
+	fmt.Println(carbon.CreateFromHijri(1446, 1, 1, hijri.UmmAlQura).ToDateString())
+
+	// Output:
+	// 2020-08-05
+	// 2024-07-08
+	// 2024-07-07
+}
+
+func ExampleCarbon_Ethiopian() {
+	fmt.Println(carbon.Parse("2020-08-05 13:14:15").Ethiopian().String())
+	fmt.Println(carbon.Parse("2023-09-11 00:00:00").Ethiopian().String())
+	fmt.Println(carbon.Parse("2023-09-11 00:00:00").Ethiopian().ToMonthString())
+	fmt.Println(carbon.Parse("2023-09-11 00:00:00").Ethiopian(ethiopian.AmeteAlem).String())
+
+	// Output:
+	// 2012-11-29
+	// 2015-13-06
+	// Pagume
+	// 7515-13-06
+}
+
+func ExampleCreateFromEthiopian() {
+	fmt.Println(carbon.CreateFromEthiopian(2012, 11, 29).ToDateString())
+	fmt.Println(carbon.CreateFromEthiopian(2016, 1, 1).ToDateString())
+	fmt.Println(carbon.CreateFromEthiopian(7516, 1, 1, ethiopian.AmeteAlem).ToDateString())
+
+	// Output:
+	// 2020-08-05
+	// 2023-09-12
+	// 2023-09-12
+}
+
+func ExampleCarbon_Coptic() {
+	fmt.Println(carbon.Parse("2020-08-05 13:14:15").Coptic().String())
+	fmt.Println(carbon.Parse("2023-09-12 00:00:00").Coptic().String())
+	fmt.Println(carbon.Parse("2023-09-12 00:00:00").Coptic().ToMonthString())
+
+	// Output:
+	// 1736-11-29
+	// 1740-01-01
+	// Thout
+}
+
+func ExampleCreateFromCoptic() {
+	fmt.Println(carbon.CreateFromCoptic(1736, 11, 29).ToDateString())
+	fmt.Println(carbon.CreateFromCoptic(1741, 4, 29).ToDateString())
+
+	// Output:
+	// 2020-08-05
+	// 2025-01-07
+}
+
+func ExampleCarbon_ToCalendar() {
+	c := carbon.Parse("2020-08-05")
+	for _, name := range []string{"persian", "hebrew", "hijri"} {
+		cal, _ := c.ToCalendar(name)
+		fmt.Println(name, cal.String(), cal.MonthName("en"), cal.WeekName("en"))
+	}
+
+	// Output:
+	// persian 1399-05-15 Mordad Chaharshanbeh
+	// hebrew 5780-05-15 Av Wednesday
+	// hijri 1441-12-15 Dhu al-Hijjah Al-Arbia
+}
+
+func ExampleCreateFromCalendar() {
+	fmt.Println(carbon.CreateFromCalendar("persian", 1399, 5, 15).ToDateString())
+	fmt.Println(carbon.CreateFromCalendar("lunar", 2020, 6, 16).ToDateString(carbon.PRC))
+	fmt.Println(carbon.CreateFromCalendar("hijri", 1441, 12, 15).ToDateString())
+	fmt.Println(carbon.CreateFromCalendar("xxx", 2020, 8, 5).Error)
+
+	// Output:
+	// 2020-08-05
+	// 2020-08-05
+	// 2020-08-05
+	// calendar "xxx" doesn't exist
+}
@@ -4,6 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"github.com/dromara/carbon/v2/calendar/ethiopian"
+	"github.com/dromara/carbon/v2/calendar/hijri"
+	"github.com/dromara/carbon/v2/calendar/lunar"
 )
 
 type CalendarSuite struct {
@@ -101,11 +105,18 @@ func (s *CalendarSuite) TestCarbon_Lunar() {
 		s.Equal("2023-12-11", Parse("2024-01-21", PRC).Lunar().String())
 		s.Equal("2023-12-14", Parse("2024-01-24", PRC).Lunar().String())
 	})
+
+	s.Run("meridian carbon", func() {
+		s.Equal("1984-12-01", Parse("1985-01-21", "Asia/Ho_Chi_Minh").Lunar().String())
+		s.Equal("1985-01-01", Parse("1985-01-21", "Asia/Ho_Chi_Minh").Lunar(lunar.VietnamMeridian).String())
+		s.Equal("1997-01-01", Parse("1997-02-08", "Asia/Seoul").Lunar(lunar.KoreaMeridian).String())
+		s.Equal(5, Parse("2017-06-24", "Asia/Seoul").Lunar(lunar.KoreaMeridian).LeapMonth())
+	})
 }
 
 func (s *CalendarSuite) TestCreateFromLunar() {
 	s.Run("error lunar", func() {
-		s.Empty(CreateFromLunar(2200, 12, 14, false).ToString())
+		s.Empty(CreateFromLunar(10000, 12, 14, false).ToString())
 	})
 
 	s.Run("valid lunar", func() {
@@ -113,6 +124,45 @@ func (s *CalendarSuite) TestCreateFromLunar() {
 		s.Equal("2024-01-18 00:00:00 +0800 CST", CreateFromLunar(2023, 12, 8, false).ToString(PRC))
 		s.Equal("2024-01-24 00:00:00 +0800 CST", CreateFromLunar(2023, 12, 14, false).ToString(PRC))
 	})
+
+	s.Run("meridian lunar", func() {
+		s.Equal("1985-01-21 00:00:00 +0700 +07", CreateFromLunar(1985, 1, 1, false, lunar.VietnamMeridian).ToString("Asia/Ho_Chi_Minh"))
+		s.Equal("1997-02-08 00:00:00 +0900 KST", CreateFromLunar(1997, 1, 1, false, lunar.KoreaMeridian).ToString("Asia/Seoul"))
+		s.Equal("2017-06-24 00:00:00 +0900 KST", CreateFromLunar(2017, 5, 1, true, lunar.KoreaMeridian).ToString("Asia/Seoul"))
+	})
+}
+
+func (s *CalendarSuite) TestCarbon_ToLunarMonthString() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Empty(c.ToLunarMonthString())
+	})
+
+	s.Run("zero carbon", func() {
+		s.Empty(NewCarbon().ToLunarMonthString())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Empty(Parse("").ToLunarMonthString())
+	})
+
+	s.Run("error carbon", func() {
+		s.Empty(Parse("xxx").ToLunarMonthString())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("六月", Parse("2020-08-05", PRC).ToLunarMonthString())
+		s.Equal("六月", Parse("2020-08-05", PRC).SetLocale("en").ToLunarMonthString())
+		s.Equal("Tháng Sáu", Parse("2020-08-05", PRC).SetLocale("vi").ToLunarMonthString(lunar.VietnamMeridian))
+		s.Equal("유월", Parse("2020-08-05", PRC).SetLocale("ko").ToLunarMonthString(lunar.KoreaMeridian))
+	})
+
+	s.Run("leap month", func() {
+		s.Equal("闰六月", Parse("2017-07-23", PRC).ToLunarMonthString())
+		s.Equal("윤오월", Parse("2017-06-24", "Asia/Seoul").SetLocale("ko").ToLunarMonthString(lunar.KoreaMeridian))
+		s.Equal("Tháng Mười Một nhuận", Parse("2033-12-22", "Asia/Ho_Chi_Minh").SetLocale("vi").ToLunarMonthString(lunar.VietnamMeridian))
+	})
 }
 
 func (s *CalendarSuite) TestCarbon_Persian() {
@@ -217,3 +267,250 @@ func (s *CalendarSuite) TestCreateFromHebrew() {
 		s.Equal("2024-03-25 12:00:00 +0000 UTC", CreateFromHebrew(5784, 1, 1).ToString())
 	})
 }
+
+func (s *CalendarSuite) TestCarbon_Hijri() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Nil(c.Hijri())
+	})
+
+	s.Run("zero carbon", func() {
+		h := NewCarbon().Hijri()
+		s.Nil(h.Error)
+		s.Empty(h.String())
+	})
+
+	s.Run("empty carbon", func() {
+		h := Parse("").Hijri()
+		s.Nil(h.Error)
+		s.Empty(h.String())
+	})
+
+	s.Run("error carbon", func() {
+		h := Parse("xxx").Hijri()
+		s.Error(h.Error)
+		s.Empty(h.String())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("1214-08-04", Parse("1800-01-01 00:00:00").Hijri().String())
+		s.Equal("1441-12-15", Parse("2020-08-05 13:14:15").Hijri().String())
+		s.Equal("1445-06-19", Parse("2024-01-01 00:00:00").Hijri().String())
+		s.Equal("1445-12-30", Parse("2024-07-07 00:00:00").Hijri().String())
+	})
+
+	s.Run("umm al-qura carbon", func() {
+		s.Equal("1441-12-15", Parse("2020-08-05 13:14:15").Hijri(hijri.UmmAlQura).String())
+		s.Equal("1446-01-01", Parse("2024-07-07 00:00:00").Hijri(hijri.UmmAlQura).String())
+		s.Empty(Parse("1800-01-01 00:00:00").Hijri(hijri.UmmAlQura).String())
+	})
+}
+
+func (s *CalendarSuite) TestCreateFromHijri() {
+	s.Run("error hijri", func() {
+		s.Error(CreateFromHijri(1446, 13, 1).Error)
+		s.Error(CreateFromHijri(1446, 12, 30).Error)
+		s.Error(CreateFromHijri(1501, 1, 1, hijri.UmmAlQura).Error)
+	})
+
+	s.Run("valid hijri", func() {
+		s.Equal("1800-01-01 00:00:00 +0000 UTC", CreateFromHijri(1214, 8, 4).ToString())
+		s.Equal("2020-08-05 00:00:00 +0000 UTC", CreateFromHijri(1441, 12, 15).ToString())
+		s.Equal("2024-07-08 00:00:00 +0000 UTC", CreateFromHijri(1446, 1, 1).ToString())
+	})
+
+	s.Run("umm al-qura hijri", func() {
+		s.Equal("2024-07-07 00:00:00 +0000 UTC", CreateFromHijri(1446, 1, 1, hijri.UmmAlQura).ToString())
+		s.Equal("2025-03-01 00:00:00 +0000 UTC", CreateFromHijri(1446, 9, 1, hijri.UmmAlQura).ToString())
+	})
+}
+
+func (s *CalendarSuite) TestCarbon_Ethiopian() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Nil(c.Ethiopian())
+	})
+
+	s.Run("zero carbon", func() {
+		e := NewCarbon().Ethiopian()
+		s.Nil(e.Error)
+		s.Empty(e.String())
+	})
+
+	s.Run("empty carbon", func() {
+		e := Parse("").Ethiopian()
+		s.Nil(e.Error)
+		s.Empty(e.String())
+	})
+
+	s.Run("error carbon", func() {
+		e := Parse("xxx").Ethiopian()
+		s.Error(e.Error)
+		s.Empty(e.String())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("1792-04-24", Parse("1800-01-01 00:00:00").Ethiopian().String())
+		s.Equal("2012-11-29", Parse("2020-08-05 13:14:15").Ethiopian().String())
+		s.Equal("2015-13-06", Parse("2023-09-11 00:00:00").Ethiopian().String())
+		s.Equal("2016-01-01", Parse("2023-09-12 00:00:00").Ethiopian().String())
+	})
+
+	s.Run("amete alem carbon", func() {
+		s.Equal("7512-11-29", Parse("2020-08-05 13:14:15").Ethiopian(ethiopian.AmeteAlem).String())
+		s.Equal("7516-01-01", Parse("2023-09-12 00:00:00").Ethiopian(ethiopian.AmeteAlem).String())
+	})
+}
+
+func (s *CalendarSuite) TestCreateFromEthiopian() {
+	s.Run("error ethiopian", func() {
+		s.Error(CreateFromEthiopian(2016, 14, 1).Error)
+		s.Error(CreateFromEthiopian(2016, 13, 6).Error)
+		s.Error(CreateFromEthiopian(0, 1, 1).Error)
+		s.Error(CreateFromEthiopian(7516, 13, 6, ethiopian.AmeteAlem).Error)
+	})
+
+	s.Run("valid ethiopian", func() {
+		s.Equal("1800-01-01 00:00:00 +0000 UTC", CreateFromEthiopian(1792, 4, 24).ToString())
+		s.Equal("2020-08-05 00:00:00 +0000 UTC", CreateFromEthiopian(2012, 11, 29).ToString())
+		s.Equal("2023-09-11 00:00:00 +0000 UTC", CreateFromEthiopian(2015, 13, 6).ToString())
+	})
+
+	s.Run("amete alem ethiopian", func() {
+		s.Equal("2020-08-05 00:00:00 +0000 UTC", CreateFromEthiopian(7512, 11, 29, ethiopian.AmeteAlem).ToString())
+		s.Equal("2023-09-12 00:00:00 +0000 UTC", CreateFromEthiopian(7516, 1, 1, ethiopian.AmeteAlem).ToString())
+	})
+}
+
+func (s *CalendarSuite) TestCarbon_Coptic() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Nil(c.Coptic())
+	})
+
+	s.Run("zero carbon", func() {
+		c := NewCarbon().Coptic()
+		s.Nil(c.Error)
+		s.Empty(c.String())
+	})
+
+	s.Run("empty carbon", func() {
+		c := Parse("").Coptic()
+		s.Nil(c.Error)
+		s.Empty(c.String())
+	})
+
+	s.Run("error carbon", func() {
+		c := Parse("xxx").Coptic()
+		s.Error(c.Error)
+		s.Empty(c.String())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("1516-04-24", Parse("1800-01-01 00:00:00").Coptic().String())
+		s.Equal("1736-11-29", Parse("2020-08-05 13:14:15").Coptic().String())
+		s.Equal("1739-13-06", Parse("2023-09-11 00:00:00").Coptic().String())
+		s.Equal("1740-01-01", Parse("2023-09-12 00:00:00").Coptic().String())
+	})
+}
+
+func (s *CalendarSuite) TestCreateFromCoptic() {
+	s.Run("error coptic", func() {
+		s.Error(CreateFromCoptic(1740, 14, 1).Error)
+		s.Error(CreateFromCoptic(1740, 13, 6).Error)
+		s.Error(CreateFromCoptic(0, 1, 1).Error)
+	})
+
+	s.Run("valid coptic", func() {
+		s.Equal("1800-01-01 00:00:00 +0000 UTC", CreateFromCoptic(1516, 4, 24).ToString())
+		s.Equal("2020-08-05 00:00:00 +0000 UTC", CreateFromCoptic(1736, 11, 29).ToString())
+		s.Equal("2025-01-07 00:00:00 +0000 UTC", CreateFromCoptic(1741, 4, 29).ToString())
+	})
+}
+
+func (s *CalendarSuite) TestCarbon_ToCalendar() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		cal, err := c.ToCalendar("persian")
+		s.Nil(cal)
+		s.Error(err)
+	})
+
+	s.Run("zero carbon", func() {
+		cal, err := NewCarbon().ToCalendar("persian")
+		s.Nil(err)
+		s.False(cal.IsValid())
+		s.Empty(cal.String())
+	})
+
+	s.Run("empty carbon", func() {
+		cal, err := Parse("").ToCalendar("persian")
+		s.Nil(err)
+		s.False(cal.IsValid())
+		s.Empty(cal.String())
+	})
+
+	s.Run("error carbon", func() {
+		cal, err := Parse("xxx").ToCalendar("persian")
+		s.Nil(cal)
+		s.Error(err)
+	})
+
+	s.Run("not exist calendar", func() {
+		cal, err := Parse("2020-08-05").ToCalendar("xxx")
+		s.Nil(cal)
+		s.Equal(ErrNotExistCalendar("xxx"), err)
+	})
+
+	s.Run("valid carbon", func() {
+		c := Parse("2020-08-05 13:14:15", PRC)
+		for name, expected := range map[string]string{
+			"lunar":                "2020-06-16",
+			"persian":              "1399-05-15",
+			"hebrew":               "5780-05-15",
+			"lunar-vietnam":        "2020-06-16",
+			"lunar-korea":          "2020-06-16",
+			"hijri":                "1441-12-15",
+			"hijri-umm-al-qura":    "1441-12-15",
+			"ethiopian":            "2012-11-29",
+			"ethiopian-amete-alem": "7512-11-29",
+			"coptic":               "1736-11-29",
+		} {
+			cal, err := c.ToCalendar(name)
+			s.Nil(err)
+			s.Equal(expected, cal.String())
+		}
+	})
+}
+
+func (s *CalendarSuite) TestCreateFromCalendar() {
+	s.Run("not exist calendar", func() {
+		c := CreateFromCalendar("xxx", 2020, 8, 5)
+		s.Equal(ErrNotExistCalendar("xxx"), c.Error)
+	})
+
+	s.Run("error calendar", func() {
+		s.Error(CreateFromCalendar("lunar", 10000, 1, 1).Error)
+		s.Error(CreateFromCalendar("persian", 1399, 13, 1).Error)
+		s.Error(CreateFromCalendar("hebrew", 5780, 14, 1).Error)
+		s.Error(CreateFromCalendar("hijri", 1441, 13, 1).Error)
+		s.Error(CreateFromCalendar("hijri-umm-al-qura", 1501, 1, 1).Error)
+		s.Error(CreateFromCalendar("ethiopian", 2016, 13, 6).Error)
+		s.Error(CreateFromCalendar("coptic", 1740, 13, 6).Error)
+	})
+
+	s.Run("valid calendar", func() {
+		s.Equal("2020-08-05", CreateFromCalendar("lunar", 2020, 6, 16).ToDateString(PRC))
+		s.Equal("2020-08-05", CreateFromCalendar("persian", 1399, 5, 15).ToDateString())
+		s.Equal(CreateFromHebrew(5780, 5, 15).ToDateString(), CreateFromCalendar("hebrew", 5780, 5, 15).ToDateString())
+		s.Equal("2020-08-05", CreateFromCalendar("hijri", 1441, 12, 15).ToDateString())
+		s.Equal("2020-08-05", CreateFromCalendar("hijri-umm-al-qura", 1441, 12, 15).ToDateString())
+		s.Equal("2020-08-05", CreateFromCalendar("ethiopian", 2012, 11, 29).ToDateString())
+		s.Equal("2020-08-05", CreateFromCalendar("ethiopian-amete-alem", 7512, 11, 29).ToDateString())
+		s.Equal("2020-08-05", CreateFromCalendar("coptic", 1736, 11, 29).ToDateString())
+	})
+}
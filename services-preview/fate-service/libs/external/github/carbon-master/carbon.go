@@ -26,6 +26,7 @@ type Carbon struct {
 	lang          *Language
 	currentLayout string
 	isEmpty       bool
+	clock         Clock
 	Error         error
 }
 
@@ -63,6 +64,7 @@ func (c *Carbon) Copy() *Carbon {
 		lang:          c.lang,
 		currentLayout: c.currentLayout,
 		isEmpty:       c.isEmpty,
+		clock:         c.clock,
 		Error:         c.Error,
 	}
 }
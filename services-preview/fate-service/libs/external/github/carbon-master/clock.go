@@ -0,0 +1,115 @@
+package carbon
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock defines a Clock interface which provides the current time.
+type Clock interface {
+	// Now returns the current time.
+	Now() StdTime
+}
+
+// frozenClock is the default clock, it returns the test time set by SetTestNow if frozen, otherwise the system time.
+type frozenClock struct{}
+
+// Now implements "Clock" interface for frozenClock struct.
+func (frozenClock) Now() StdTime {
+	if IsTestNow() {
+		frozenNow.rw.RLock()
+		defer frozenNow.rw.RUnlock()
+		return frozenNow.testNow.StdTime()
+	}
+	return time.Now()
+}
+
+// FixedClock defines a FixedClock struct which always returns the same time.
+type FixedClock struct {
+	time StdTime
+}
+
+// NewFixedClock returns a new FixedClock instance.
+func NewFixedClock(t StdTime) *FixedClock {
+	return &FixedClock{time: t}
+}
+
+// Now implements "Clock" interface for FixedClock struct.
+func (fc *FixedClock) Now() StdTime {
+	return fc.time
+}
+
+// OffsetClock defines an OffsetClock struct which returns the time of another clock plus an offset.
+type OffsetClock struct {
+	clock  Clock
+	offset Duration
+}
+
+// NewOffsetClock returns a new OffsetClock instance, a nil clock means the default clock.
+func NewOffsetClock(clock Clock, offset Duration) *OffsetClock {
+	if clock == nil {
+		clock = frozenClock{}
+	}
+	return &OffsetClock{clock: clock, offset: offset}
+}
+
+// Now implements "Clock" interface for OffsetClock struct.
+func (oc *OffsetClock) Now() StdTime {
+	return oc.clock.Now().Add(oc.offset)
+}
+
+// FakeClock defines a FakeClock struct which is advanced manually, it is safe for concurrent use.
+type FakeClock struct {
+	time StdTime
+	rw   sync.RWMutex
+}
+
+// NewFakeClock returns a new FakeClock instance.
+func NewFakeClock(t StdTime) *FakeClock {
+	return &FakeClock{time: t}
+}
+
+// Now implements "Clock" interface for FakeClock struct.
+func (fc *FakeClock) Now() StdTime {
+	fc.rw.RLock()
+	defer fc.rw.RUnlock()
+	return fc.time
+}
+
+// Advance advances the time by duration, a negative duration moves the time backwards.
+func (fc *FakeClock) Advance(d Duration) {
+	fc.rw.Lock()
+	defer fc.rw.Unlock()
+	fc.time = fc.time.Add(d)
+}
+
+// Set sets the time.
+func (fc *FakeClock) Set(t StdTime) {
+	fc.rw.Lock()
+	defer fc.rw.Unlock()
+	fc.time = t
+}
+
+// clockKey is the context key of the clock.
+type clockKey struct{}
+
+// WithClock returns a copy of the context with the clock attached.
+func WithClock(ctx context.Context, clock Clock) context.Context {
+	return context.WithValue(ctx, clockKey{}, clock)
+}
+
+// ClockFromContext returns the clock attached to the context, or the default clock if there isn't any.
+func ClockFromContext(ctx context.Context) Clock {
+	if ctx != nil {
+		if clock, ok := ctx.Value(clockKey{}).(Clock); ok && clock != nil {
+			return clock
+		}
+	}
+	return frozenClock{}
+}
+
+// now returns a Carbon instance for now in the location of the Carbon instance by its clock.
+func (c *Carbon) now() *Carbon {
+	return NowWithClock(c.clock).SetLocation(c.loc)
+}
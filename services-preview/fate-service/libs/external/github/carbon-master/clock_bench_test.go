@@ -0,0 +1,139 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func BenchmarkFakeClock_Now(b *testing.B) {
+	clock := NewFakeClock(time.Now())
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			clock.Now()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				clock.Now()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				clock.Now()
+			}
+		})
+	})
+}
+
+func BenchmarkFakeClock_Advance(b *testing.B) {
+	clock := NewFakeClock(time.Now())
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			clock.Advance(time.Second)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				clock.Advance(time.Second)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				clock.Advance(time.Second)
+			}
+		})
+	})
+}
+
+func BenchmarkNowWithClock(b *testing.B) {
+	clock := NewFixedClock(time.Now())
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			NowWithClock(clock)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				NowWithClock(clock)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				NowWithClock(clock)
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_IsTodayWithClock(b *testing.B) {
+	c := Parse("2020-08-05 13:14:15").SetClock(NewFixedClock(time.Date(2020, 8, 5, 0, 0, 0, 0, time.UTC)))
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.IsToday()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.IsToday()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.IsToday()
+			}
+		})
+	})
+}
@@ -0,0 +1,55 @@
+package carbon_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func ExampleNewFixedClock() {
+	clock := carbon.NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+	fmt.Println(carbon.NowWithClock(clock).ToDateTimeString())
+	fmt.Println(carbon.YesterdayWithClock(clock).ToDateTimeString())
+	fmt.Println(carbon.TomorrowWithClock(clock, carbon.PRC).ToDateTimeString())
+
+	// Output:
+	// 2020-08-05 13:14:15
+	// 2020-08-04 13:14:15
+	// 2020-08-06 21:14:15
+}
+
+func ExampleNewOffsetClock() {
+	clock := carbon.NewOffsetClock(carbon.NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC)), time.Hour)
+	fmt.Println(carbon.NowWithClock(clock).ToDateTimeString())
+
+	// Output:
+	// 2020-08-05 14:14:15
+}
+
+func ExampleNewFakeClock() {
+	clock := carbon.NewFakeClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+	c := carbon.Parse("2020-08-05 13:14:15").SetClock(clock)
+	fmt.Println(c.IsToday())
+	fmt.Println(c.DiffForHumans())
+
+	clock.Advance(24 * time.Hour)
+	fmt.Println(c.IsYesterday())
+	fmt.Println(c.DiffForHumans())
+
+	// Output:
+	// true
+	// just now
+	// true
+	// 1 day ago
+}
+
+func ExampleClockFromContext() {
+	clock := carbon.NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+	ctx := carbon.WithClock(context.Background(), clock)
+	fmt.Println(carbon.NowWithClock(carbon.ClockFromContext(ctx)).ToDateTimeString())
+
+	// Output:
+	// 2020-08-05 13:14:15
+}
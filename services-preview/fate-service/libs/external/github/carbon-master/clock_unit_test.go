@@ -0,0 +1,189 @@
+package carbon
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ClockSuite struct {
+	suite.Suite
+}
+
+func TestClockSuite(t *testing.T) {
+	suite.Run(t, new(ClockSuite))
+}
+
+func (s *ClockSuite) TearDownTest() {
+	ClearTestNow()
+}
+
+func (s *ClockSuite) TestFixedClock() {
+	t := time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC)
+	clock := NewFixedClock(t)
+	s.Equal(t, clock.Now())
+	s.Equal(t, clock.Now())
+}
+
+func (s *ClockSuite) TestOffsetClock() {
+	t := time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC)
+
+	s.Run("with clock", func() {
+		clock := NewOffsetClock(NewFixedClock(t), -time.Hour)
+		s.Equal(t.Add(-time.Hour), clock.Now())
+	})
+
+	s.Run("nil clock", func() {
+		SetTestNow(CreateFromStdTime(t))
+		s.Equal(t.Add(time.Hour), NewOffsetClock(nil, time.Hour).Now())
+		ClearTestNow()
+		s.WithinDuration(time.Now().Add(time.Hour), NewOffsetClock(nil, time.Hour).Now(), time.Minute)
+	})
+}
+
+func (s *ClockSuite) TestFakeClock() {
+	t := time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC)
+	clock := NewFakeClock(t)
+	s.Equal(t, clock.Now())
+
+	clock.Advance(time.Hour)
+	s.Equal(t.Add(time.Hour), clock.Now())
+
+	clock.Advance(-2 * time.Hour)
+	s.Equal(t.Add(-time.Hour), clock.Now())
+
+	clock.Set(t)
+	s.Equal(t, clock.Now())
+
+	s.Run("concurrent", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				clock.Advance(time.Second)
+				clock.Now()
+			}()
+		}
+		wg.Wait()
+		s.Equal(t.Add(100*time.Second), clock.Now())
+	})
+}
+
+func (s *ClockSuite) TestClockFromContext() {
+	clock := NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+
+	s.Run("nil context", func() {
+		var ctx context.Context
+		s.Equal(frozenClock{}, ClockFromContext(ctx))
+	})
+
+	s.Run("without clock", func() {
+		s.Equal(frozenClock{}, ClockFromContext(context.Background()))
+		s.Equal(frozenClock{}, ClockFromContext(WithClock(context.Background(), nil)))
+	})
+
+	s.Run("with clock", func() {
+		ctx := WithClock(context.Background(), clock)
+		s.Equal(clock, ClockFromContext(ctx))
+		s.Equal("2020-08-05 13:14:15", NowWithClock(ClockFromContext(ctx)).ToDateTimeString())
+	})
+}
+
+func (s *ClockSuite) TestFrozenClock() {
+	SetTestNow(Parse("2020-08-05 13:14:15", PRC))
+	s.Equal("2020-08-05 05:14:15 +0000 UTC", frozenClock{}.Now().UTC().String())
+
+	ClearTestNow()
+	s.WithinDuration(time.Now(), frozenClock{}.Now(), time.Minute)
+}
+
+func (s *ClockSuite) TestCarbon_Clock() {
+	clock := NewFakeClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Equal(frozenClock{}, c.Clock())
+	})
+
+	s.Run("without clock", func() {
+		s.Equal(frozenClock{}, Parse("2020-08-05").Clock())
+	})
+
+	s.Run("with clock", func() {
+		c := Parse("2020-08-05").SetClock(clock)
+		s.Equal(clock, c.Clock())
+		s.Equal(clock, c.Copy().Clock())
+		s.Equal(clock, c.AddDay().Clock())
+		s.Equal(clock, c.StartOfMonth().Clock())
+		s.Equal(clock, NowWithClock(clock).Clock())
+	})
+}
+
+func (s *ClockSuite) TestCarbon_ConsultClock() {
+	clock := NewFakeClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+	SetTestNow(Parse("2000-01-01"))
+
+	s.Run("comparer", func() {
+		now := NowWithClock(clock)
+		s.True(now.IsNow())
+		s.True(now.IsToday())
+		s.True(now.SubDay().IsYesterday())
+		s.True(now.AddDay().IsTomorrow())
+		s.True(now.AddHour().IsFuture())
+		s.True(now.SubHour().IsPast())
+		s.False(Parse("2020-08-05").IsToday())
+		s.True(Parse("2020-08-05").SetClock(clock).IsToday())
+	})
+
+	s.Run("difference", func() {
+		now := NowWithClock(clock)
+		s.Equal("just now", now.DiffForHumans())
+		s.Equal("1 day ago", now.SubDay().DiffForHumans())
+		s.Equal("2 months from now", Parse("2020-10-05 13:14:15").SetClock(clock).DiffForHumans())
+		s.Equal(int64(1), Parse("2020-08-04 13:14:15").SetClock(clock).DiffInDays())
+		s.Equal("P1D", Parse("2020-08-04 13:14:15").SetClock(clock).DiffInCalendarDuration().String())
+		s.Equal(20, Parse("2000-08-05").SetClock(clock).Age())
+	})
+
+	s.Run("advance clock", func() {
+		c := Parse("2020-08-05 13:14:15").SetClock(clock)
+		s.True(c.IsToday())
+		clock.Advance(24 * time.Hour)
+		s.True(c.IsYesterday())
+		s.Equal("1 day ago", c.DiffForHumans())
+		clock.Advance(-24 * time.Hour)
+	})
+
+	s.Run("timezone", func() {
+		c := Parse("2020-08-05 21:14:15", PRC).SetClock(clock)
+		s.True(c.IsNow())
+		s.True(c.IsToday())
+		s.True(Parse("2020-08-06 00:00:00", Tokyo).SetClock(NewFixedClock(clock.Now())).IsFuture())
+	})
+}
+
+func (s *ClockSuite) TestParallel() {
+	for i := 0; i < 10; i++ {
+		i := i
+		s.Run("parallel", func() {
+			day := time.Date(2020, 8, i+1, 0, 0, 0, 0, time.UTC)
+			clock := NewFakeClock(day)
+			var wg sync.WaitGroup
+			for j := 0; j < 10; j++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					now := NowWithClock(clock)
+					s.Equal(day.Format(DateLayout), now.ToDateString())
+					s.True(CreateFromStdTime(day).SetClock(clock).IsToday())
+				}()
+			}
+			wg.Wait()
+		})
+	}
+}
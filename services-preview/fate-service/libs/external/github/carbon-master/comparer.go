@@ -220,7 +220,7 @@ func (c *Carbon) IsNow() bool {
 	if c.IsInvalid() {
 		return false
 	}
-	return c.Timestamp() == Now().SetLocation(c.loc).Timestamp()
+	return c.Timestamp() == c.now().Timestamp()
 }
 
 // IsFuture reports whether it is future time.
@@ -231,7 +231,7 @@ func (c *Carbon) IsFuture() bool {
 	if c.IsZero() {
 		return false
 	}
-	return c.Timestamp() > Now().SetLocation(c.loc).Timestamp()
+	return c.Timestamp() > c.now().Timestamp()
 }
 
 // IsPast reports whether it is past time.
@@ -242,7 +242,7 @@ func (c *Carbon) IsPast() bool {
 	if c.IsZero() {
 		return true
 	}
-	return c.Timestamp() < Now().SetLocation(c.loc).Timestamp()
+	return c.Timestamp() < c.now().Timestamp()
 }
 
 // IsYesterday reports whether it is yesterday.
@@ -250,7 +250,7 @@ func (c *Carbon) IsYesterday() bool {
 	if c.IsInvalid() {
 		return false
 	}
-	return c.ToDateString() == Yesterday().SetLocation(c.loc).ToDateString()
+	return c.ToDateString() == c.now().SubDay().ToDateString()
 }
 
 // IsToday reports whether it is today.
@@ -258,7 +258,7 @@ func (c *Carbon) IsToday() bool {
 	if c.IsInvalid() {
 		return false
 	}
-	return c.ToDateString() == Now().SetLocation(c.loc).ToDateString()
+	return c.ToDateString() == c.now().ToDateString()
 }
 
 // IsTomorrow reports whether it is tomorrow.
@@ -266,7 +266,7 @@ func (c *Carbon) IsTomorrow() bool {
 	if c.IsInvalid() {
 		return false
 	}
-	return c.ToDateString() == Tomorrow().SetLocation(c.loc).ToDateString()
+	return c.ToDateString() == c.now().AddDay().ToDateString()
 }
 
 // IsSameCentury reports whether it is same century.
@@ -92,6 +92,16 @@ func CreateFromDate(year, month, day int, timezone ...string) *Carbon {
 	return create(year, month, day, MinHour, MinMinute, MinSecond, MinNanosecond, timezone...)
 }
 
+// CreateFromISOWeekDate creates a Carbon instance from a given ISO 8601 week-numbering year, week and day of week,
+// Monday is 1 and Sunday is 7.
+func CreateFromISOWeekDate(year, week, day int, timezone ...string) *Carbon {
+	c := create(year, int(time.January), isoWeekDay(year, week, day), MinHour, MinMinute, MinSecond, MinNanosecond, timezone...)
+	if !c.HasError() && !isValidISOWeekDate(year, week, day) {
+		c.Error = ErrInvalidISOWeekDate(year, week, day)
+	}
+	return c
+}
+
 // CreateFromDateMilli creates a Carbon instance from a given date and millisecond.
 func CreateFromDateMilli(year, month, day, millisecond int, timezone ...string) *Carbon {
 	return create(year, month, day, MinHour, MinMinute, MinSecond, millisecond*1e6, timezone...)
@@ -133,14 +143,7 @@ func CreateFromTimeNano(hour, minute, second, nanosecond int, timezone ...string
 
 // creates a new Carbon instance from a given date, time and nanosecond.
 func create(year, month, day, hour, minute, second, nanosecond int, timezone ...string) *Carbon {
-	var (
-		loc *Location
-		err error
-	)
-	if loc, err = parseTimezone(timezone...); err != nil {
-		return &Carbon{Error: err}
-	}
-	return NewCarbon(time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, loc))
+	return createByPolicy(DefaultDSTPolicy, year, month, day, hour, minute, second, nanosecond, timezone...)
 }
 
 // creates a new Carbon instance from a given date, time and nanosecond based on the existing Carbon.
@@ -153,6 +156,7 @@ func (c *Carbon) create(year, month, day, hour, minute, second, nanosecond int)
 		lang:          c.lang.Copy(),
 		currentLayout: c.currentLayout,
 		isEmpty:       c.isEmpty,
+		clock:         c.clock,
 		Error:         c.Error,
 	}
 }
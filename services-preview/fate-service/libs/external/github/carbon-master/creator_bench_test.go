@@ -319,6 +319,37 @@ func BenchmarkCreateFromDate(b *testing.B) {
 	})
 }
 
+func BenchmarkCreateFromISOWeekDate(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			CreateFromISOWeekDate(2020, 32, 3)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				CreateFromISOWeekDate(2020, 32, 3)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				CreateFromISOWeekDate(2020, 32, 3)
+			}
+		})
+	})
+}
+
 func BenchmarkCreateFromDateMilli(b *testing.B) {
 	b.Run("sequential", func(b *testing.B) {
 		b.ResetTimer()
@@ -117,6 +117,15 @@ func ExampleCreateFromDate() {
 	// 2020-08-05 00:00:00 +0800 CST
 }
 
+func ExampleCreateFromISOWeekDate() {
+	fmt.Println(carbon.CreateFromISOWeekDate(2020, 32, 3).ToString())
+	fmt.Println(carbon.CreateFromISOWeekDate(2020, 53, 7, carbon.PRC).ToString())
+
+	// Output:
+	// 2020-08-05 00:00:00 +0000 UTC
+	// 2021-01-03 00:00:00 +0800 CST
+}
+
 func ExampleCreateFromDateMilli() {
 	fmt.Println(carbon.CreateFromDateMilli(2020, 8, 5, 999).ToString())
 	fmt.Println(carbon.CreateFromDateMilli(2020, 8, 5, 999, carbon.PRC).ToString())
@@ -276,6 +276,50 @@ func (s *CreatorSuite) TestCreateFromDate() {
 	})
 }
 
+func (s *CreatorSuite) TestCreateFromISOWeekDate() {
+	s.Run("empty timezone", func() {
+		c := CreateFromISOWeekDate(2020, 1, 1, "")
+		s.True(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("error timezone", func() {
+		c := CreateFromISOWeekDate(2020, 1, 1, "xxx")
+		s.True(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("invalid week or day", func() {
+		c := CreateFromISOWeekDate(2021, 53, 1)
+		s.Equal(ErrInvalidISOWeekDate(2021, 53, 1), c.Error)
+		s.Empty(c.ToString())
+		s.True(CreateFromISOWeekDate(2020, 60, 9).HasError())
+		s.True(CreateFromISOWeekDate(2020, 0, 1).HasError())
+		s.True(CreateFromISOWeekDate(2020, 1, 0).HasError())
+		s.True(CreateFromISOWeekDate(2020, 1, 8).HasError())
+		// the timezone error comes first
+		s.ErrorContains(CreateFromISOWeekDate(2021, 53, 1, "xxx").Error, "invalid timezone")
+	})
+
+	s.Run("without timezone", func() {
+		s.Equal("2020-08-05 00:00:00 +0000 UTC", CreateFromISOWeekDate(2020, 32, 3).ToString())
+		s.Equal("2019-12-30 00:00:00 +0000 UTC", CreateFromISOWeekDate(2020, 1, 1).ToString())
+		s.Equal("2021-01-03 00:00:00 +0000 UTC", CreateFromISOWeekDate(2020, 53, 7).ToString())
+		s.Equal("2022-01-02 00:00:00 +0000 UTC", CreateFromISOWeekDate(2021, 52, 7).ToString())
+	})
+
+	s.Run("with timezone", func() {
+		s.Equal("2020-08-05 00:00:00 +0800 CST", CreateFromISOWeekDate(2020, 32, 3, PRC).ToString())
+		s.Equal("2026-12-28 00:00:00 +0800 CST", CreateFromISOWeekDate(2026, 53, 1, PRC).ToString())
+	})
+
+	s.Run("round trip", func() {
+		for c := Parse("2014-12-01"); c.Lt(Parse("2027-02-01")); c = c.AddDay() {
+			s.True(CreateFromISOWeekDate(c.ISOYear(), c.ISOWeek(), c.ISOWeekday()).Eq(c), c.ToDateString())
+		}
+	})
+}
+
 func (s *CreatorSuite) TestCreateFromDateMilli() {
 	s.Run("empty timezone", func() {
 		c := CreateFromDateMilli(0, 0, 0, 0, "")
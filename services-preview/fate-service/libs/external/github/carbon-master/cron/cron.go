@@ -0,0 +1,556 @@
+// Package cron is part of the carbon package, it parses cron expressions and computes their fire times.
+package cron
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dromara/carbon/v2"
+)
+
+const day = 24 * time.Hour
+
+// maxYears is the number of years searched for a fire time, which covers "0 0 29 2 *" across a non-leap century year
+const maxYears = 8
+
+// descriptors of the predefined schedules in six fields
+var descriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// field defines a field struct of cron expressions.
+type field struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var (
+	secondField  = field{name: "second", min: 0, max: 59}
+	minuteField  = field{name: "minute", min: 0, max: 59}
+	hourField    = field{name: "hour", min: 0, max: 23}
+	dayField     = field{name: "day-of-month", min: 1, max: 31}
+	monthField   = field{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}}
+	weekdayField = field{name: "day-of-week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}}
+)
+
+// Schedule defines a Schedule struct which is a parsed cron expression.
+type Schedule struct {
+	spec string
+
+	// bits of the allowed values of each field
+	second, minute, hour, day, month, weekday uint64
+	// isDayStar and isWeekdayStar report whether the day-of-month and day-of-week fields start with "*" or are "?",
+	// a day matches either of them if both are restricted like Vixie cron
+	isDayStar, isWeekdayStar bool
+	// every is the interval of the "@every" descriptor
+	every time.Duration
+	// loc is the location of the "CRON_TZ" or "TZ" prefix
+	loc   *time.Location
+	clock carbon.Clock
+	Error error
+}
+
+// Parse parses a cron expression as a Schedule instance, it supports five fields like "30 9 * * MON-FRI",
+// six fields with seconds like "0 30 9 * * MON-FRI", the descriptors like "@daily" and "@every 1h30m",
+// and an optional "CRON_TZ=" or "TZ=" prefix like "CRON_TZ=Asia/Shanghai 0 9 * * *".
+func Parse(spec string) *Schedule {
+	s := &Schedule{spec: strings.TrimSpace(spec)}
+	fields := strings.Fields(spec)
+	if len(fields) > 0 && (strings.HasPrefix(fields[0], "CRON_TZ=") || strings.HasPrefix(fields[0], "TZ=")) {
+		_, timezone, _ := strings.Cut(fields[0], "=")
+		if s.loc, s.Error = time.LoadLocation(timezone); s.Error != nil || timezone == "" {
+			s.Error = carbon.ErrInvalidTimezone(timezone)
+			return s
+		}
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		s.Error = ErrFailedParse(spec)
+		return s
+	}
+
+	if strings.HasPrefix(fields[0], "@") {
+		descriptor := strings.ToLower(fields[0])
+		if descriptor == "@every" {
+			if len(fields) != 2 {
+				s.Error = ErrFailedParse(spec)
+				return s
+			}
+			d, err := time.ParseDuration(fields[1])
+			if err != nil || d <= 0 {
+				s.Error = ErrInvalidEvery(fields[1])
+				return s
+			}
+			s.every = d
+			return s
+		}
+		expr, ok := descriptors[descriptor]
+		if !ok {
+			s.Error = ErrUnknownDescriptor(fields[0])
+			return s
+		}
+		if len(fields) != 1 {
+			s.Error = ErrFailedParse(spec)
+			return s
+		}
+		fields = strings.Fields(expr)
+	}
+
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		s.Error = ErrFailedParse(spec)
+		return s
+	}
+	s.isDayStar = strings.HasPrefix(fields[3], "*") || fields[3] == "?"
+	s.isWeekdayStar = strings.HasPrefix(fields[5], "*") || fields[5] == "?"
+	for i, f := range []struct {
+		field field
+		bits  *uint64
+	}{
+		{secondField, &s.second},
+		{minuteField, &s.minute},
+		{hourField, &s.hour},
+		{dayField, &s.day},
+		{monthField, &s.month},
+		{weekdayField, &s.weekday},
+	} {
+		if *f.bits, s.Error = parseField(fields[i], f.field, i == 3 || i == 5); s.Error != nil {
+			return s
+		}
+	}
+	// 7 is also sunday
+	if s.weekday&(1<<7) != 0 {
+		s.weekday = s.weekday&^(1<<7) | 1
+	}
+	return s
+}
+
+// WithClock returns a copy of the Schedule instance which gets now by the clock if no Carbon instance is given.
+func (s *Schedule) WithClock(clock carbon.Clock) *Schedule {
+	if s == nil {
+		return nil
+	}
+	schedule := *s
+	schedule.clock = clock
+	return &schedule
+}
+
+// String implements "Stringer" interface for Schedule.
+func (s *Schedule) String() string {
+	if s == nil || s.Error != nil {
+		return ""
+	}
+	return s.spec
+}
+
+// Next returns the first fire time after the Carbon instance, which defaults to now by the clock of the schedule.
+// The fire time is evaluated in the location of the Carbon instance, or the location of the "CRON_TZ" prefix if any,
+// and keeps the other settings of the Carbon instance like layout and locale.
+func (s *Schedule) Next(c ...*carbon.Carbon) *carbon.Carbon {
+	from, loc, err := s.prepare(c...)
+	if err != nil {
+		return &carbon.Carbon{Error: err}
+	}
+	if s.every > 0 {
+		return toCarbon(from, loc, from.StdTime().Add(s.every))
+	}
+	t, ok := s.next(from.StdTime(), loc)
+	if !ok {
+		return &carbon.Carbon{Error: ErrNoFireTime(s.spec)}
+	}
+	return toCarbon(from, loc, t)
+}
+
+// Prev returns the last fire time before the Carbon instance, which defaults to now by the clock of the schedule.
+// The fire time is evaluated in the location of the Carbon instance, or the location of the "CRON_TZ" prefix if any,
+// and keeps the other settings of the Carbon instance like layout and locale.
+func (s *Schedule) Prev(c ...*carbon.Carbon) *carbon.Carbon {
+	from, loc, err := s.prepare(c...)
+	if err != nil {
+		return &carbon.Carbon{Error: err}
+	}
+	if s.every > 0 {
+		return toCarbon(from, loc, from.StdTime().Add(-s.every))
+	}
+	t, ok := s.prev(from.StdTime(), loc)
+	if !ok {
+		return &carbon.Carbon{Error: ErrNoFireTime(s.spec)}
+	}
+	return toCarbon(from, loc, t)
+}
+
+// Between returns an Iterator instance of the fire times between start and end, both are included,
+// the fire times are computed lazily and those of the "@every" descriptor start at start.
+func (s *Schedule) Between(start, end *carbon.Carbon) *Iterator {
+	from, loc, err := s.prepare(start)
+	if err == nil {
+		_, _, err = s.prepare(end)
+	}
+	if err != nil {
+		return &Iterator{Error: err}
+	}
+	t, until := from.StdTime(), end.StdTime()
+	it := &Iterator{from: from, loc: loc}
+	if s.every > 0 {
+		it.next = func() (time.Time, bool) {
+			if t.After(until) {
+				return time.Time{}, false
+			}
+			fire := t
+			t = t.Add(s.every)
+			return fire, true
+		}
+		return it
+	}
+	t = t.Add(-time.Nanosecond)
+	it.next = func() (time.Time, bool) {
+		fire, ok := s.next(t, loc)
+		if !ok || fire.After(until) {
+			return time.Time{}, false
+		}
+		t = fire
+		return fire, true
+	}
+	return it
+}
+
+// prepare checks the schedule and the Carbon instance, and returns the Carbon instance or now,
+// and the location the fire times are evaluated in.
+func (s *Schedule) prepare(c ...*carbon.Carbon) (*carbon.Carbon, *time.Location, error) {
+	if s == nil {
+		return nil, nil, ErrNilSchedule()
+	}
+	if s.Error != nil {
+		return nil, nil, s.Error
+	}
+	var from *carbon.Carbon
+	if len(c) > 0 {
+		from = c[0]
+	} else if s.loc != nil {
+		from = carbon.NowWithClock(s.clock, s.loc.String())
+	} else {
+		from = carbon.NowWithClock(s.clock)
+	}
+	switch {
+	case from.IsNil():
+		return nil, nil, ErrNilCarbon()
+	case from.HasError():
+		return nil, nil, from.Error
+	case from.IsEmpty():
+		return nil, nil, ErrEmptyCarbon()
+	}
+	loc := from.StdTime().Location()
+	if s.loc != nil {
+		loc = s.loc
+	}
+	return from, loc, nil
+}
+
+// next returns the first fire time after t in the location.
+func (s *Schedule) next(t time.Time, loc *time.Location) (best time.Time, found bool) {
+	t = t.In(loc)
+	wall := wallClock(t).Truncate(time.Second).Add(-getShift(t, loc))
+	limit := wall.Year() + maxYears
+	for {
+		w, ok := s.nextMatch(wall, limit)
+		if !ok {
+			return
+		}
+		for _, instant := range s.resolve(w, loc) {
+			if instant.After(t) && (!found || instant.Before(best)) {
+				best, found = instant, true
+			}
+		}
+		// the later wall clock times can't be earlier than the best one beyond the shift around it
+		if found && !w.Before(wallClock(best).Add(getShift(best, loc))) {
+			return
+		}
+		wall = w.Add(time.Second)
+	}
+}
+
+// prev returns the last fire time before t in the location.
+func (s *Schedule) prev(t time.Time, loc *time.Location) (best time.Time, found bool) {
+	t = t.In(loc)
+	wall := wallClock(t).Add(getShift(t, loc)).Truncate(time.Second)
+	limit := wall.Year() - maxYears
+	for {
+		w, ok := s.prevMatch(wall, limit)
+		if !ok {
+			return
+		}
+		for _, instant := range s.resolve(w, loc) {
+			if instant.Before(t) && (!found || instant.After(best)) {
+				best, found = instant, true
+			}
+		}
+		// the earlier wall clock times can't be later than the best one beyond the shift around it
+		if found && !w.After(wallClock(best).Add(-getShift(best, loc))) {
+			return
+		}
+		wall = w.Add(-time.Second)
+	}
+}
+
+// resolve returns the fire times of a wall clock time in the location.
+// An ambiguous wall clock time fires once at the earlier instant, and a nonexistent one fires after the gap
+// with the offset before it, unless the schedule fires every hour, which fires at both ambiguous instants
+// and skips the nonexistent ones like Vixie cron.
+func (s *Schedule) resolve(wall time.Time, loc *time.Location) []time.Time {
+	var offsets []int
+	for _, d := range []time.Duration{-day, 0, day} {
+		_, offset := wall.Add(d).In(loc).Zone()
+		if !contains(offsets, offset) {
+			offsets = append(offsets, offset)
+		}
+	}
+	var instants []time.Time
+	for _, offset := range offsets {
+		if t := wall.Add(-time.Duration(offset) * time.Second).In(loc); wallClock(t).Equal(wall) {
+			instants = append(instants, t)
+		}
+	}
+	sort.Slice(instants, func(i, j int) bool {
+		return instants[i].Before(instants[j])
+	})
+
+	everyHour := s.hour == 1<<24-1
+	switch {
+	case len(instants) == 0 && everyHour:
+		return nil
+	case len(instants) == 0:
+		_, offset := wall.Add(-day).In(loc).Zone()
+		return []time.Time{wall.Add(-time.Duration(offset) * time.Second).In(loc)}
+	case len(instants) > 1 && !everyHour:
+		return instants[:1]
+	}
+	return instants
+}
+
+// nextMatch returns the first wall clock time at or after wall which matches the fields, until the year limit.
+func (s *Schedule) nextMatch(wall time.Time, limit int) (time.Time, bool) {
+	t := wall
+wrap:
+	if t.Year() > limit {
+		return t, false
+	}
+	for !has(s.month, int(t.Month())) {
+		t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+	for !s.matchDay(t) {
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		if t.Day() == 1 {
+			goto wrap
+		}
+	}
+	if hour, ok := nextBit(s.hour, t.Hour(), 23); !ok {
+		t = t.Truncate(time.Hour).Add(time.Duration(24-t.Hour()) * time.Hour)
+		goto wrap
+	} else if hour != t.Hour() {
+		t = t.Truncate(time.Hour).Add(time.Duration(hour-t.Hour()) * time.Hour)
+	}
+	if minute, ok := nextBit(s.minute, t.Minute(), 59); !ok {
+		t = t.Truncate(time.Hour).Add(time.Hour)
+		goto wrap
+	} else if minute != t.Minute() {
+		t = t.Truncate(time.Minute).Add(time.Duration(minute-t.Minute()) * time.Minute)
+	}
+	if second, ok := nextBit(s.second, t.Second(), 59); !ok {
+		t = t.Truncate(time.Minute).Add(time.Minute)
+		goto wrap
+	} else {
+		t = t.Add(time.Duration(second-t.Second()) * time.Second)
+	}
+	return t, true
+}
+
+// prevMatch returns the last wall clock time at or before wall which matches the fields, until the year limit.
+func (s *Schedule) prevMatch(wall time.Time, limit int) (time.Time, bool) {
+	t := wall
+wrap:
+	if t.Year() < limit {
+		return t, false
+	}
+	for !has(s.month, int(t.Month())) {
+		t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+		if t.Month() == time.December {
+			goto wrap
+		}
+	}
+	for !s.matchDay(t) {
+		month := t.Month()
+		t = time.Date(t.Year(), month, t.Day(), 0, 0, 0, 0, time.UTC).Add(-time.Second)
+		if t.Month() != month {
+			goto wrap
+		}
+	}
+	if hour, ok := prevBit(s.hour, t.Hour()); !ok {
+		t = t.Truncate(time.Hour).Add(-time.Duration(t.Hour())*time.Hour - time.Second)
+		goto wrap
+	} else if hour != t.Hour() {
+		t = t.Truncate(time.Hour).Add(-time.Duration(t.Hour()-hour-1)*time.Hour - time.Second)
+	}
+	if minute, ok := prevBit(s.minute, t.Minute()); !ok {
+		t = t.Truncate(time.Hour).Add(-time.Second)
+		goto wrap
+	} else if minute != t.Minute() {
+		t = t.Truncate(time.Minute).Add(-time.Duration(t.Minute()-minute-1)*time.Minute - time.Second)
+	}
+	if second, ok := prevBit(s.second, t.Second()); !ok {
+		t = t.Truncate(time.Minute).Add(-time.Second)
+		goto wrap
+	} else {
+		t = t.Add(-time.Duration(t.Second()-second) * time.Second)
+	}
+	return t, true
+}
+
+// matchDay reports whether the day of the wall clock time matches the day-of-month and day-of-week fields.
+func (s *Schedule) matchDay(t time.Time) bool {
+	dayMatched, weekdayMatched := has(s.day, t.Day()), has(s.weekday, int(t.Weekday()))
+	if !s.isDayStar && !s.isWeekdayStar {
+		return dayMatched || weekdayMatched
+	}
+	return dayMatched && weekdayMatched
+}
+
+// parseField parses a field like "*", "1-5", "*/15", "MON-FRI" or "1,15" as the bits of the allowed values,
+// "?" is the same as "*" if allowed.
+func parseField(value string, f field, allowQuestion bool) (bits uint64, err error) {
+	for _, item := range strings.Split(value, ",") {
+		rangeValue, stepValue, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			if step, err = strconv.Atoi(stepValue); err != nil || step <= 0 {
+				return 0, ErrInvalidField(f.name, value)
+			}
+		}
+
+		var low, high int
+		switch {
+		case rangeValue == "*" || (rangeValue == "?" && allowQuestion && !hasStep):
+			low, high = f.min, f.max
+			if f.max == 7 {
+				high = 6
+			}
+		default:
+			lowValue, highValue, isRange := strings.Cut(rangeValue, "-")
+			if low, err = parseValue(lowValue, f); err != nil {
+				return 0, ErrInvalidField(f.name, value)
+			}
+			high = low
+			if isRange {
+				if high, err = parseValue(highValue, f); err != nil {
+					return 0, ErrInvalidField(f.name, value)
+				}
+			} else if hasStep {
+				high = f.max
+			}
+		}
+		if low > high {
+			return 0, ErrInvalidField(f.name, value)
+		}
+		for v := low; v <= high; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// parseValue parses a value of the field like "5" or "MON".
+func parseValue(value string, f field) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return f.min + i, nil
+		}
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil || v < f.min || v > f.max {
+		return 0, ErrInvalidField(f.name, value)
+	}
+	return v, nil
+}
+
+// toCarbon converts the time to a Carbon instance in the location with the other settings of c like layout and locale.
+func toCarbon(c *carbon.Carbon, loc *time.Location, t time.Time) *carbon.Carbon {
+	t = t.In(loc)
+	r := c.Copy().SetLocation(loc).SetDateTimeNano(t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+	// the second one of an ambiguous wall clock time needs to be adjusted
+	if d := t.Sub(r.StdTime()); d != 0 {
+		r = r.AddNanoseconds(int(d))
+	}
+	return r
+}
+
+// getShift returns the largest change of the offsets in the location a day around t,
+// which bounds how far the order of wall clock times and instants can differ.
+func getShift(t time.Time, loc *time.Location) time.Duration {
+	low, high := 0, 0
+	for i, d := range []time.Duration{-day, 0, day} {
+		_, offset := t.Add(d).In(loc).Zone()
+		if i == 0 || offset < low {
+			low = offset
+		}
+		if i == 0 || offset > high {
+			high = offset
+		}
+	}
+	return time.Duration(high-low) * time.Second
+}
+
+// wallClock returns the wall clock of the time in UTC.
+func wallClock(t time.Time) time.Time {
+	year, month, d := t.Date()
+	hour, minute, second := t.Clock()
+	return time.Date(year, month, d, hour, minute, second, t.Nanosecond(), time.UTC)
+}
+
+// nextBit returns the smallest value in the bits at or after from until max.
+func nextBit(bits uint64, from, max int) (int, bool) {
+	for v := from; v <= max; v++ {
+		if has(bits, v) {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// prevBit returns the largest value in the bits at or before from.
+func prevBit(bits uint64, from int) (int, bool) {
+	for v := from; v >= 0; v-- {
+		if has(bits, v) {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// has reports whether the bits contain the value.
+func has(bits uint64, value int) bool {
+	return bits&(1<<uint(value)) != 0
+}
+
+// contains reports whether the integers contain the value.
+func contains(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package cron
+
+import (
+	"testing"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func BenchmarkParse(b *testing.B) {
+	specs := []string{
+		"30 9 * * MON-FRI",
+		"0 */15 0-6,22-23 1,15 JAN-MAR ?",
+		"CRON_TZ=America/New_York 0 9 * * *",
+		"@daily",
+		"@every 1h30m",
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Parse(specs[i%len(specs)])
+	}
+}
+
+func BenchmarkSchedule_Next(b *testing.B) {
+	c := carbon.Parse("2020-08-05 13:14:15", "America/New_York")
+	schedules := []*Schedule{
+		Parse("* * * * * *"),
+		Parse("30 9 * * MON-FRI"),
+		Parse("0 0 1,15 * MON"),
+		Parse("0 0 29 2 *"),
+		Parse("@every 1h30m"),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		schedules[i%len(schedules)].Next(c)
+	}
+}
+
+func BenchmarkSchedule_Prev(b *testing.B) {
+	c := carbon.Parse("2020-08-05 13:14:15", "America/New_York")
+	schedules := []*Schedule{
+		Parse("* * * * * *"),
+		Parse("30 9 * * MON-FRI"),
+		Parse("0 0 1,15 * MON"),
+		Parse("0 0 29 2 *"),
+		Parse("@every 1h30m"),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		schedules[i%len(schedules)].Prev(c)
+	}
+}
+
+func BenchmarkSchedule_Between(b *testing.B) {
+	s := Parse("*/5 * * * *")
+	start := carbon.Parse("2020-11-01 00:00:00", "America/New_York")
+	end := carbon.Parse("2020-11-01 03:00:00", "America/New_York")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Between(start, end).Take(100)
+	}
+}
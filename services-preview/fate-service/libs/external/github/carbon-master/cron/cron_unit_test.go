@@ -0,0 +1,339 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dromara/carbon/v2"
+)
+
+const newYork = "America/New_York"
+
+func TestParse(t *testing.T) {
+	t.Run("valid spec", func(t *testing.T) {
+		specs := []string{
+			"* * * * *",
+			"30 9 * * MON-FRI",
+			"0 30 9 * * mon-fri",
+			"*/15 0-6,22-23 1,15 JAN-MAR,dec ?",
+			"0 0 ? * 1-7",
+			"5/10 * * * *",
+			"0 12 * * 7",
+			"@yearly",
+			"@annually",
+			"@monthly",
+			"@weekly",
+			"@DAILY",
+			"@midnight",
+			"@hourly",
+			"@every 1h30m",
+			"CRON_TZ=Asia/Shanghai 0 9 * * *",
+			"TZ=UTC @daily",
+		}
+		for _, spec := range specs {
+			s := Parse(spec)
+			assert.Nil(t, s.Error, spec)
+			assert.Equal(t, spec, s.String())
+		}
+		assert.Equal(t, "@daily", Parse("  @daily ").String())
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		specs := []string{
+			"",
+			"* * * *",
+			"* * * * * * *",
+			"60 * * * *",
+			"* 24 * * *",
+			"* * 0 * *",
+			"* * 32 * *",
+			"* * * 13 *",
+			"* * * * 8",
+			"* * * FOO *",
+			"* * * * MON-",
+			"5-1 * * * *",
+			"*/0 * * * *",
+			"*/x * * * *",
+			"? * * * *",
+			"* * ?/2 * *",
+			"x * * * *",
+			"@reboot",
+			"@daily *",
+			"@every",
+			"@every 0s",
+			"@every -1h",
+			"@every x",
+			"CRON_TZ=xxx 0 9 * * *",
+			"CRON_TZ= 0 9 * * *",
+			"CRON_TZ=UTC",
+		}
+		for _, spec := range specs {
+			assert.Error(t, Parse(spec).Error, spec)
+			assert.Empty(t, Parse(spec).String(), spec)
+		}
+		assert.Equal(t, ErrInvalidField("minute", "60"), Parse("60 * * * *").Error)
+		assert.Equal(t, ErrUnknownDescriptor("@reboot"), Parse("@reboot").Error)
+		assert.Equal(t, ErrInvalidEvery("0s"), Parse("@every 0s").Error)
+		assert.Equal(t, carbon.ErrInvalidTimezone("xxx"), Parse("CRON_TZ=xxx 0 9 * * *").Error)
+	})
+
+	t.Run("sunday", func(t *testing.T) {
+		assert.Equal(t, Parse("0 0 * * 0").weekday, Parse("0 0 * * 7").weekday)
+		assert.Equal(t, Parse("0 0 * * *").weekday, Parse("0 0 * * 1-7").weekday)
+	})
+}
+
+func TestSchedule_Next(t *testing.T) {
+	c := carbon.Parse("2020-08-05 13:14:15", carbon.PRC)
+
+	t.Run("invalid schedule", func(t *testing.T) {
+		var s *Schedule
+		assert.Equal(t, ErrNilSchedule(), s.Next(c).Error)
+		assert.Error(t, Parse("xxx").Next(c).Error)
+		assert.Equal(t, ErrNoFireTime("0 0 30 2 *"), Parse("0 0 30 2 *").Next(c).Error)
+	})
+
+	t.Run("invalid carbon", func(t *testing.T) {
+		s := Parse("@daily")
+		assert.Equal(t, ErrNilCarbon(), s.Next(nil).Error)
+		assert.Equal(t, ErrEmptyCarbon(), s.Next(carbon.Parse("")).Error)
+		assert.Error(t, s.Next(carbon.Parse("xxx")).Error)
+	})
+
+	t.Run("valid schedule", func(t *testing.T) {
+		for spec, next := range map[string]string{
+			"* * * * *":            "2020-08-05 13:15:00 +0800 CST",
+			"* * * * * *":          "2020-08-05 13:14:16 +0800 CST",
+			"30 9 * * MON-FRI":     "2020-08-06 09:30:00 +0800 CST",
+			"0 30 9 * * *":         "2020-08-06 09:30:00 +0800 CST",
+			"*/20 13 * * *":        "2020-08-05 13:20:00 +0800 CST",
+			"0 0 1,15 * *":         "2020-08-15 00:00:00 +0800 CST",
+			"0 0 1,15 * MON":       "2020-08-10 00:00:00 +0800 CST",
+			"0 0 */10 * MON":       "2020-08-31 00:00:00 +0800 CST",
+			"0 0 31 * *":           "2020-08-31 00:00:00 +0800 CST",
+			"0 0 * FEB SUN":        "2021-02-07 00:00:00 +0800 CST",
+			"0 0 29 2 *":           "2024-02-29 00:00:00 +0800 CST",
+			"@yearly":              "2021-01-01 00:00:00 +0800 CST",
+			"@monthly":             "2020-09-01 00:00:00 +0800 CST",
+			"@weekly":              "2020-08-09 00:00:00 +0800 CST",
+			"@daily":               "2020-08-06 00:00:00 +0800 CST",
+			"@hourly":              "2020-08-05 14:00:00 +0800 CST",
+			"@every 1h30m":         "2020-08-05 14:44:15 +0800 CST",
+			"TZ=Asia/Tokyo @daily": "2020-08-06 00:00:00 +0900 JST",
+		} {
+			assert.Equal(t, next, Parse(spec).Next(c).ToString(), spec)
+		}
+
+		// a restricted day-of-month matches either day field, but one starting with "*" must match both
+		assert.NotEqual(t, Parse("0 0 1,11,21,31 * MON").Next(c).ToString(), Parse("0 0 */10 * MON").Next(c).ToString())
+
+		// the fire time is strictly after the given time
+		assert.Equal(t, "2020-08-06 13:14:15 +0800 CST", Parse("15 14 13 * * *").Next(c).ToString())
+		assert.Equal(t, "2020-08-05 13:14:15 +0800 CST", Parse("15 14 13 * * *").Next(c.Copy().SubNanosecond()).ToString())
+		// the non-leap century year is skipped
+		assert.Equal(t, "2104-02-29 00:00:00 +0000 UTC", Parse("0 0 29 2 *").Next(carbon.Parse("2097-03-01", carbon.UTC)).ToString())
+	})
+
+	t.Run("settings of carbon", func(t *testing.T) {
+		next := Parse("@daily").Next(c.Copy().SetLayout(carbon.DateLayout).SetLocale("zh-CN"))
+		assert.Equal(t, "2020-08-06", next.String())
+		assert.Equal(t, "zh-CN", next.Locale())
+	})
+
+	t.Run("spring forward", func(t *testing.T) {
+		// 2020-03-08 02:00:00 EST jumps to 03:00:00 EDT in New York
+		from := carbon.Parse("2020-03-08 00:00:00", newYork)
+		// a fixed time in the gap fires after the gap
+		assert.Equal(t, "2020-03-08 03:30:00 -0400 EDT", Parse("30 2 * * *").Next(from).ToString())
+		assert.Equal(t, "2020-03-09 02:30:00 -0400 EDT", Parse("30 2 * * *").Next(carbon.Parse("2020-03-08 03:30:00", newYork)).ToString())
+		// the hourly schedules skip the gap
+		assert.Equal(t, "2020-03-08 03:00:00 -0400 EDT", Parse("*/30 * * * *").Next(carbon.Parse("2020-03-08 01:45:00", newYork)).ToString())
+	})
+
+	t.Run("fall back", func(t *testing.T) {
+		// 2020-11-01 02:00:00 EDT falls back to 01:00:00 EST in New York
+		from := carbon.Parse("2020-11-01 00:00:00", newYork)
+		// a fixed time in the repeated hour fires once
+		s := Parse("30 1 * * *")
+		next := s.Next(from)
+		assert.Equal(t, "2020-11-01 01:30:00 -0400 EDT", next.ToString())
+		assert.Equal(t, "2020-11-02 01:30:00 -0500 EST", s.Next(next).ToString())
+		// the hourly schedules fire in both hours
+		s = Parse("30 * * * *")
+		next = s.Next(from.Copy().AddHour())
+		assert.Equal(t, "2020-11-01 01:30:00 -0400 EDT", next.ToString())
+		next = s.Next(next)
+		assert.Equal(t, "2020-11-01 01:30:00 -0500 EST", next.ToString())
+		assert.Equal(t, "2020-11-01 02:30:00 -0500 EST", s.Next(next).ToString())
+	})
+
+	t.Run("clock", func(t *testing.T) {
+		clock := carbon.NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+		s := Parse("0 9 * * *").WithClock(clock)
+		assert.Equal(t, "2020-08-06 09:00:00 +0000 UTC", s.Next().ToString())
+		assert.Equal(t, "2020-08-06 09:00:00 +0800 CST", Parse("CRON_TZ=PRC 0 9 * * *").WithClock(clock).Next().ToString())
+		assert.Nil(t, (*Schedule)(nil).WithClock(clock))
+
+		carbon.SetTestNow(carbon.Parse("2020-08-05 13:14:15", carbon.UTC))
+		defer carbon.ClearTestNow()
+		assert.Equal(t, "2020-08-05 14:00:00 +0000 UTC", Parse("@hourly").Next().ToString())
+	})
+}
+
+func TestSchedule_Prev(t *testing.T) {
+	c := carbon.Parse("2020-08-05 13:14:15", carbon.PRC)
+
+	t.Run("invalid schedule", func(t *testing.T) {
+		var s *Schedule
+		assert.Equal(t, ErrNilSchedule(), s.Prev(c).Error)
+		assert.Error(t, Parse("xxx").Prev(c).Error)
+		assert.Equal(t, ErrNoFireTime("0 0 30 2 *"), Parse("0 0 30 2 *").Prev(c).Error)
+	})
+
+	t.Run("invalid carbon", func(t *testing.T) {
+		assert.Equal(t, ErrNilCarbon(), Parse("@daily").Prev(nil).Error)
+	})
+
+	t.Run("valid schedule", func(t *testing.T) {
+		for spec, prev := range map[string]string{
+			"* * * * *":        "2020-08-05 13:14:00 +0800 CST",
+			"* * * * * *":      "2020-08-05 13:14:14 +0800 CST",
+			"30 9 * * MON-FRI": "2020-08-05 09:30:00 +0800 CST",
+			"0 30 9 * * SAT":   "2020-08-01 09:30:00 +0800 CST",
+			"*/20 14 * * *":    "2020-08-04 14:40:00 +0800 CST",
+			"0 0 1,15 * *":     "2020-08-01 00:00:00 +0800 CST",
+			"0 0 31 * *":       "2020-07-31 00:00:00 +0800 CST",
+			"0 0 * FEB SUN":    "2020-02-23 00:00:00 +0800 CST",
+			"0 0 29 2 *":       "2020-02-29 00:00:00 +0800 CST",
+			"@yearly":          "2020-01-01 00:00:00 +0800 CST",
+			"@monthly":         "2020-08-01 00:00:00 +0800 CST",
+			"@weekly":          "2020-08-02 00:00:00 +0800 CST",
+			"@daily":           "2020-08-05 00:00:00 +0800 CST",
+			"@hourly":          "2020-08-05 13:00:00 +0800 CST",
+			"@every 1h30m":     "2020-08-05 11:44:15 +0800 CST",
+		} {
+			assert.Equal(t, prev, Parse(spec).Prev(c).ToString(), spec)
+		}
+		// the fire time is strictly before the given time
+		assert.Equal(t, "2020-08-04 13:14:15 +0800 CST", Parse("15 14 13 * * *").Prev(c).ToString())
+		assert.Equal(t, "2020-08-05 13:14:15 +0800 CST", Parse("15 14 13 * * *").Prev(c.Copy().AddNanosecond()).ToString())
+	})
+
+	t.Run("fall back", func(t *testing.T) {
+		s := Parse("0,30 * * * *")
+		c := carbon.Parse("2020-11-01 02:00:00", newYork)
+		var actual []string
+		for i := 0; i < 5; i++ {
+			c = s.Prev(c)
+			actual = append(actual, c.ToString())
+		}
+		assert.Equal(t, []string{
+			"2020-11-01 01:30:00 -0500 EST",
+			"2020-11-01 01:00:00 -0500 EST",
+			"2020-11-01 01:30:00 -0400 EDT",
+			"2020-11-01 01:00:00 -0400 EDT",
+			"2020-11-01 00:30:00 -0400 EDT",
+		}, actual)
+	})
+
+	t.Run("spring forward", func(t *testing.T) {
+		assert.Equal(t, "2020-03-08 03:30:00 -0400 EDT", Parse("30 2 * * *").Prev(carbon.Parse("2020-03-09 00:00:00", newYork)).ToString())
+		assert.Equal(t, "2020-03-08 01:30:00 -0500 EST", Parse("*/30 * * * *").Prev(carbon.Parse("2020-03-08 03:00:00", newYork)).ToString())
+	})
+}
+
+func TestSchedule_Between(t *testing.T) {
+	start := carbon.Parse("2020-08-05 09:00:00", carbon.PRC)
+	end := carbon.Parse("2020-08-05 11:00:00", carbon.PRC)
+
+	toStrings := func(it *Iterator) []string {
+		actual := make([]string, 0)
+		for c, ok := it.Next(); ok; c, ok = it.Next() {
+			actual = append(actual, c.ToString())
+		}
+		return actual
+	}
+
+	t.Run("invalid schedule or carbon", func(t *testing.T) {
+		assert.Equal(t, ErrNilSchedule(), (*Schedule)(nil).Between(start, end).Error)
+		assert.Error(t, Parse("xxx").Between(start, end).Error)
+		assert.Equal(t, ErrNilCarbon(), Parse("@hourly").Between(nil, end).Error)
+		assert.Error(t, Parse("@hourly").Between(start, carbon.Parse("xxx")).Error)
+		assert.Empty(t, Parse("@hourly").Between(start, carbon.Parse("xxx")).Take(10))
+		assert.Empty(t, toStrings(Parse("@hourly").Between(end, start)))
+		assert.Empty(t, toStrings(Parse("@every 1h").Between(end, start)))
+
+		var it *Iterator
+		c, ok := it.Next()
+		assert.Nil(t, c)
+		assert.False(t, ok)
+	})
+
+	t.Run("valid schedule", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"2020-08-05 09:00:00 +0800 CST",
+			"2020-08-05 10:00:00 +0800 CST",
+			"2020-08-05 11:00:00 +0800 CST",
+		}, toStrings(Parse("@hourly").Between(start, end)))
+		assert.Equal(t, []string{
+			"2020-08-05 09:45:00 +0800 CST",
+			"2020-08-05 10:45:00 +0800 CST",
+		}, toStrings(Parse("45 * * * *").Between(start, end)))
+		assert.Equal(t, []string{
+			"2020-08-05 09:00:00 +0800 CST",
+			"2020-08-05 09:50:00 +0800 CST",
+			"2020-08-05 10:40:00 +0800 CST",
+		}, toStrings(Parse("@every 50m").Between(start, end)))
+		assert.Empty(t, toStrings(Parse("0 0 30 2 *").Between(start, end)))
+	})
+
+	t.Run("lazy fire times", func(t *testing.T) {
+		// a hundred years of fire times every second are only computed when taken
+		it := Parse("* * * * * *").Between(start, start.AddYears(100))
+		assert.Equal(t, []string{
+			"2020-08-05 09:00:00 +0800 CST",
+			"2020-08-05 09:00:01 +0800 CST",
+			"2020-08-05 09:00:02 +0800 CST",
+		}, func() []string {
+			actual := make([]string, 0)
+			for _, c := range it.Take(3) {
+				actual = append(actual, c.ToString())
+			}
+			return actual
+		}())
+		c, ok := it.Next()
+		assert.True(t, ok)
+		assert.Equal(t, "2020-08-05 09:00:03 +0800 CST", c.ToString())
+
+		it = Parse("@every 1ms").Between(start, start.AddYears(100))
+		assert.Len(t, it.Take(1000), 1000)
+		c, ok = it.Next()
+		assert.True(t, ok)
+		assert.Equal(t, "2020-08-05 09:00:01", c.ToDateTimeString())
+
+		it = Parse("@hourly").Between(start, end)
+		assert.Len(t, it.Take(10), 3)
+		c, ok = it.Next()
+		assert.Nil(t, c)
+		assert.False(t, ok)
+	})
+
+	t.Run("dst transitions", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"2020-11-01 00:30:00 -0400 EDT",
+			"2020-11-01 01:00:00 -0400 EDT",
+			"2020-11-01 01:30:00 -0400 EDT",
+			"2020-11-01 01:00:00 -0500 EST",
+			"2020-11-01 01:30:00 -0500 EST",
+			"2020-11-01 02:00:00 -0500 EST",
+		}, toStrings(Parse("0,30 * * * *").Between(carbon.Parse("2020-11-01 00:30:00", newYork), carbon.Parse("2020-11-01 02:00:00", newYork))))
+		assert.Equal(t, []string{
+			"2020-03-08 01:00:00 -0500 EST",
+			"2020-03-08 01:30:00 -0500 EST",
+			"2020-03-08 03:00:00 -0400 EDT",
+			"2020-03-08 03:30:00 -0400 EDT",
+		}, toStrings(Parse("0,30 1-3 * * *").Between(carbon.Parse("2020-03-08 00:00:00", newYork), carbon.Parse("2020-03-08 05:00:00", newYork))))
+	})
+}
@@ -0,0 +1,47 @@
+package cron
+
+import (
+	"fmt"
+)
+
+var (
+	// ErrFailedParse failed to parse error.
+	ErrFailedParse = func(value any) error {
+		return fmt.Errorf("failed to parse %v as cron expression", value)
+	}
+
+	// ErrInvalidField invalid field error.
+	ErrInvalidField = func(name, value string) error {
+		return fmt.Errorf("invalid %s field %q", name, value)
+	}
+
+	// ErrUnknownDescriptor unknown descriptor error.
+	ErrUnknownDescriptor = func(descriptor string) error {
+		return fmt.Errorf("unknown descriptor %q", descriptor)
+	}
+
+	// ErrInvalidEvery invalid every descriptor error.
+	ErrInvalidEvery = func(value string) error {
+		return fmt.Errorf("invalid @every duration %q, it must be a positive duration like %q", value, "1h30m")
+	}
+
+	// ErrNilSchedule nil schedule error.
+	ErrNilSchedule = func() error {
+		return fmt.Errorf("schedule cannot be nil")
+	}
+
+	// ErrNilCarbon nil carbon error.
+	ErrNilCarbon = func() error {
+		return fmt.Errorf("carbon cannot be nil")
+	}
+
+	// ErrEmptyCarbon empty carbon error.
+	ErrEmptyCarbon = func() error {
+		return fmt.Errorf("carbon cannot be empty")
+	}
+
+	// ErrNoFireTime no fire time error.
+	ErrNoFireTime = func(spec string) error {
+		return fmt.Errorf("cron expression %q never fires", spec)
+	}
+)
@@ -0,0 +1,41 @@
+package cron
+
+import (
+	"time"
+
+	"github.com/dromara/carbon/v2"
+)
+
+// Iterator defines an Iterator struct which computes the fire times of a schedule lazily.
+type Iterator struct {
+	from  *carbon.Carbon
+	loc   *time.Location
+	next  func() (time.Time, bool)
+	Error error
+}
+
+// Next returns the next fire time as a Carbon instance, ok is false once the fire times are exhausted.
+func (it *Iterator) Next() (c *carbon.Carbon, ok bool) {
+	if it == nil || it.Error != nil || it.next == nil {
+		return nil, false
+	}
+	t, ok := it.next()
+	if !ok {
+		it.next = nil
+		return nil, false
+	}
+	return toCarbon(it.from, it.loc, t), true
+}
+
+// Take returns at most the next n fire times.
+func (it *Iterator) Take(n int) []*carbon.Carbon {
+	occurrences := make([]*carbon.Carbon, 0)
+	for i := 0; i < n; i++ {
+		c, ok := it.Next()
+		if !ok {
+			break
+		}
+		occurrences = append(occurrences, c)
+	}
+	return occurrences
+}
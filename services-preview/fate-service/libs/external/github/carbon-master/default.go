@@ -17,6 +17,12 @@ var (
 	DefaultWeekendDays = []Weekday{
 		Saturday, Sunday,
 	}
+
+	// DefaultDSTPolicy default policy of resolving ambiguous and non-existent local times, empty means leaving it to time.Date
+	DefaultDSTPolicy DSTPolicy
+
+	// DefaultNullPolicy default policy of emitting nil, zero and empty values of Null generic struct
+	DefaultNullPolicy = NullAsNull
 )
 
 type Default struct {
@@ -25,6 +31,8 @@ type Default struct {
 	Locale       string
 	WeekStartsAt Weekday
 	WeekendDays  []Weekday
+	DSTPolicy    DSTPolicy
+	NullPolicy   NullPolicy
 }
 
 // SetDefault sets default.
@@ -44,6 +52,12 @@ func SetDefault(d Default) {
 	if len(d.WeekendDays) > 0 {
 		DefaultWeekendDays = d.WeekendDays
 	}
+	if d.DSTPolicy != "" {
+		DefaultDSTPolicy = d.DSTPolicy
+	}
+	if d.NullPolicy != "" {
+		DefaultNullPolicy = d.NullPolicy
+	}
 }
 
 // ResetDefault resets default.
@@ -55,4 +69,6 @@ func ResetDefault() {
 	DefaultWeekendDays = []Weekday{
 		Saturday, Sunday,
 	}
+	DefaultDSTPolicy = ""
+	DefaultNullPolicy = NullAsNull
 }
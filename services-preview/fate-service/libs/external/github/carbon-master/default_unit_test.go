@@ -27,6 +27,8 @@ func (s *DefaultSuite) TestSetDefault() {
 		WeekendDays: []Weekday{
 			Saturday, Sunday,
 		},
+		DSTPolicy:  DSTError,
+		NullPolicy: NullAsEmpty,
 	})
 
 	s.Equal(DateTimeLayout, DefaultLayout)
@@ -36,4 +38,14 @@ func (s *DefaultSuite) TestSetDefault() {
 	s.Equal([]Weekday{
 		Saturday, Sunday,
 	}, DefaultWeekendDays)
+	s.Equal(DSTError, DefaultDSTPolicy)
+	s.Equal(NullAsEmpty, DefaultNullPolicy)
+}
+
+func (s *DefaultSuite) TestResetDefault() {
+	SetDefault(Default{
+		NullPolicy: NullAsOmit,
+	})
+	ResetDefault()
+	s.Equal(NullAsNull, DefaultNullPolicy)
 }
@@ -0,0 +1,404 @@
+package carbon
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Dialect defines a Dialect interface which translates a pattern of a date format dialect into a Go layout,
+// it can be implemented to plug in another dialect for FormatWith and ParseWith.
+type Dialect interface {
+	Layout(pattern string) (string, error)
+}
+
+// built-in dialects
+var (
+	// StrftimeDialect is the dialect of C strftime, like "%Y-%m-%d %H:%M:%S".
+	StrftimeDialect Dialect = strftimeDialect{}
+
+	// ICUDialect is the dialect of ICU and Java DateTimeFormatter, like "yyyy-MM-dd'T'HH:mm:ss".
+	ICUDialect Dialect = icuDialect{}
+
+	// JavaDialect is an alias of ICUDialect.
+	JavaDialect = ICUDialect
+
+	// MomentDialect is the dialect of moment.js and day.js, like "YYYY-MM-DD HH:mm:ss".
+	MomentDialect Dialect = momentDialect{}
+)
+
+// strftime directive map, the flag "-" removes the padding and the flag ":" adds a colon to the zone offset
+var strftimeMap = map[string]string{
+	"Y":  "2006",        // Year:   A full numeric representation of a year, 4 digits. Eg: 2020.
+	"y":  "06",          // Year:   A two digit representation of a year. Eg: 20.
+	"m":  "01",          // Month:  Numeric representation of a month, with leading zeros. Eg: 01 through 12.
+	"-m": "1",           // Month:  Numeric representation of a month, without leading zeros. Eg: 1 through 12.
+	"b":  "Jan",         // Month:  A short textual representation of a month. Eg: Jan through Dec.
+	"h":  "Jan",         // Month:  Same as %b.
+	"B":  "January",     // Month:  A full textual representation of a month. Eg: January through December.
+	"d":  "02",          // Day:    Day of the month, 2 digits with leading zeros. Eg: 01 to 31.
+	"-d": "2",           // Day:    Day of the month without leading zeros. Eg: 1 to 31.
+	"e":  "_2",          // Day:    Day of the month with leading spaces. Eg: " 1" to "31".
+	"j":  "002",         // Day:    Day of the year with leading zeros. Eg: 001 to 366.
+	"a":  "Mon",         // Week:   A short textual representation of the day of the week. Eg: Mon through Sun.
+	"A":  "Monday",      // Week:   A full textual representation of the day of the week. Eg: Monday through Sunday.
+	"H":  "15",          // Time:   24-hour format of an hour with leading zeros. Eg: 00 through 23.
+	"I":  "03",          // Time:   12-hour format of an hour with leading zeros. Eg: 01 through 12.
+	"-I": "3",           // Time:   12-hour format of an hour without leading zeros. Eg: 1 through 12.
+	"M":  "04",          // Time:   Minutes with leading zeros. Eg: 00 to 59.
+	"-M": "4",           // Time:   Minutes without leading zeros. Eg: 0 to 59.
+	"S":  "05",          // Time:   Seconds with leading zeros. Eg: 00 through 59.
+	"-S": "5",           // Time:   Seconds without leading zeros. Eg: 0 through 59.
+	"p":  "PM",          // Time:   Uppercase morning or afternoon sign. Eg: AM or PM.
+	"P":  "pm",          // Time:   Lowercase morning or afternoon sign. Eg: am or pm.
+	"L":  "000",         // Second: Millisecond, it must follow a dot or comma. Eg: 999.
+	"f":  "000000",      // Second: Microsecond, it must follow a dot or comma. Eg: 999999.
+	"N":  "000000000",   // Second: Nanosecond, it must follow a dot or comma. Eg: 999999999.
+	"z":  "-0700",       // Zone:   Difference to Greenwich time (GMT) in hours. Eg: +0800.
+	":z": "-07:00",      // Zone:   Difference to Greenwich time (GMT) with colon between hours and minutes. Eg: +08:00.
+	"Z":  "MST",         // Zone:   Zone name. Eg: UTC, EST, MDT ...
+	"D":  "01/02/06",    // Format: Same as %m/%d/%y.
+	"F":  "2006-01-02",  // Format: Same as %Y-%m-%d.
+	"T":  "15:04:05",    // Format: Same as %H:%M:%S.
+	"R":  "15:04",       // Format: Same as %H:%M.
+	"r":  "03:04:05 PM", // Format: Same as %I:%M:%S %p.
+	"n":  "\n",          // Text:   A newline.
+	"t":  "\t",          // Text:   A tab.
+	"%":  "%",           // Text:   A percent sign.
+}
+
+// icu pattern map, which is keyed by the repeated pattern letters
+var icuMap = map[string]string{
+	"y":         "2006",      // Year:   A full numeric representation of a year. Eg: 2020.
+	"yy":        "06",        // Year:   A two digit representation of a year. Eg: 20.
+	"yyyy":      "2006",      // Year:   A full numeric representation of a year, 4 digits. Eg: 2020.
+	"u":         "2006",      // Year:   Same as y.
+	"uu":        "06",        // Year:   Same as yy.
+	"uuuu":      "2006",      // Year:   Same as yyyy.
+	"M":         "1",         // Month:  Numeric representation of a month, without leading zeros. Eg: 1 through 12.
+	"MM":        "01",        // Month:  Numeric representation of a month, with leading zeros. Eg: 01 through 12.
+	"MMM":       "Jan",       // Month:  A short textual representation of a month. Eg: Jan through Dec.
+	"MMMM":      "January",   // Month:  A full textual representation of a month. Eg: January through December.
+	"L":         "1",         // Month:  Same as M in the standalone form.
+	"LL":        "01",        // Month:  Same as MM in the standalone form.
+	"LLL":       "Jan",       // Month:  Same as MMM in the standalone form.
+	"LLLL":      "January",   // Month:  Same as MMMM in the standalone form.
+	"d":         "2",         // Day:    Day of the month without leading zeros. Eg: 1 to 31.
+	"dd":        "02",        // Day:    Day of the month, 2 digits with leading zeros. Eg: 01 to 31.
+	"DDD":       "002",       // Day:    Day of the year with leading zeros. Eg: 001 to 366.
+	"E":         "Mon",       // Week:   A short textual representation of the day of the week. Eg: Mon through Sun.
+	"EE":        "Mon",       // Week:   Same as E.
+	"EEE":       "Mon",       // Week:   Same as E.
+	"EEEE":      "Monday",    // Week:   A full textual representation of the day of the week. Eg: Monday through Sunday.
+	"a":         "PM",        // Time:   Uppercase morning or afternoon sign. Eg: AM or PM.
+	"HH":        "15",        // Time:   24-hour format of an hour with leading zeros. Eg: 00 through 23.
+	"h":         "3",         // Time:   12-hour format of an hour without leading zeros. Eg: 1 through 12.
+	"hh":        "03",        // Time:   12-hour format of an hour with leading zeros. Eg: 01 through 12.
+	"m":         "4",         // Time:   Minutes without leading zeros. Eg: 0 to 59.
+	"mm":        "04",        // Time:   Minutes with leading zeros. Eg: 00 to 59.
+	"s":         "5",         // Time:   Seconds without leading zeros. Eg: 0 through 59.
+	"ss":        "05",        // Time:   Seconds with leading zeros. Eg: 00 through 59.
+	"S":         "0",         // Second: Tenth of a second, it must follow a dot or comma. Eg: 9.
+	"SS":        "00",        // Second: Hundredth of a second, it must follow a dot or comma. Eg: 99.
+	"SSS":       "000",       // Second: Millisecond, it must follow a dot or comma. Eg: 999.
+	"SSSSSS":    "000000",    // Second: Microsecond, it must follow a dot or comma. Eg: 999999.
+	"SSSSSSSSS": "000000000", // Second: Nanosecond, it must follow a dot or comma. Eg: 999999999.
+	"z":         "MST",       // Zone:   Zone name. Eg: UTC, EST, MDT ...
+	"zz":        "MST",       // Zone:   Same as z.
+	"zzz":       "MST",       // Zone:   Same as z.
+	"Z":         "-0700",     // Zone:   Difference to Greenwich time (GMT) in hours. Eg: +0800.
+	"ZZ":        "-0700",     // Zone:   Same as Z.
+	"ZZZ":       "-0700",     // Zone:   Same as Z.
+	"ZZZZZ":     "-07:00",    // Zone:   Difference to Greenwich time (GMT) with colon between hours and minutes. Eg: +08:00.
+	"X":         "Z07",       // Zone:   ISO8601 timezone in hours. Eg: Z, +08.
+	"XX":        "Z0700",     // Zone:   ISO8601 timezone. Eg: Z, +0800.
+	"XXX":       "Z07:00",    // Zone:   ISO8601 colon timezone. Eg: Z, +08:00.
+	"x":         "-07",       // Zone:   Difference to Greenwich time (GMT) in hours. Eg: +08.
+	"xx":        "-0700",     // Zone:   Same as Z.
+	"xxx":       "-07:00",    // Zone:   Same as ZZZZZ.
+}
+
+// moment token map
+var momentMap = map[string]string{
+	"YYYY":      "2006",      // Year:   A full numeric representation of a year, 4 digits. Eg: 2020.
+	"YY":        "06",        // Year:   A two digit representation of a year. Eg: 20.
+	"M":         "1",         // Month:  Numeric representation of a month, without leading zeros. Eg: 1 through 12.
+	"MM":        "01",        // Month:  Numeric representation of a month, with leading zeros. Eg: 01 through 12.
+	"MMM":       "Jan",       // Month:  A short textual representation of a month. Eg: Jan through Dec.
+	"MMMM":      "January",   // Month:  A full textual representation of a month. Eg: January through December.
+	"D":         "2",         // Day:    Day of the month without leading zeros. Eg: 1 to 31.
+	"DD":        "02",        // Day:    Day of the month, 2 digits with leading zeros. Eg: 01 to 31.
+	"DDDD":      "002",       // Day:    Day of the year with leading zeros. Eg: 001 to 366.
+	"ddd":       "Mon",       // Week:   A short textual representation of the day of the week. Eg: Mon through Sun.
+	"dddd":      "Monday",    // Week:   A full textual representation of the day of the week. Eg: Monday through Sunday.
+	"A":         "PM",        // Time:   Uppercase morning or afternoon sign. Eg: AM or PM.
+	"a":         "pm",        // Time:   Lowercase morning or afternoon sign. Eg: am or pm.
+	"HH":        "15",        // Time:   24-hour format of an hour with leading zeros. Eg: 00 through 23.
+	"h":         "3",         // Time:   12-hour format of an hour without leading zeros. Eg: 1 through 12.
+	"hh":        "03",        // Time:   12-hour format of an hour with leading zeros. Eg: 01 through 12.
+	"m":         "4",         // Time:   Minutes without leading zeros. Eg: 0 to 59.
+	"mm":        "04",        // Time:   Minutes with leading zeros. Eg: 00 to 59.
+	"s":         "5",         // Time:   Seconds without leading zeros. Eg: 0 through 59.
+	"ss":        "05",        // Time:   Seconds with leading zeros. Eg: 00 through 59.
+	"S":         "0",         // Second: Tenth of a second, it must follow a dot or comma. Eg: 9.
+	"SS":        "00",        // Second: Hundredth of a second, it must follow a dot or comma. Eg: 99.
+	"SSS":       "000",       // Second: Millisecond, it must follow a dot or comma. Eg: 999.
+	"SSSSSS":    "000000",    // Second: Microsecond, it must follow a dot or comma. Eg: 999999.
+	"SSSSSSSSS": "000000000", // Second: Nanosecond, it must follow a dot or comma. Eg: 999999999.
+	"Z":         "-07:00",    // Zone:   Difference to Greenwich time (GMT) with colon between hours and minutes. Eg: +08:00.
+	"ZZ":        "-0700",     // Zone:   Difference to Greenwich time (GMT) in hours. Eg: +0800.
+	"z":         "MST",       // Zone:   Zone name. Eg: UTC, EST, MDT ...
+	"zz":        "MST",       // Zone:   Same as z.
+}
+
+// letters of the moment tokens, the other letters are output as they are
+const momentLetters = "YyMQDdEeWwGgAaHhkmsSXxZzN"
+
+// max patterns and max pattern length of the cached layouts of the built-in dialects
+const (
+	maxCachedDialectLayouts = 1024
+	maxCachedPatternLength  = 50
+)
+
+// dialectLayoutCache caches pattern to layout conversions of the built-in dialects,
+// dialectLayoutCount counts them
+var (
+	dialectLayoutCache sync.Map
+	dialectLayoutCount int32
+)
+
+type dialectLayoutKey struct {
+	dialect string
+	pattern string
+}
+
+type strftimeDialect struct{}
+
+// Layout translates a strftime pattern into a Go layout.
+func (d strftimeDialect) Layout(pattern string) (string, error) {
+	return translatePattern("strftime", pattern, func(b *layoutBuilder) error {
+		for i := 0; i < len(pattern); i++ {
+			if pattern[i] != '%' {
+				b.writeLiteral(pattern[i : i+1])
+				continue
+			}
+			j := i + 1
+			if j < len(pattern) && (pattern[j] == '-' || pattern[j] == ':') {
+				j++
+			}
+			if j >= len(pattern) {
+				return ErrInvalidPattern(pattern, pattern[i:])
+			}
+			directive := pattern[i+1 : j+1]
+			layout, ok := strftimeMap[directive]
+			if !ok {
+				return ErrInvalidPattern(pattern, "%"+directive)
+			}
+			if err := b.writeToken(pattern, "%"+directive, layout); err != nil {
+				return err
+			}
+			i = j
+		}
+		return nil
+	})
+}
+
+type icuDialect struct{}
+
+// Layout translates an ICU or Java DateTimeFormatter pattern into a Go layout,
+// the text in single quotes is output as it is and two single quotes represent a single quote.
+func (d icuDialect) Layout(pattern string) (string, error) {
+	return translatePattern("icu", pattern, func(b *layoutBuilder) error {
+		for i := 0; i < len(pattern); i++ {
+			char := pattern[i]
+			switch {
+			case char == '\'':
+				// two single quotes represent a single quote
+				if i+1 < len(pattern) && pattern[i+1] == '\'' {
+					b.writeLiteral("'")
+					i++
+					continue
+				}
+				// the quoted text ends with a single quote which isn't followed by another one
+				j := i + 1
+				for ; j < len(pattern); j++ {
+					if pattern[j] != '\'' {
+						continue
+					}
+					if j+1 < len(pattern) && pattern[j+1] == '\'' {
+						j++
+						continue
+					}
+					break
+				}
+				if j >= len(pattern) {
+					return ErrInvalidPattern(pattern, pattern[i:])
+				}
+				b.writeLiteral(strings.ReplaceAll(pattern[i+1:j], "''", "'"))
+				i = j
+			case isASCIILetter(char):
+				token := getRepeatedLetters(pattern[i:])
+				layout, ok := icuMap[token]
+				if !ok {
+					return ErrInvalidPattern(pattern, token)
+				}
+				if err := b.writeToken(pattern, token, layout); err != nil {
+					return err
+				}
+				i += len(token) - 1
+			default:
+				b.writeLiteral(pattern[i : i+1])
+			}
+		}
+		return nil
+	})
+}
+
+type momentDialect struct{}
+
+// Layout translates a moment.js pattern into a Go layout,
+// the text in square brackets is output as it is.
+func (d momentDialect) Layout(pattern string) (string, error) {
+	return translatePattern("moment", pattern, func(b *layoutBuilder) error {
+		for i := 0; i < len(pattern); i++ {
+			char := pattern[i]
+			switch {
+			case char == '[':
+				end := strings.IndexByte(pattern[i+1:], ']')
+				if end < 0 {
+					return ErrInvalidPattern(pattern, pattern[i:])
+				}
+				b.writeLiteral(pattern[i+1 : i+1+end])
+				i += end + 1
+			case strings.IndexByte(momentLetters, char) >= 0:
+				token := getRepeatedLetters(pattern[i:])
+				// ordinal tokens like "Do" aren't supported
+				if i+len(token) < len(pattern) && pattern[i+len(token)] == 'o' {
+					return ErrInvalidPattern(pattern, token+"o")
+				}
+				layout, ok := momentMap[token]
+				if !ok {
+					return ErrInvalidPattern(pattern, token)
+				}
+				if err := b.writeToken(pattern, token, layout); err != nil {
+					return err
+				}
+				i += len(token) - 1
+			default:
+				b.writeLiteral(pattern[i : i+1])
+			}
+		}
+		return nil
+	})
+}
+
+// instants which differ in every element of Go layouts, to check the layout renders as the pattern expects
+var (
+	checkedTime1 = time.Date(2001, 2, 3, 4, 5, 6, 987654321, time.UTC)
+	checkedTime2 = time.Date(2012, 11, 14, 15, 16, 17, 123456789, time.FixedZone("XXX", 3600))
+)
+
+// layoutBuilder builds a Go layout from the tokens and literals of a pattern.
+type layoutBuilder struct {
+	layout   strings.Builder
+	literal  strings.Builder
+	expected [2]strings.Builder
+}
+
+// writes the literal text, which is checked when it's flushed.
+func (b *layoutBuilder) writeLiteral(literal string) {
+	b.literal.WriteString(literal)
+}
+
+// writes the layout of the token, the fraction of second must follow a dot or comma.
+func (b *layoutBuilder) writeToken(pattern, token, layout string) error {
+	if err := b.flush(pattern); err != nil {
+		return err
+	}
+	isFraction := layout[0] == '0' && strings.Trim(layout, "0") == ""
+	if isFraction {
+		s := b.layout.String()
+		if s == "" || (s[len(s)-1] != '.' && s[len(s)-1] != ',') {
+			return ErrInvalidPattern(pattern, token)
+		}
+	}
+	b.layout.WriteString(layout)
+	for i, t := range []StdTime{checkedTime1, checkedTime2} {
+		if isFraction {
+			b.expected[i].WriteString(t.Format("." + layout)[1:])
+		} else {
+			b.expected[i].WriteString(t.Format(layout))
+		}
+	}
+	return nil
+}
+
+// flushes the literal text into the layout, the text can't contain the elements of Go layouts,
+// since Go layouts have no way to escape them.
+func (b *layoutBuilder) flush(pattern string) error {
+	literal := b.literal.String()
+	if literal == "" {
+		return nil
+	}
+	b.literal.Reset()
+	if checkedTime1.Format(literal) != literal || checkedTime2.Format(literal) != literal {
+		return ErrInvalidPattern(pattern, literal)
+	}
+	b.layout.WriteString(literal)
+	b.expected[0].WriteString(literal)
+	b.expected[1].WriteString(literal)
+	return nil
+}
+
+// gets the layout and checks the adjacent tokens and literals don't make up other elements of Go layouts, like "Mon" and "day".
+func (b *layoutBuilder) build(pattern string) (string, error) {
+	if err := b.flush(pattern); err != nil {
+		return "", err
+	}
+	layout := b.layout.String()
+	if checkedTime1.Format(layout) != b.expected[0].String() || checkedTime2.Format(layout) != b.expected[1].String() {
+		return "", ErrInvalidPattern(pattern, pattern)
+	}
+	return layout, nil
+}
+
+// translates the pattern by the function and caches the layout.
+func translatePattern(dialect, pattern string, translate func(b *layoutBuilder) error) (string, error) {
+	if pattern == "" {
+		return "", ErrEmptyPattern()
+	}
+	key := dialectLayoutKey{dialect: dialect, pattern: pattern}
+	if cached, exists := dialectLayoutCache.Load(key); exists {
+		return cached.(string), nil
+	}
+	b := &layoutBuilder{}
+	if err := translate(b); err != nil {
+		return "", err
+	}
+	layout, err := b.build(pattern)
+	if err != nil {
+		return "", err
+	}
+	// patterns may come from users, so the cache is bounded
+	if len(pattern) <= maxCachedPatternLength && atomic.LoadInt32(&dialectLayoutCount) < maxCachedDialectLayouts {
+		if _, loaded := dialectLayoutCache.LoadOrStore(key, layout); !loaded {
+			atomic.AddInt32(&dialectLayoutCount, 1)
+		}
+	}
+	return layout, nil
+}
+
+// gets the leading letters which are the same as the first one.
+func getRepeatedLetters(s string) string {
+	i := 1
+	for i < len(s) && s[i] == s[0] {
+		i++
+	}
+	return s[:i]
+}
+
+// reports whether the char is an ascii letter.
+func isASCIILetter(char byte) bool {
+	return (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z')
+}
@@ -0,0 +1,93 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+)
+
+func BenchmarkStrftimeDialect_Layout(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			StrftimeDialect.Layout("%Y-%m-%d %H:%M:%S")
+		}
+	})
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				StrftimeDialect.Layout("%Y-%m-%d %H:%M:%S")
+			}()
+		}
+		wg.Wait()
+	})
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				StrftimeDialect.Layout("%Y-%m-%d %H:%M:%S")
+			}
+		})
+	})
+}
+
+func BenchmarkICUDialect_Layout(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			ICUDialect.Layout("yyyy-MM-dd'T'HH:mm:ss.SSSXXX")
+		}
+	})
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ICUDialect.Layout("yyyy-MM-dd'T'HH:mm:ss.SSSXXX")
+			}()
+		}
+		wg.Wait()
+	})
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				ICUDialect.Layout("yyyy-MM-dd'T'HH:mm:ss.SSSXXX")
+			}
+		})
+	})
+}
+
+func BenchmarkMomentDialect_Layout(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			MomentDialect.Layout("YYYY-MM-DD HH:mm:ss")
+		}
+	})
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				MomentDialect.Layout("YYYY-MM-DD HH:mm:ss")
+			}()
+		}
+		wg.Wait()
+	})
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				MomentDialect.Layout("YYYY-MM-DD HH:mm:ss")
+			}
+		})
+	})
+}
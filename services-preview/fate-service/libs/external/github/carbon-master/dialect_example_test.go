@@ -0,0 +1,24 @@
+package carbon_test
+
+import (
+	"fmt"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func ExampleDialect() {
+	layout, _ := carbon.StrftimeDialect.Layout("%Y-%m-%d %H:%M:%S")
+	fmt.Println(layout)
+	layout, _ = carbon.ICUDialect.Layout("yyyy-MM-dd'T'HH:mm:ss.SSSXXX")
+	fmt.Println(layout)
+	layout, _ = carbon.MomentDialect.Layout("[Today is] dddd")
+	fmt.Println(layout)
+	_, err := carbon.MomentDialect.Layout("MMMM Do YYYY")
+	fmt.Println(err)
+
+	// Output:
+	// 2006-01-02 15:04:05
+	// 2006-01-02T15:04:05.000Z07:00
+	// Today is Monday
+	// invalid pattern "MMMM Do YYYY", "Do" isn't supported
+}
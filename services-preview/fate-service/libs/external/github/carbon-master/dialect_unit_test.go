@@ -0,0 +1,240 @@
+package carbon
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DialectSuite struct {
+	suite.Suite
+}
+
+func TestDialectSuite(t *testing.T) {
+	suite.Run(t, new(DialectSuite))
+}
+
+// dialect translating Go layouts as they are
+type goDialect struct{}
+
+func (d goDialect) Layout(pattern string) (string, error) {
+	if pattern == "" {
+		return "", ErrEmptyPattern()
+	}
+	return pattern, nil
+}
+
+func (s *DialectSuite) TestStrftimeDialect_Layout() {
+	s.Run("empty pattern", func() {
+		_, err := StrftimeDialect.Layout("")
+		s.Equal(ErrEmptyPattern(), err)
+	})
+
+	s.Run("invalid pattern", func() {
+		_, err := StrftimeDialect.Layout("%Y-%Q")
+		s.Equal(ErrInvalidPattern("%Y-%Q", "%Q"), err)
+		_, err = StrftimeDialect.Layout("%Y-%")
+		s.Equal(ErrInvalidPattern("%Y-%", "%"), err)
+		_, err = StrftimeDialect.Layout("%Y-%-")
+		s.Equal(ErrInvalidPattern("%Y-%-", "%-"), err)
+		_, err = StrftimeDialect.Layout("%-H")
+		s.Equal(ErrInvalidPattern("%-H", "%-H"), err)
+		// the fraction of second must follow a dot or comma
+		_, err = StrftimeDialect.Layout("%S%f")
+		s.Equal(ErrInvalidPattern("%S%f", "%f"), err)
+		// the literal text can't contain the elements of Go layouts
+		_, err = StrftimeDialect.Layout("%Y Jan")
+		s.Equal(ErrInvalidPattern("%Y Jan", " Jan"), err)
+		_, err = StrftimeDialect.Layout("%aday")
+		s.Equal(ErrInvalidPattern("%aday", "%aday"), err)
+	})
+
+	s.Run("valid pattern", func() {
+		for pattern, layout := range map[string]string{
+			"%Y-%m-%d %H:%M:%S":         "2006-01-02 15:04:05",
+			"%F %T":                     "2006-01-02 15:04:05",
+			"%D %R":                     "01/02/06 15:04",
+			"%r":                        "03:04:05 PM",
+			"%y/%-m/%-d %-I:%-M:%-S %P": "06/1/2 3:4:5 pm",
+			"%a %A %b %h %B":            "Mon Monday Jan Jan January",
+			"%e|%j":                     "_2|002",
+			"%S.%L":                     "05.000",
+			"%S,%f":                     "05,000000",
+			"%S.%N":                     "05.000000000",
+			"%z %:z %Z":                 "-0700 -07:00 MST",
+			"%%Y%n%t":                   "%Y\n\t",
+			"%Y年%m月%d日":                 "2006年01月02日",
+		} {
+			actual, err := StrftimeDialect.Layout(pattern)
+			s.Nil(err, pattern)
+			s.Equal(layout, actual, pattern)
+		}
+	})
+}
+
+func (s *DialectSuite) TestICUDialect_Layout() {
+	s.Run("empty pattern", func() {
+		_, err := ICUDialect.Layout("")
+		s.Equal(ErrEmptyPattern(), err)
+	})
+
+	s.Run("invalid pattern", func() {
+		_, err := ICUDialect.Layout("yyyy-MM-ddTHH")
+		s.Equal(ErrInvalidPattern("yyyy-MM-ddTHH", "T"), err)
+		_, err = ICUDialect.Layout("yyyyy")
+		s.Equal(ErrInvalidPattern("yyyyy", "yyyyy"), err)
+		_, err = ICUDialect.Layout("H:mm")
+		s.Equal(ErrInvalidPattern("H:mm", "H"), err)
+		_, err = ICUDialect.Layout("yyyy'abc")
+		s.Equal(ErrInvalidPattern("yyyy'abc", "'abc"), err)
+		_, err = ICUDialect.Layout("ssSSS")
+		s.Equal(ErrInvalidPattern("ssSSS", "SSS"), err)
+		_, err = ICUDialect.Layout("yyyy '1'")
+		s.Equal(ErrInvalidPattern("yyyy '1'", " 1"), err)
+		_, err = ICUDialect.Layout("EEE'day'")
+		s.Equal(ErrInvalidPattern("EEE'day'", "EEE'day'"), err)
+	})
+
+	s.Run("valid pattern", func() {
+		for pattern, layout := range map[string]string{
+			"yyyy-MM-dd HH:mm:ss":               "2006-01-02 15:04:05",
+			"yyyy-MM-dd'T'HH:mm:ss.SSSXXX":      "2006-01-02T15:04:05.000Z07:00",
+			"uuuu-M-d h:m:s a":                  "2006-1-2 3:4:5 PM",
+			"yy/LL/dd hh:mm":                    "06/01/02 03:04",
+			"E EE EEE EEEE MMM MMMM LLL LLLL":   "Mon Mon Mon Monday Jan January Jan January",
+			"DDD":                               "002",
+			"ss.S ss.SS ss.SSSSSS ss,SSSSSSSSS": "05.0 05.00 05.000000 05,000000000",
+			"z Z ZZZZZ X XX x xxx":              "MST -0700 -07:00 Z07 Z0700 -07 -07:00",
+			"h 'o''clock' a":                    "3 o'clock PM",
+			"''yy":                              "'06",
+			"yyyy'年'M'月'd'日'":                   "2006年1月2日",
+		} {
+			actual, err := ICUDialect.Layout(pattern)
+			s.Nil(err, pattern)
+			s.Equal(layout, actual, pattern)
+		}
+		s.Equal(ICUDialect, JavaDialect)
+	})
+}
+
+func (s *DialectSuite) TestMomentDialect_Layout() {
+	s.Run("empty pattern", func() {
+		_, err := MomentDialect.Layout("")
+		s.Equal(ErrEmptyPattern(), err)
+	})
+
+	s.Run("invalid pattern", func() {
+		_, err := MomentDialect.Layout("MMMM Do YYYY")
+		s.Equal(ErrInvalidPattern("MMMM Do YYYY", "Do"), err)
+		_, err = MomentDialect.Layout("H:mm")
+		s.Equal(ErrInvalidPattern("H:mm", "H"), err)
+		_, err = MomentDialect.Layout("X")
+		s.Equal(ErrInvalidPattern("X", "X"), err)
+		_, err = MomentDialect.Layout("[Today is dddd")
+		s.Equal(ErrInvalidPattern("[Today is dddd", "[Today is dddd"), err)
+		_, err = MomentDialect.Layout("[Mon] dddd")
+		s.Equal(ErrInvalidPattern("[Mon] dddd", "Mon "), err)
+		_, err = MomentDialect.Layout("YYYY_D")
+		s.Equal(ErrInvalidPattern("YYYY_D", "YYYY_D"), err)
+	})
+
+	s.Run("valid pattern", func() {
+		for pattern, layout := range map[string]string{
+			"YYYY-MM-DD HH:mm:ss":               "2006-01-02 15:04:05",
+			"YYYY-MM-DDTHH:mm:ss.SSSZ":          "2006-01-02T15:04:05.000-07:00",
+			"YY/M/D h:m:s a":                    "06/1/2 3:4:5 pm",
+			"ddd dddd MMM MMMM":                 "Mon Monday Jan January",
+			"DDDD":                              "002",
+			"hh:mm A ZZ z":                      "03:04 PM -0700 MST",
+			"ss.S ss.SS ss.SSSSSS ss,SSSSSSSSS": "05.0 05.00 05.000000 05,000000000",
+			"[Today is] dddd":                   "Today is Monday",
+			"YYYY年MM月DD日":                       "2006年01月02日",
+		} {
+			actual, err := MomentDialect.Layout(pattern)
+			s.Nil(err, pattern)
+			s.Equal(layout, actual, pattern)
+		}
+	})
+}
+
+func (s *DialectSuite) TestDialect_RoundTrip() {
+	// equivalent patterns of the dialects and the formats, an empty pattern means the dialect has no equivalent
+	patterns := []struct {
+		format, strftime, icu, moment string
+	}{
+		{DateTimeFormat, "%Y-%m-%d %H:%M:%S", "yyyy-MM-dd HH:mm:ss", "YYYY-MM-DD HH:mm:ss"},
+		{ShortDateTimeFormat, "%Y%m%d%H%M%S", "yyyyMMddHHmmss", "YYYYMMDDHHmmss"},
+		{DateFormat, "%F", "yyyy-MM-dd", "YYYY-MM-DD"},
+		{TimeFormat, "%T", "HH:mm:ss", "HH:mm:ss"},
+		{"D, d M Y", "%a, %d %b %Y", "EEE, dd MMM yyyy", "ddd, DD MMM YYYY"},
+		{"l, F j, Y g:i A", "%A, %B %-d, %Y %-I:%M %p", "EEEE, MMMM d, yyyy h:mm a", "dddd, MMMM D, YYYY h:mm A"},
+		{"y/n/j h:i:s a", "%y/%-m/%-d %I:%M:%S %P", "", "YY/M/D hh:mm:ss a"},
+		{"Y-m-d H:i:s O", "%Y-%m-%d %H:%M:%S %z", "yyyy-MM-dd HH:mm:ss Z", "YYYY-MM-DD HH:mm:ss ZZ"},
+		{"Y-m-d\\TH:i:sP", "%Y-%m-%dT%H:%M:%S%:z", "yyyy-MM-dd'T'HH:mm:ssxxx", "YYYY-MM-DDTHH:mm:ssZ"},
+		{"Y-m-d\\TH:i:sR", "", "yyyy-MM-dd'T'HH:mm:ssXXX", ""},
+		{"d/m/Y H:i Z", "%d/%m/%Y %H:%M %Z", "dd/MM/yyyy HH:mm z", "DD/MM/YYYY HH:mm z"},
+		{"Y年m月d日 H时i分s秒", "%Y年%m月%d日 %H时%M分%S秒", "yyyy'年'MM'月'dd'日' HH'时'mm'分'ss'秒'", "YYYY年MM月DD日 HH时mm分ss秒"},
+	}
+	carbons := []*Carbon{
+		Parse("2020-08-05 13:14:15.123", UTC),
+		Parse("2021-01-03 01:02:03.456", PRC),
+		Parse("1999-12-31 23:59:59.999", NewYork),
+	}
+
+	for _, p := range patterns {
+		for _, dp := range []struct {
+			dialect Dialect
+			pattern string
+		}{{StrftimeDialect, p.strftime}, {ICUDialect, p.icu}, {MomentDialect, p.moment}} {
+			if dp.pattern == "" {
+				continue
+			}
+			for _, c := range carbons {
+				value := c.Format(p.format)
+				s.Equal(value, c.FormatWith(dp.dialect, dp.pattern), dp.pattern)
+
+				expected := ParseByFormat(value, p.format, c.Timezone())
+				actual := ParseWith(dp.dialect, value, dp.pattern, c.Timezone())
+				s.Nil(actual.Error, dp.pattern)
+				s.Equal(expected.ToString(), actual.ToString(), dp.pattern)
+				s.Equal(value, actual.FormatWith(dp.dialect, dp.pattern), dp.pattern)
+			}
+		}
+	}
+}
+
+func (s *DialectSuite) TestDialect_Custom() {
+	c := Parse("2020-08-05 13:14:15", PRC)
+	s.Equal("2020-08-05 13:14:15 +0800", c.FormatWith(goDialect{}, "2006-01-02 15:04:05 -0700"))
+	s.Empty(c.FormatWith(goDialect{}, ""))
+	s.Equal("2020-08-05 13:14:15 +0800 CST", ParseWith(goDialect{}, "2020-08-05 13:14:15", DateTimeLayout, PRC).ToString())
+	s.Equal(ErrEmptyPattern(), ParseWith(goDialect{}, "2020-08-05 13:14:15", "").Error)
+}
+
+func (s *DialectSuite) TestDialect_Cache() {
+	long := "%Y" + strings.Repeat("-", maxCachedPatternLength)
+	layout, err := StrftimeDialect.Layout(long)
+	s.Nil(err)
+	s.Equal("2006"+strings.Repeat("-", maxCachedPatternLength), layout)
+	_, exists := dialectLayoutCache.Load(dialectLayoutKey{dialect: "strftime", pattern: long})
+	s.False(exists)
+
+	// distinct patterns like "%Y-/-" spelling the numbers in binary
+	for i := 0; i < maxCachedDialectLayouts+100; i++ {
+		var b strings.Builder
+		b.WriteString("%Y")
+		for bit := 10; bit >= 0; bit-- {
+			if i>>bit&1 == 1 {
+				b.WriteByte('/')
+			} else {
+				b.WriteByte('-')
+			}
+		}
+		layout, err = StrftimeDialect.Layout(b.String())
+		s.Nil(err)
+		s.Equal("2006"+b.String()[2:], layout)
+	}
+	s.LessOrEqual(atomic.LoadInt32(&dialectLayoutCount), int32(maxCachedDialectLayouts))
+}
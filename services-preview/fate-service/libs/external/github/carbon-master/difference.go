@@ -14,7 +14,7 @@ func (c *Carbon) DiffInYears(carbon ...*Carbon) int64 {
 	if len(carbon) > 0 {
 		end = carbon[0]
 	} else {
-		end = Now().SetLocation(c.loc)
+		end = c.now()
 	}
 	if end.IsInvalid() {
 		return 0
@@ -47,7 +47,7 @@ func (c *Carbon) DiffInMonths(carbon ...*Carbon) int64 {
 	if len(carbon) > 0 {
 		end = carbon[0]
 	} else {
-		end = Now().SetLocation(c.loc)
+		end = c.now()
 	}
 	if end.IsInvalid() {
 		return 0
@@ -79,7 +79,7 @@ func (c *Carbon) DiffInWeeks(carbon ...*Carbon) int64 {
 	if len(carbon) > 0 {
 		end = carbon[0]
 	} else {
-		end = Now().SetLocation(c.loc)
+		end = c.now()
 	}
 	if end.IsInvalid() {
 		return 0
@@ -101,7 +101,7 @@ func (c *Carbon) DiffInDays(carbon ...*Carbon) int64 {
 	if len(carbon) > 0 {
 		end = carbon[0]
 	} else {
-		end = Now().SetLocation(c.loc)
+		end = c.now()
 	}
 	if end.IsInvalid() {
 		return 0
@@ -123,7 +123,7 @@ func (c *Carbon) DiffInHours(carbon ...*Carbon) int64 {
 	if len(carbon) > 0 {
 		end = carbon[0]
 	} else {
-		end = Now().SetLocation(c.loc)
+		end = c.now()
 	}
 	if end.IsInvalid() {
 		return 0
@@ -145,7 +145,7 @@ func (c *Carbon) DiffInMinutes(carbon ...*Carbon) int64 {
 	if len(carbon) > 0 {
 		end = carbon[0]
 	} else {
-		end = Now().SetLocation(c.loc)
+		end = c.now()
 	}
 	if end.IsInvalid() {
 		return 0
@@ -167,7 +167,7 @@ func (c *Carbon) DiffInSeconds(carbon ...*Carbon) int64 {
 	if len(carbon) > 0 {
 		end = carbon[0]
 	} else {
-		end = Now().SetLocation(c.loc)
+		end = c.now()
 	}
 	if end.IsInvalid() {
 		return 0
@@ -189,7 +189,7 @@ func (c *Carbon) DiffInString(carbon ...*Carbon) string {
 	if len(carbon) > 0 {
 		end = carbon[0]
 	} else {
-		end = Now().SetLocation(c.loc)
+		end = c.now()
 	}
 	if end.IsInvalid() {
 		return ""
@@ -207,7 +207,7 @@ func (c *Carbon) DiffAbsInString(carbon ...*Carbon) string {
 	if len(carbon) > 0 {
 		end = carbon[0]
 	} else {
-		end = Now().SetLocation(c.loc)
+		end = c.now()
 	}
 	if end.IsInvalid() {
 		return ""
@@ -225,7 +225,7 @@ func (c *Carbon) DiffInDuration(carbon ...*Carbon) Duration {
 	if len(carbon) > 0 {
 		end = carbon[0]
 	} else {
-		end = Now().SetLocation(c.loc)
+		end = c.now()
 	}
 	if end.IsInvalid() {
 		return 0
@@ -255,7 +255,7 @@ func (c *Carbon) DiffForHumans(carbon ...*Carbon) string {
 		if len(carbon) > 0 {
 			return carbon[0]
 		}
-		return Now().SetLocation(c.loc)
+		return c.now()
 	}()
 	if end.IsInvalid() {
 		return ""
@@ -266,24 +266,77 @@ func (c *Carbon) DiffForHumans(carbon ...*Carbon) string {
 	if unit == "now" {
 		return translation
 	}
+	return c.lang.direct(translation, value > 0, len(carbon) > 0)
+}
+
+// DiffInStringWith gets the difference in string by the options like "1 year 3 months" or "1y 3mo", i18n is supported.
+func (c *Carbon) DiffInStringWith(opts DiffOptions, carbon ...*Carbon) string {
+	lang, end := c.humanizer(opts, carbon...)
+	if lang == nil {
+		return ""
+	}
+	values, negative := c.diffValues(end)
+	if str := lang.humanize(values, negative, opts); str != "" {
+		return str
+	}
+	return lang.translate("now", 0)
+}
+
+// DiffForHumansWith gets the difference in a human-readable format by the options like "about 1 year 3 months ago",
+// i18n is supported.
+func (c *Carbon) DiffForHumansWith(opts DiffOptions, carbon ...*Carbon) string {
+	lang, end := c.humanizer(opts, carbon...)
+	if lang == nil {
+		return ""
+	}
+	values, negative := c.diffValues(end)
+	str := lang.humanize(values, false, opts)
+	if str == "" {
+		return lang.translate("now", 0)
+	}
+	return lang.direct(str, !negative, len(carbon) > 0)
+}
+
+// gets the language and end of the human-readable difference, the language is nil if either instance is invalid.
+func (c *Carbon) humanizer(opts DiffOptions, carbon ...*Carbon) (*Language, *Carbon) {
+	if c.IsInvalid() || c.lang == nil {
+		return nil, nil
+	}
+	end := c.now()
+	if len(carbon) > 0 {
+		end = carbon[0]
+	}
+	if end.IsInvalid() {
+		return nil, nil
+	}
+	lang := c.lang
+	if opts.Locale != "" {
+		if lang = NewLanguage().SetLocale(opts.Locale); lang.Error != nil {
+			return nil, nil
+		}
+	}
+	return lang, end
+}
 
+// puts a translated difference in the "ago", "before", "from now" or "after" words of the language,
+// isBefore reports whether the instance is before the end, hasEnd reports whether the end is given.
+func (lang *Language) direct(translation string, isBefore, hasEnd bool) string {
 	// Concurrent-safe access to language resources
-	c.lang.rw.RLock()
-	resources := c.lang.resources
+	lang.rw.RLock()
+	resources := lang.resources
 	ago := resources["ago"]
 	before := resources["before"]
 	fromNow := resources["from_now"]
 	after := resources["after"]
-	c.lang.rw.RUnlock()
+	lang.rw.RUnlock()
 
-	isBefore := value > 0
-	if isBefore && len(carbon) == 0 {
+	if isBefore && !hasEnd {
 		return strings.Replace(ago, "%s", translation, 1)
 	}
-	if isBefore && len(carbon) > 0 {
+	if isBefore && hasEnd {
 		return strings.Replace(before, "%s", translation, 1)
 	}
-	if !isBefore && len(carbon) == 0 {
+	if !isBefore && !hasEnd {
 		return strings.Replace(fromNow, "%s", translation, 1)
 	}
 	return strings.Replace(after, "%s", translation, 1)
@@ -704,3 +704,77 @@ func BenchmarkCarbon_DiffForHumans(b *testing.B) {
 		})
 	})
 }
+
+func BenchmarkCarbon_DiffInStringWith(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c1 := Parse("2019-05-03 10:10:10")
+		c2 := Parse("2020-08-05 13:14:15")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c1.DiffInStringWith(DiffOptions{Parts: 3}, c2)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c1 := Parse("2019-05-03 10:10:10")
+		c2 := Parse("2020-08-05 13:14:15")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c1.DiffInStringWith(DiffOptions{Parts: 3}, c2)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c1 := Parse("2019-05-03 10:10:10")
+		c2 := Parse("2020-08-05 13:14:15")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c1.DiffInStringWith(DiffOptions{Parts: 3}, c2)
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_DiffForHumansWith(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c1 := Parse("2019-05-03 10:10:10")
+		c2 := Parse("2020-08-05 13:14:15")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c1.DiffForHumansWith(DiffOptions{Parts: 3}, c2)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c1 := Parse("2019-05-03 10:10:10")
+		c2 := Parse("2020-08-05 13:14:15")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c1.DiffForHumansWith(DiffOptions{Parts: 3}, c2)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c1 := Parse("2019-05-03 10:10:10")
+		c2 := Parse("2020-08-05 13:14:15")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c1.DiffForHumansWith(DiffOptions{Parts: 3}, c2)
+			}
+		})
+	})
+}
@@ -334,3 +334,40 @@ func ExampleCarbon_DiffForHumans() {
 	// 1 day after
 	// 1 day before
 }
+
+func ExampleCarbon_DiffInStringWith() {
+	now := carbon.Parse("2020-08-05 13:14:15")
+	c := carbon.Parse("2019-05-03 10:10:10")
+
+	fmt.Println(c.DiffInStringWith(carbon.DiffOptions{Parts: 3}, now))
+	fmt.Println(c.DiffInStringWith(carbon.DiffOptions{Parts: 3, Style: carbon.DiffStyleShort}, now))
+	fmt.Println(c.DiffInStringWith(carbon.DiffOptions{Parts: 3, Join: true}, now))
+	fmt.Println(now.DiffInStringWith(carbon.DiffOptions{Parts: 2}, c))
+	fmt.Println(c.DiffInStringWith(carbon.DiffOptions{Parts: 2, Locale: "zh-CN"}, now))
+
+	// Output:
+	// 1 year 3 months 2 days
+	// 1y 3mo 2d
+	// 1 year, 3 months and 2 days
+	// -1 year 3 months
+	// 1 年 3 个月
+}
+
+func ExampleCarbon_DiffForHumansWith() {
+	defer carbon.ClearTestNow()
+	now := carbon.Parse("2020-08-05 13:14:15")
+	carbon.SetTestNow(now)
+
+	fmt.Println(carbon.Parse("2020-08-07 16:14:15").DiffForHumansWith(carbon.DiffOptions{Parts: 2}))
+	fmt.Println(carbon.Parse("2020-08-07 16:14:15").DiffForHumansWith(carbon.DiffOptions{Parts: 2, Style: carbon.DiffStyleShort}))
+	fmt.Println(carbon.Parse("2019-05-03 10:10:10").DiffForHumansWith(carbon.DiffOptions{Parts: 2, Join: true}, now))
+	fmt.Println(carbon.Parse("2019-05-03 10:10:10").DiffForHumansWith(carbon.DiffOptions{Approximate: true}))
+	fmt.Println(carbon.Parse("2020-08-05 11:15:00").DiffForHumansWith(carbon.DiffOptions{Approximate: true}))
+
+	// Output:
+	// 2 days 3 hours from now
+	// 2d 3h from now
+	// 1 year and 3 months before
+	// about 1 year ago
+	// almost 2 hours ago
+}
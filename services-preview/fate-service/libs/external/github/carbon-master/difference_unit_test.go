@@ -903,6 +903,132 @@ func (s *DifferenceSuite) TestCarbon_DiffForHumans() {
 	})
 }
 
+func (s *DifferenceSuite) TestCarbon_DiffInStringWith() {
+	opts := DiffOptions{Parts: 3}
+
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Empty(c.DiffInStringWith(opts))
+		s.Empty(Now().DiffInStringWith(opts, c))
+		s.Empty(c.DiffInStringWith(opts, c))
+	})
+
+	s.Run("zero carbon", func() {
+		c := NewCarbon()
+		s.Equal("2019 years 7 months 4 days", c.DiffInStringWith(opts))
+		s.Equal("-2019 years 7 months 4 days", Now().DiffInStringWith(opts, c))
+		s.Equal("just now", c.DiffInStringWith(opts, c))
+	})
+
+	s.Run("empty carbon", func() {
+		c := Parse("")
+		s.Empty(c.DiffInStringWith(opts))
+		s.Empty(Now().DiffInStringWith(opts, c))
+		s.Empty(c.DiffInStringWith(opts, c))
+	})
+
+	s.Run("error carbon", func() {
+		c := Parse("xxx")
+		s.Empty(c.DiffInStringWith(opts))
+		s.Empty(Now().DiffInStringWith(opts, c))
+		s.Empty(c.DiffInStringWith(opts, c))
+	})
+
+	s.Run("error locale", func() {
+		s.Empty(Now().DiffInStringWith(DiffOptions{Locale: "xxx"}))
+	})
+
+	s.Run("valid carbon", func() {
+		c := Parse("2019-05-03 10:10:10")
+		s.Equal("1 year", c.DiffInStringWith(DiffOptions{}))
+		s.Equal(c.DiffInString(), c.DiffInStringWith(DiffOptions{}))
+		s.Equal("1 year 3 months", c.DiffInStringWith(DiffOptions{Parts: 2}))
+		s.Equal("1 year 3 months 2 days", c.DiffInStringWith(opts))
+		s.Equal("1 year 3 months 2 days 3 hours 4 minutes 5 seconds", c.DiffInStringWith(DiffOptions{Parts: 10}))
+		s.Equal("1y 3mo 2d", c.DiffInStringWith(DiffOptions{Parts: 3, Style: DiffStyleShort}))
+		s.Equal("1 year, 3 months and 2 days", c.DiffInStringWith(DiffOptions{Parts: 3, Join: true}))
+		s.Equal("1 year and 3 months", c.DiffInStringWith(DiffOptions{Parts: 2, Join: true}))
+		s.Equal("-1 year 3 months", Now().DiffInStringWith(DiffOptions{Parts: 2}, c))
+		s.Equal("-1y 3mo", Now().DiffInStringWith(DiffOptions{Parts: 2, Style: DiffStyleShort}, c))
+
+		s.Equal("1 年 3 个月 2 天", c.DiffInStringWith(DiffOptions{Parts: 3, Locale: "zh-CN"}))
+		s.Equal("1年 3个月 2天 3小时", c.DiffInStringWith(DiffOptions{Parts: 4, Style: DiffStyleShort, Locale: "zh-CN"}))
+		s.Equal("1年 3個月 2天 3小時", c.DiffInStringWith(DiffOptions{Parts: 4, Style: DiffStyleShort, Locale: "zh-TW"}))
+		s.Equal("1 an, 3 mois et 2 jours", c.DiffInStringWith(DiffOptions{Parts: 3, Join: true, Locale: "fr"}))
+		// the locales without short units fall back to long units
+		s.Equal("1 год 3 месяца", c.DiffInStringWith(DiffOptions{Parts: 2, Style: DiffStyleShort, Locale: "ru"}))
+		s.Equal("1 год 3 месяца", c.DiffInStringWith(DiffOptions{Parts: 2, Join: true, Locale: "ru"}))
+		s.Equal("1 year 3 months", c.SetLocale("zh-CN").DiffInStringWith(DiffOptions{Parts: 2, Locale: "en"}))
+	})
+
+	s.Run("approximate", func() {
+		s.Equal("1 year", Parse("2019-08-05 13:14:15").DiffInStringWith(DiffOptions{Approximate: true}))
+		s.Equal("about 1 year", Parse("2019-05-03 10:10:10").DiffInStringWith(DiffOptions{Approximate: true}))
+		s.Equal("almost 2 hours", Parse("2020-08-05 11:15:00").DiffInStringWith(DiffOptions{Approximate: true}))
+		s.Equal("about 1 hour 59 minutes", Parse("2020-08-05 11:15:00").DiffInStringWith(DiffOptions{Parts: 2, Approximate: true}))
+		s.Equal("2 days 5 seconds", Parse("2020-08-03 13:14:10").DiffInStringWith(DiffOptions{Parts: 2, Approximate: true}))
+		s.Equal("将近2 小时", Parse("2020-08-05 11:15:00").DiffInStringWith(DiffOptions{Approximate: true, Locale: "zh-CN"}))
+		// the rounded up unit is carried to the larger unit
+		s.Equal("almost 1 day", Parse("2020-08-04 13:14:45").DiffInStringWith(DiffOptions{Parts: 2, Approximate: true}))
+		s.Equal("almost 1 year", Parse("2019-08-20 13:14:15").DiffInStringWith(DiffOptions{Approximate: true}))
+		// the locales without approximate words don't prefix
+		s.Equal("2 часа", Parse("2020-08-05 11:15:00").DiffInStringWith(DiffOptions{Approximate: true, Locale: "ru"}))
+	})
+}
+
+func (s *DifferenceSuite) TestCarbon_DiffForHumansWith() {
+	opts := DiffOptions{Parts: 2}
+
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Empty(c.DiffForHumansWith(opts))
+		s.Empty(Now().DiffForHumansWith(opts, c))
+		s.Empty(c.DiffForHumansWith(opts, c))
+	})
+
+	s.Run("zero carbon", func() {
+		c := NewCarbon()
+		s.Equal("2019 years 7 months ago", c.DiffForHumansWith(opts))
+		s.Equal("2019 years 7 months after", Now().DiffForHumansWith(opts, c))
+		s.Equal("just now", c.DiffForHumansWith(opts, c))
+	})
+
+	s.Run("empty carbon", func() {
+		c := Parse("")
+		s.Empty(c.DiffForHumansWith(opts))
+		s.Empty(Now().DiffForHumansWith(opts, c))
+		s.Empty(c.DiffForHumansWith(opts, c))
+	})
+
+	s.Run("error carbon", func() {
+		c := Parse("xxx")
+		s.Empty(c.DiffForHumansWith(opts))
+		s.Empty(Now().DiffForHumansWith(opts, c))
+		s.Empty(c.DiffForHumansWith(opts, c))
+	})
+
+	s.Run("nil lang", func() {
+		c := Now()
+		c.lang = nil
+		s.Empty(c.DiffForHumansWith(opts))
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("just now", Parse("2020-08-05 13:14:15").DiffForHumansWith(opts))
+		s.Equal("2 days ago", Parse("2020-08-03 13:14:15").DiffForHumansWith(opts))
+		s.Equal("2 days 3 hours from now", Parse("2020-08-07 16:14:15").DiffForHumansWith(opts))
+		s.Equal("2d 3h from now", Parse("2020-08-07 16:14:15").DiffForHumansWith(DiffOptions{Parts: 2, Style: DiffStyleShort}))
+		s.Equal("1 year and 3 months before", Parse("2019-05-03 10:10:10").DiffForHumansWith(DiffOptions{Parts: 2, Join: true}, Now()))
+		s.Equal("1 year and 3 months after", Now().DiffForHumansWith(DiffOptions{Parts: 2, Join: true}, Parse("2019-05-03 10:10:10")))
+		s.Equal("about 1 year ago", Parse("2019-05-03 10:10:10").DiffForHumansWith(DiffOptions{Approximate: true}))
+		s.Equal("almost 2 hours ago", Parse("2020-08-05 11:15:00").DiffForHumansWith(DiffOptions{Approximate: true}))
+		s.Equal("约1 年 3 个月前", Parse("2019-05-03 10:10:10").DiffForHumansWith(DiffOptions{Parts: 2, Approximate: true, Locale: "zh-CN"}))
+		s.Equal(Parse("2020-08-03 13:14:15").DiffForHumans(), Parse("2020-08-03 13:14:15").DiffForHumansWith(DiffOptions{}))
+	})
+}
+
 func (s *DifferenceSuite) TestCarbon_getDiffInMonths() {
 	s.Run("nil carbon", func() {
 		var c *Carbon
@@ -0,0 +1,129 @@
+package carbon
+
+import (
+	"sort"
+	"time"
+)
+
+// DSTPolicy defines a DSTPolicy type which decides how a local date and time is resolved when it is ambiguous,
+// which is repeated by a backward transition, or non-existent, which is skipped by a forward transition.
+type DSTPolicy string
+
+// dst policy constants
+const (
+	DSTEarlier DSTPolicy = "earlier" // resolves to the earlier one of the two candidates
+	DSTLater   DSTPolicy = "later"   // resolves to the later one of the two candidates
+	DSTError   DSTPolicy = "error"   // returns an error instead of resolving
+)
+
+// IsAmbiguous reports whether the local date and time occurs twice in the timezone, like 1986-09-14 01:30:00 in PRC,
+// and returns both candidates in order, which are the same instant if it isn't ambiguous.
+func IsAmbiguous(year, month, day, hour, minute, second int, timezone ...string) (earlier, later *Carbon, ok bool) {
+	loc, err := parseTimezone(timezone...)
+	if err != nil {
+		return &Carbon{Error: err}, &Carbon{Error: err}, false
+	}
+	instants, gap := getLocalInstants(year, month, day, hour, minute, second, MinNanosecond, loc)
+	if len(instants) < 2 || gap {
+		c := NewCarbon(time.Date(year, time.Month(month), day, hour, minute, second, MinNanosecond, loc))
+		return c, c.Copy(), false
+	}
+	return NewCarbon(instants[0]), NewCarbon(instants[len(instants)-1]), true
+}
+
+// IsNonExistent reports whether the local date and time is skipped in the timezone, like 1986-05-04 02:30:00 in PRC,
+// and returns both candidates in order, which are shifted by the offsets before and after the transition,
+// they are the same instant if it isn't non-existent.
+func IsNonExistent(year, month, day, hour, minute, second int, timezone ...string) (earlier, later *Carbon, ok bool) {
+	loc, err := parseTimezone(timezone...)
+	if err != nil {
+		return &Carbon{Error: err}, &Carbon{Error: err}, false
+	}
+	instants, gap := getLocalInstants(year, month, day, hour, minute, second, MinNanosecond, loc)
+	if len(instants) < 2 || !gap {
+		c := NewCarbon(time.Date(year, time.Month(month), day, hour, minute, second, MinNanosecond, loc))
+		return c, c.Copy(), false
+	}
+	return NewCarbon(instants[0]), NewCarbon(instants[len(instants)-1]), true
+}
+
+// creates a new Carbon instance from a given date, time and nanosecond, and resolves an ambiguous or non-existent
+// local time by the policy, an empty policy leaves it to time.Date.
+func createByPolicy(policy DSTPolicy, year, month, day, hour, minute, second, nanosecond int, timezone ...string) *Carbon {
+	var (
+		loc *Location
+		err error
+	)
+	if loc, err = parseTimezone(timezone...); err != nil {
+		return &Carbon{Error: err}
+	}
+	stdTime := time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, loc)
+	if policy == "" {
+		return NewCarbon(stdTime)
+	}
+	if !isValidDSTPolicy(policy) {
+		return &Carbon{Error: ErrInvalidDSTPolicy(policy)}
+	}
+	instants, gap := getLocalInstants(year, month, day, hour, minute, second, nanosecond, loc)
+	if len(instants) < 2 {
+		return NewCarbon(stdTime)
+	}
+	switch policy {
+	case DSTEarlier:
+		return NewCarbon(instants[0])
+	case DSTLater:
+		return NewCarbon(instants[len(instants)-1])
+	}
+	value := time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, time.UTC).Format(DateTimeLayout)
+	if gap {
+		return &Carbon{Error: ErrNonExistentTime(value, loc.String())}
+	}
+	return &Carbon{Error: ErrAmbiguousTime(value, loc.String())}
+}
+
+// gets the instants whose wall clock in the location is the local date and time in order,
+// if there is no such instant, gets the candidates shifted by the offsets around it and reports it's in a gap.
+func getLocalInstants(year, month, day, hour, minute, second, nanosecond int, loc *Location) (instants []StdTime, gap bool) {
+	wall := time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, time.UTC)
+
+	// the offsets in use a day before, at and a day after the local time
+	var offsets []int
+	for _, d := range []time.Duration{-24 * time.Hour, 0, 24 * time.Hour} {
+		_, offset := wall.Add(d).In(loc).Zone()
+		if !containsInt(offsets, offset) {
+			offsets = append(offsets, offset)
+		}
+	}
+
+	var candidates []StdTime
+	for _, offset := range offsets {
+		t := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		candidates = append(candidates, t)
+		y, m, d := t.Date()
+		if time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC).Equal(wall) {
+			instants = append(instants, t)
+		}
+	}
+	if len(instants) == 0 {
+		instants, gap = candidates, true
+	}
+	sort.Slice(instants, func(i, j int) bool {
+		return instants[i].Before(instants[j])
+	})
+	return
+}
+
+// reports whether the policy is a valid dst policy.
+func isValidDSTPolicy(policy DSTPolicy) bool {
+	return policy == DSTEarlier || policy == DSTLater || policy == DSTError
+}
+
+// reports whether the int slice contains the value.
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+)
+
+func BenchmarkIsAmbiguous(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			IsAmbiguous(1986, 9, 14, 1, 30, 0, PRC)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				IsAmbiguous(1986, 9, 14, 1, 30, 0, PRC)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				IsAmbiguous(1986, 9, 14, 1, 30, 0, PRC)
+			}
+		})
+	})
+}
+
+func BenchmarkIsNonExistent(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			IsNonExistent(1986, 5, 4, 2, 30, 0, PRC)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				IsNonExistent(1986, 5, 4, 2, 30, 0, PRC)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				IsNonExistent(1986, 5, 4, 2, 30, 0, PRC)
+			}
+		})
+	})
+}
+
+func BenchmarkFactory_CreateFromDateTimeByPolicy(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		f := NewFactory(Default{Timezone: PRC, DSTPolicy: DSTEarlier})
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			f.CreateFromDateTime(1986, 9, 14, 1, 30, 0)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		f := NewFactory(Default{Timezone: PRC, DSTPolicy: DSTEarlier})
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				f.CreateFromDateTime(1986, 9, 14, 1, 30, 0)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		f := NewFactory(Default{Timezone: PRC, DSTPolicy: DSTEarlier})
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				f.CreateFromDateTime(1986, 9, 14, 1, 30, 0)
+			}
+		})
+	})
+}
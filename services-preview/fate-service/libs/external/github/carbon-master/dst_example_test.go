@@ -0,0 +1,45 @@
+package carbon_test
+
+import (
+	"fmt"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func ExampleIsAmbiguous() {
+	earlier, later, ok := carbon.IsAmbiguous(1986, 9, 14, 1, 30, 0, carbon.PRC)
+	fmt.Println(ok)
+	fmt.Println(earlier.ToString())
+	fmt.Println(later.ToString())
+
+	// Output:
+	// true
+	// 1986-09-14 01:30:00 +0900 CDT
+	// 1986-09-14 01:30:00 +0800 CST
+}
+
+func ExampleIsNonExistent() {
+	earlier, later, ok := carbon.IsNonExistent(1986, 5, 4, 2, 30, 0, carbon.PRC)
+	fmt.Println(ok)
+	fmt.Println(earlier.ToString())
+	fmt.Println(later.ToString())
+
+	// Output:
+	// true
+	// 1986-05-04 01:30:00 +0800 CST
+	// 1986-05-04 03:30:00 +0900 CDT
+}
+
+func ExampleDSTPolicy() {
+	f := carbon.NewFactory(carbon.Default{Timezone: carbon.PRC, DSTPolicy: carbon.DSTError})
+	fmt.Println(f.CreateFromDateTime(1986, 9, 14, 1, 30, 0).Error)
+	fmt.Println(f.CreateFromDateTime(1986, 5, 4, 2, 30, 0).Error)
+
+	f = carbon.NewFactory(carbon.Default{Timezone: carbon.PRC, DSTPolicy: carbon.DSTEarlier})
+	fmt.Println(f.CreateFromDateTime(1986, 9, 14, 1, 30, 0).ToString())
+
+	// Output:
+	// local time "1986-09-14 01:30:00" is ambiguous in timezone "PRC"
+	// local time "1986-05-04 02:30:00" doesn't exist in timezone "PRC"
+	// 1986-09-14 01:30:00 +0900 CDT
+}
@@ -0,0 +1,170 @@
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DSTSuite struct {
+	suite.Suite
+}
+
+func TestDSTSuite(t *testing.T) {
+	suite.Run(t, new(DSTSuite))
+}
+
+func (s *DSTSuite) TearDownTest() {
+	ResetDefault()
+}
+
+func (s *DSTSuite) TestIsAmbiguous() {
+	s.Run("error timezone", func() {
+		earlier, later, ok := IsAmbiguous(1986, 9, 14, 1, 30, 0, "xxx")
+		s.False(ok)
+		s.Error(earlier.Error)
+		s.Error(later.Error)
+	})
+
+	s.Run("not ambiguous", func() {
+		earlier, later, ok := IsAmbiguous(1986, 9, 14, 2, 30, 0, PRC)
+		s.False(ok)
+		s.Equal("1986-09-14 02:30:00 +0800 CST", earlier.ToString())
+		s.True(earlier.Eq(later))
+
+		// non-existent time isn't ambiguous
+		earlier, later, ok = IsAmbiguous(1986, 5, 4, 2, 30, 0, PRC)
+		s.False(ok)
+		s.True(earlier.Eq(later))
+
+		earlier, _, ok = IsAmbiguous(2020, 8, 5, 13, 14, 15)
+		s.False(ok)
+		s.Equal("2020-08-05 13:14:15 +0000 UTC", earlier.ToString())
+	})
+
+	s.Run("china summer time", func() {
+		// the summer time ended at 02:00 on the 2nd sunday of september from 1986 to 1991
+		for _, date := range [][3]int{{1986, 9, 14}, {1987, 9, 13}, {1988, 9, 11}, {1989, 9, 17}, {1990, 9, 16}, {1991, 9, 15}} {
+			earlier, later, ok := IsAmbiguous(date[0], date[1], date[2], 1, 30, 0, PRC)
+			s.True(ok, date)
+			s.Equal("01:30:00 +0900 CDT", earlier.Layout("15:04:05 -0700 MST"), date)
+			s.Equal("01:30:00 +0800 CST", later.Layout("15:04:05 -0700 MST"), date)
+			s.Equal(int64(3600), later.Timestamp()-earlier.Timestamp(), date)
+		}
+		_, _, ok := IsAmbiguous(1992, 9, 13, 1, 30, 0, PRC)
+		s.False(ok)
+	})
+
+	s.Run("before 1949", func() {
+		earlier, later, ok := IsAmbiguous(1919, 9, 30, 23, 30, 0, Shanghai)
+		s.True(ok)
+		s.Equal("1919-09-30 23:30:00 +0900 CDT", earlier.ToString())
+		s.Equal("1919-09-30 23:30:00 +0800 CST", later.ToString())
+
+		// the local mean time of Shanghai was 5 minutes 43 seconds ahead of CST
+		earlier, later, ok = IsAmbiguous(1900, 12, 31, 23, 58, 0, Shanghai)
+		s.True(ok)
+		s.Equal("1900-12-31 23:58:00 +0805 LMT", earlier.ToString())
+		s.Equal("1900-12-31 23:58:00 +0800 CST", later.ToString())
+	})
+
+	s.Run("other timezone", func() {
+		earlier, later, ok := IsAmbiguous(2020, 11, 1, 1, 30, 0, NewYork)
+		s.True(ok)
+		s.Equal("2020-11-01 01:30:00 -0400 EDT", earlier.ToString())
+		s.Equal("2020-11-01 01:30:00 -0500 EST", later.ToString())
+	})
+}
+
+func (s *DSTSuite) TestIsNonExistent() {
+	s.Run("error timezone", func() {
+		earlier, later, ok := IsNonExistent(1986, 5, 4, 2, 30, 0, "xxx")
+		s.False(ok)
+		s.Error(earlier.Error)
+		s.Error(later.Error)
+	})
+
+	s.Run("not non-existent", func() {
+		earlier, later, ok := IsNonExistent(1986, 5, 4, 3, 30, 0, PRC)
+		s.False(ok)
+		s.Equal("1986-05-04 03:30:00 +0900 CDT", earlier.ToString())
+		s.True(earlier.Eq(later))
+
+		// ambiguous time isn't non-existent
+		earlier, later, ok = IsNonExistent(1986, 9, 14, 1, 30, 0, PRC)
+		s.False(ok)
+		s.True(earlier.Eq(later))
+	})
+
+	s.Run("china summer time", func() {
+		// the summer time started at 02:00 on the mid-april sunday from 1987 to 1991, and on 1986-05-04
+		for _, date := range [][3]int{{1986, 5, 4}, {1987, 4, 12}, {1988, 4, 17}, {1989, 4, 16}, {1990, 4, 15}, {1991, 4, 14}} {
+			earlier, later, ok := IsNonExistent(date[0], date[1], date[2], 2, 30, 0, PRC)
+			s.True(ok, date)
+			s.Equal("01:30:00 +0800 CST", earlier.Layout("15:04:05 -0700 MST"), date)
+			s.Equal("03:30:00 +0900 CDT", later.Layout("15:04:05 -0700 MST"), date)
+		}
+		_, _, ok := IsNonExistent(1992, 4, 12, 2, 30, 0, PRC)
+		s.False(ok)
+	})
+
+	s.Run("before 1949", func() {
+		// the summer time started at midnight on 1940-06-01
+		earlier, later, ok := IsNonExistent(1940, 6, 1, 0, 30, 0, Shanghai)
+		s.True(ok)
+		s.Equal("1940-05-31 23:30:00 +0800 CST", earlier.ToString())
+		s.Equal("1940-06-01 01:30:00 +0900 CDT", later.ToString())
+
+		// Urumqi switched from the local mean time to UTC+6 in 1928
+		earlier, later, ok = IsNonExistent(1928, 1, 1, 0, 5, 0, Urumqi)
+		s.True(ok)
+		s.Equal("1927-12-31 23:55:20 +0550 LMT", earlier.ToString())
+		s.Equal("1928-01-01 00:14:40 +0600 +06", later.ToString())
+	})
+
+	s.Run("other timezone", func() {
+		earlier, later, ok := IsNonExistent(2020, 3, 8, 2, 30, 0, NewYork)
+		s.True(ok)
+		s.Equal("2020-03-08 01:30:00 -0500 EST", earlier.ToString())
+		s.Equal("2020-03-08 03:30:00 -0400 EDT", later.ToString())
+	})
+}
+
+func (s *DSTSuite) TestDSTPolicy() {
+	s.Run("empty policy", func() {
+		s.Equal("1986-09-14 01:30:00 +0800 CST", CreateFromDateTime(1986, 9, 14, 1, 30, 0, PRC).ToString())
+		s.Equal("1986-05-04 03:30:00 +0900 CDT", CreateFromDateTime(1986, 5, 4, 2, 30, 0, PRC).ToString())
+	})
+
+	s.Run("invalid policy", func() {
+		SetDefault(Default{DSTPolicy: "xxx"})
+		s.Equal(ErrInvalidDSTPolicy("xxx"), CreateFromDateTime(1986, 9, 14, 1, 30, 0, PRC).Error)
+	})
+
+	s.Run("error timezone", func() {
+		SetDefault(Default{DSTPolicy: DSTError})
+		s.Error(CreateFromDateTime(1986, 9, 14, 1, 30, 0, "xxx").Error)
+	})
+
+	s.Run("earlier policy", func() {
+		SetDefault(Default{DSTPolicy: DSTEarlier})
+		s.Equal("1986-09-14 01:30:00 +0900 CDT", CreateFromDateTime(1986, 9, 14, 1, 30, 0, PRC).ToString())
+		s.Equal("1986-05-04 01:30:00 +0800 CST", CreateFromDateTime(1986, 5, 4, 2, 30, 0, PRC).ToString())
+		s.Equal("1986-08-05 13:14:15 +0900 CDT", CreateFromDateTime(1986, 8, 5, 13, 14, 15, PRC).ToString())
+	})
+
+	s.Run("later policy", func() {
+		SetDefault(Default{DSTPolicy: DSTLater})
+		s.Equal("1986-09-14 01:30:00 +0800 CST", CreateFromDateTime(1986, 9, 14, 1, 30, 0, PRC).ToString())
+		s.Equal("1986-05-04 03:30:00 +0900 CDT", CreateFromDateTime(1986, 5, 4, 2, 30, 0, PRC).ToString())
+		s.Equal("1940-06-01 01:00:00 +0900 CDT", CreateFromDate(1940, 6, 1, Shanghai).ToString())
+	})
+
+	s.Run("error policy", func() {
+		SetDefault(Default{DSTPolicy: DSTError})
+		s.Equal(ErrAmbiguousTime("1986-09-14 01:30:00", PRC), CreateFromDateTime(1986, 9, 14, 1, 30, 0, PRC).Error)
+		s.Equal(ErrNonExistentTime("1986-05-04 02:30:00", PRC), CreateFromDateTime(1986, 5, 4, 2, 30, 0, PRC).Error)
+		s.Equal(ErrNonExistentTime("1940-06-01 00:00:00", Shanghai), CreateFromDate(1940, 6, 1, Shanghai).Error)
+		s.Equal("1986-08-05 13:14:15 +0900 CDT", CreateFromDateTime(1986, 8, 5, 13, 14, 15, PRC).ToString())
+	})
+}
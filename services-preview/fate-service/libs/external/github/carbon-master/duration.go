@@ -0,0 +1,295 @@
+package carbon
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CalendarDuration defines a CalendarDuration struct which is aware of calendar,
+// years, months and days are calendar units and nanos is an exact duration.
+type CalendarDuration struct {
+	Years  int
+	Months int
+	Days   int
+	Nanos  int64
+	Error  error
+}
+
+// ParseCalendarDuration parses an ISO 8601 duration string like "P1Y2M10DT2H30M", "P2W" or "-PT1.5S" as a CalendarDuration instance,
+// each component can be signed like "P1Y-2M".
+func ParseCalendarDuration(value string) *CalendarDuration {
+	d := new(CalendarDuration)
+	if value == "" {
+		d.Error = ErrEmptyDuration()
+		return d
+	}
+	s := strings.ToUpper(value)
+	negative := false
+	if s[0] == '-' || s[0] == '+' {
+		negative, s = s[0] == '-', s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) == 1 {
+		d.Error = ErrInvalidDuration(value)
+		return d
+	}
+	s = s[1:]
+
+	const designators = "YMWDTHMS"
+	pos, isTime := 0, false
+	for s != "" {
+		if s[0] == 'T' {
+			if isTime || len(s) == 1 {
+				d.Error = ErrInvalidDuration(value)
+				return d
+			}
+			isTime, pos, s = true, strings.IndexByte(designators, 'T')+1, s[1:]
+			continue
+		}
+		i := strings.IndexFunc(s, func(r rune) bool {
+			return r >= 'A' && r <= 'Z'
+		})
+		if i <= 0 {
+			d.Error = ErrInvalidDuration(value)
+			return d
+		}
+		number, designator := s[:i], s[i]
+		s = s[i+1:]
+
+		// designators must be in order, "M" means months in the date part and minutes in the time part
+		index := strings.IndexByte(designators[pos:], designator)
+		if isTime && designator == 'M' {
+			index = strings.LastIndexByte(designators[pos:], designator)
+		}
+		if index < 0 || (!isTime && index+pos > 3) || (isTime && index+pos < 5) {
+			d.Error = ErrInvalidDuration(value)
+			return d
+		}
+		pos += index + 1
+
+		if designator == 'S' {
+			nanos, ok := parseSeconds(number)
+			if !ok {
+				d.Error = ErrInvalidDuration(value)
+				return d
+			}
+			d.Nanos += nanos
+			continue
+		}
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			d.Error = ErrInvalidDuration(value)
+			return d
+		}
+		switch {
+		case designator == 'Y':
+			d.Years = n
+		case designator == 'M' && !isTime:
+			d.Months = n
+		case designator == 'W':
+			d.Days += n * DaysPerWeek
+		case designator == 'D':
+			d.Days += n
+		case designator == 'H':
+			d.Nanos += int64(n) * int64(time.Hour)
+		case designator == 'M':
+			d.Nanos += int64(n) * int64(time.Minute)
+		}
+	}
+	if negative {
+		*d = d.Neg()
+	}
+	return d
+}
+
+// IsZero reports whether it is a zero duration.
+func (d CalendarDuration) IsZero() bool {
+	return d.Years == 0 && d.Months == 0 && d.Days == 0 && d.Nanos == 0
+}
+
+// Neg returns the negated duration.
+func (d CalendarDuration) Neg() CalendarDuration {
+	return CalendarDuration{Years: -d.Years, Months: -d.Months, Days: -d.Days, Nanos: -d.Nanos, Error: d.Error}
+}
+
+// String implements "Stringer" interface for CalendarDuration struct, it outputs an ISO 8601 duration string like "P1Y2M10DT2H30M".
+func (d CalendarDuration) String() string {
+	if d.Error != nil {
+		return ""
+	}
+	if d.IsZero() {
+		return "PT0S"
+	}
+	var b strings.Builder
+	if d.Years <= 0 && d.Months <= 0 && d.Days <= 0 && d.Nanos <= 0 {
+		b.WriteByte('-')
+		d = d.Neg()
+	}
+	b.WriteByte('P')
+	for _, part := range []struct {
+		value      int64
+		designator byte
+	}{{int64(d.Years), 'Y'}, {int64(d.Months), 'M'}, {int64(d.Days), 'D'}} {
+		if part.value != 0 {
+			b.WriteString(strconv.FormatInt(part.value, 10))
+			b.WriteByte(part.designator)
+		}
+	}
+	if d.Nanos == 0 {
+		return b.String()
+	}
+	b.WriteByte('T')
+	hours, minutes, nanos := d.Nanos/int64(time.Hour), d.Nanos%int64(time.Hour)/int64(time.Minute), d.Nanos%int64(time.Minute)
+	if hours != 0 {
+		b.WriteString(strconv.FormatInt(hours, 10))
+		b.WriteByte('H')
+	}
+	if minutes != 0 {
+		b.WriteString(strconv.FormatInt(minutes, 10))
+		b.WriteByte('M')
+	}
+	if nanos != 0 {
+		if nanos < 0 {
+			b.WriteByte('-')
+			nanos = -nanos
+		}
+		b.WriteString(strconv.FormatInt(nanos/int64(time.Second), 10))
+		if fraction := nanos % int64(time.Second); fraction != 0 {
+			b.WriteByte('.')
+			b.WriteString(strings.TrimRight(strconv.FormatInt(fraction+int64(time.Second), 10)[1:], "0"))
+		}
+		b.WriteByte('S')
+	}
+	return b.String()
+}
+
+// AddCalendarDuration adds a calendar duration, the years and months are added first, then the days and the nanos.
+func (c *Carbon) AddCalendarDuration(d *CalendarDuration) *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	if d == nil {
+		c.Error = ErrEmptyDuration()
+		return c
+	}
+	if d.Error != nil {
+		c.Error = d.Error
+		return c
+	}
+	result := c.AddMonths(d.Years*MonthsPerYear + d.Months)
+	result.time = result.StdTime().AddDate(0, 0, d.Days).Add(time.Duration(d.Nanos))
+	return result
+}
+
+// AddCalendarDurationNoOverflow adds a calendar duration without overflowing month.
+func (c *Carbon) AddCalendarDurationNoOverflow(d *CalendarDuration) *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	if d == nil {
+		c.Error = ErrEmptyDuration()
+		return c
+	}
+	if d.Error != nil {
+		c.Error = d.Error
+		return c
+	}
+	result := c.AddMonthsNoOverflow(d.Years*MonthsPerYear + d.Months)
+	result.time = result.StdTime().AddDate(0, 0, d.Days).Add(time.Duration(d.Nanos))
+	return result
+}
+
+// SubCalendarDuration subtracts a calendar duration.
+func (c *Carbon) SubCalendarDuration(d *CalendarDuration) *Carbon {
+	if d == nil || d.Error != nil {
+		return c.AddCalendarDuration(d)
+	}
+	neg := d.Neg()
+	return c.AddCalendarDuration(&neg)
+}
+
+// SubCalendarDurationNoOverflow subtracts a calendar duration without overflowing month.
+func (c *Carbon) SubCalendarDurationNoOverflow(d *CalendarDuration) *Carbon {
+	if d == nil || d.Error != nil {
+		return c.AddCalendarDurationNoOverflow(d)
+	}
+	neg := d.Neg()
+	return c.AddCalendarDurationNoOverflow(&neg)
+}
+
+// DiffInCalendarDuration gets the difference in calendar duration, adding it to the Carbon instance
+// by AddCalendarDuration gets the end time.
+func (c *Carbon) DiffInCalendarDuration(carbon ...*Carbon) *CalendarDuration {
+	d := new(CalendarDuration)
+	if c.IsInvalid() {
+		return d
+	}
+	var end *Carbon
+	if len(carbon) > 0 {
+		end = carbon[0]
+	} else {
+		end = c.now()
+	}
+	if end.IsInvalid() {
+		return d
+	}
+	start := c.StdTime()
+	stop := end.StdTime().In(start.Location())
+	sign := 1
+	if stop.Before(start) {
+		sign = -1
+	}
+	// reports whether t has passed the end time
+	passed := func(t time.Time) bool {
+		if sign > 0 {
+			return t.After(stop)
+		}
+		return t.Before(stop)
+	}
+
+	months := (stop.Year()-start.Year())*MonthsPerYear + int(stop.Month()-start.Month())
+	for months != 0 && passed(start.AddDate(0, months, 0)) {
+		months -= sign
+	}
+	middle := start.AddDate(0, months, 0)
+	days := int(stop.Sub(middle) / (HoursPerDay * time.Hour))
+	for days != 0 && passed(middle.AddDate(0, 0, days)) {
+		days -= sign
+	}
+	// a day may be shorter than 24 hours due to daylight saving time
+	for !passed(middle.AddDate(0, 0, days+sign)) {
+		days += sign
+	}
+
+	d.Years, d.Months, d.Days = months/MonthsPerYear, months%MonthsPerYear, days
+	d.Nanos = int64(stop.Sub(middle.AddDate(0, 0, days)))
+	return d
+}
+
+// reports whether it is an ISO 8601 duration string like "P1D" or "-PT1H".
+func isCalendarDuration(value string) bool {
+	value = strings.TrimLeft(value, "+-")
+	return value != "" && (value[0] == 'P' || value[0] == 'p')
+}
+
+// parses seconds with an optional fraction like "30", "-1.5" or "0,25" as nanoseconds.
+func parseSeconds(value string) (int64, bool) {
+	integer, fraction, _ := strings.Cut(strings.Replace(value, ",", ".", 1), ".")
+	negative := strings.HasPrefix(integer, "-")
+	seconds, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil || len(fraction) > 9 {
+		return 0, false
+	}
+	nanos := seconds * int64(time.Second)
+	if fraction != "" {
+		n, err := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+		if err != nil || n < 0 || strings.HasPrefix(fraction, "+") {
+			return 0, false
+		}
+		if negative {
+			n = -n
+		}
+		nanos += n
+	}
+	return nanos, true
+}
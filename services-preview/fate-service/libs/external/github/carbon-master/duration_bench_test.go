@@ -0,0 +1,138 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+)
+
+func BenchmarkParseCalendarDuration(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			ParseCalendarDuration("P1Y2M10DT2H30M")
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ParseCalendarDuration("P1Y2M10DT2H30M")
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				ParseCalendarDuration("P1Y2M10DT2H30M")
+			}
+		})
+	})
+}
+
+func BenchmarkCalendarDuration_String(b *testing.B) {
+	d := ParseCalendarDuration("P1Y2M10DT2H30M")
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			_ = d.String()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = d.String()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = d.String()
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_AddCalendarDuration(b *testing.B) {
+	c := Parse("2020-01-31 13:14:15")
+	d := ParseCalendarDuration("P1Y2M10DT2H30M")
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.AddCalendarDuration(d)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.AddCalendarDuration(d)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.AddCalendarDuration(d)
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_DiffInCalendarDuration(b *testing.B) {
+	c := Parse("2020-01-01 00:00:00")
+	e := Parse("2021-03-11 02:30:00")
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.DiffInCalendarDuration(e)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.DiffInCalendarDuration(e)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.DiffInCalendarDuration(e)
+			}
+		})
+	})
+}
@@ -0,0 +1,51 @@
+package carbon_test
+
+import (
+	"fmt"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func ExampleParseCalendarDuration() {
+	d := carbon.ParseCalendarDuration("P1Y2M10DT2H30M")
+	fmt.Println(d.Years, d.Months, d.Days)
+	fmt.Println(d.String())
+	fmt.Println(carbon.ParseCalendarDuration("P2W").String())
+
+	// Output:
+	// 1 2 10
+	// P1Y2M10DT2H30M
+	// P14D
+}
+
+func ExampleCarbon_AddCalendarDuration() {
+	d := carbon.ParseCalendarDuration("P1M")
+	fmt.Println(carbon.Parse("2020-01-31 13:14:15").AddCalendarDuration(d).ToDateTimeString())
+	fmt.Println(carbon.Parse("2020-01-31 13:14:15").AddCalendarDurationNoOverflow(d).ToDateTimeString())
+
+	// Output:
+	// 2020-03-02 13:14:15
+	// 2020-02-29 13:14:15
+}
+
+func ExampleCarbon_SubCalendarDuration() {
+	d := carbon.ParseCalendarDuration("P1M")
+	fmt.Println(carbon.Parse("2020-03-31 13:14:15").SubCalendarDuration(d).ToDateTimeString())
+	fmt.Println(carbon.Parse("2020-03-31 13:14:15").SubCalendarDurationNoOverflow(d).ToDateTimeString())
+
+	// Output:
+	// 2020-03-02 13:14:15
+	// 2020-02-29 13:14:15
+}
+
+func ExampleCarbon_DiffInCalendarDuration() {
+	start := carbon.Parse("2020-01-01 00:00:00")
+	end := carbon.Parse("2021-03-11 02:30:00")
+	d := start.DiffInCalendarDuration(end)
+	fmt.Println(d.String())
+	fmt.Println(start.AddCalendarDuration(d).ToDateTimeString())
+
+	// Output:
+	// P1Y2M10DT2H30M
+	// 2021-03-11 02:30:00
+}
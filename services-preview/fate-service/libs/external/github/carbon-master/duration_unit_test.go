@@ -0,0 +1,199 @@
+package carbon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CalendarDurationSuite struct {
+	suite.Suite
+}
+
+func TestCalendarDurationSuite(t *testing.T) {
+	suite.Run(t, new(CalendarDurationSuite))
+}
+
+func (s *CalendarDurationSuite) TestParseCalendarDuration() {
+	s.Run("empty duration", func() {
+		s.Error(ParseCalendarDuration("").Error)
+	})
+
+	s.Run("invalid duration", func() {
+		for _, value := range []string{
+			"xxx", "P", "-P", "PT", "P1YT", "1Y", "P1", "PY", "P1.5Y", "P1X", "PT1D", "P1H", "P1D1Y", "P1M1Y",
+			"PT1S1M", "P1DT1HT1M", "PT1.1234567891S", "PT1.-5S", "PT1.+5S", "PTxS", "P1W1M",
+		} {
+			s.Error(ParseCalendarDuration(value).Error, value)
+		}
+	})
+
+	s.Run("valid duration", func() {
+		s.Equal(&CalendarDuration{Years: 1, Months: 2, Days: 10, Nanos: int64(2*time.Hour + 30*time.Minute)}, ParseCalendarDuration("P1Y2M10DT2H30M"))
+		s.Equal(&CalendarDuration{Days: 14}, ParseCalendarDuration("P2W"))
+		s.Equal(&CalendarDuration{Days: 17}, ParseCalendarDuration("P2W3D"))
+		s.Equal(&CalendarDuration{Months: 1}, ParseCalendarDuration("P1M"))
+		s.Equal(&CalendarDuration{Nanos: int64(time.Minute)}, ParseCalendarDuration("PT1M"))
+		s.Equal(&CalendarDuration{Nanos: int64(1500 * time.Millisecond)}, ParseCalendarDuration("PT1.5S"))
+		s.Equal(&CalendarDuration{Nanos: int64(250 * time.Millisecond)}, ParseCalendarDuration("pt0,25s"))
+		s.Equal(&CalendarDuration{Nanos: 1}, ParseCalendarDuration("PT0.000000001S"))
+		s.Equal(&CalendarDuration{Days: -1, Nanos: -int64(time.Hour)}, ParseCalendarDuration("-P1DT1H"))
+		s.Equal(&CalendarDuration{Days: 1}, ParseCalendarDuration("+P1D"))
+		s.Equal(&CalendarDuration{Years: 1, Months: -2}, ParseCalendarDuration("P1Y-2M"))
+		s.Equal(&CalendarDuration{Years: -1, Months: 2}, ParseCalendarDuration("-P1Y-2M"))
+		s.Equal(&CalendarDuration{Nanos: -int64(500 * time.Millisecond)}, ParseCalendarDuration("PT-0.5S"))
+		s.Equal(&CalendarDuration{}, ParseCalendarDuration("P0D"))
+	})
+}
+
+func (s *CalendarDurationSuite) TestCalendarDuration_String() {
+	s.Run("error duration", func() {
+		s.Empty(ParseCalendarDuration("xxx").String())
+	})
+
+	s.Run("valid duration", func() {
+		s.Equal("PT0S", CalendarDuration{}.String())
+		s.Equal("P1Y2M10DT2H30M", CalendarDuration{Years: 1, Months: 2, Days: 10, Nanos: int64(2*time.Hour + 30*time.Minute)}.String())
+		s.Equal("P14D", CalendarDuration{Days: 14}.String())
+		s.Equal("PT25H", CalendarDuration{Nanos: int64(25 * time.Hour)}.String())
+		s.Equal("PT1.5S", CalendarDuration{Nanos: int64(1500 * time.Millisecond)}.String())
+		s.Equal("PT0.000000001S", CalendarDuration{Nanos: 1}.String())
+		s.Equal("-P1DT1H", CalendarDuration{Days: -1, Nanos: -int64(time.Hour)}.String())
+		s.Equal("P1Y-2M", CalendarDuration{Years: 1, Months: -2}.String())
+		s.Equal("P1DT-1H-30M-0.5S", CalendarDuration{Days: 1, Nanos: -int64(time.Hour + 30*time.Minute + 500*time.Millisecond)}.String())
+	})
+
+	s.Run("round trip", func() {
+		for _, value := range []string{"P1Y2M10DT2H30M", "-P1Y", "P1Y-2M", "PT1.5S", "P1DT-1H-30M-0.5S", "PT0S"} {
+			s.Equal(value, ParseCalendarDuration(value).String())
+		}
+	})
+}
+
+func (s *CalendarDurationSuite) TestCalendarDuration_IsZero() {
+	s.True(CalendarDuration{}.IsZero())
+	s.True(ParseCalendarDuration("P0Y0M0DT0S").IsZero())
+	s.False(CalendarDuration{Nanos: 1}.IsZero())
+}
+
+func (s *CalendarDurationSuite) TestCalendarDuration_Neg() {
+	s.Equal(CalendarDuration{Years: -1, Months: 2, Days: -3, Nanos: -4}, CalendarDuration{Years: 1, Months: -2, Days: 3, Nanos: 4}.Neg())
+}
+
+func (s *CalendarDurationSuite) TestCarbon_AddCalendarDuration() {
+	d := ParseCalendarDuration("P1M1DT1H")
+
+	s.Run("invalid carbon", func() {
+		s.True(((*Carbon)(nil)).AddCalendarDuration(d).IsNil())
+		s.True(Parse("").AddCalendarDuration(d).IsEmpty())
+		s.Error(Parse("xxx").AddCalendarDuration(d).Error)
+	})
+
+	s.Run("invalid duration", func() {
+		s.Error(Parse("2020-01-31").AddCalendarDuration(nil).Error)
+		s.Error(Parse("2020-01-31").AddCalendarDuration(ParseCalendarDuration("xxx")).Error)
+	})
+
+	s.Run("valid duration", func() {
+		c := Parse("2020-01-31 13:14:15")
+		s.Equal("2020-03-03 14:14:15", c.AddCalendarDuration(d).ToDateTimeString())
+		s.Equal("2020-01-31 13:14:15", c.ToDateTimeString())
+		s.Equal("2021-03-03 13:14:15", c.AddCalendarDuration(&CalendarDuration{Years: 1, Months: 1}).ToDateTimeString())
+	})
+
+	s.Run("daylight saving time", func() {
+		c := Parse("2024-03-09 12:00:00", "America/New_York")
+		s.Equal("2024-03-10 12:00:00 -0400 EDT", c.AddCalendarDuration(&CalendarDuration{Days: 1}).ToString())
+		s.Equal("2024-03-10 13:00:00 -0400 EDT", c.AddCalendarDuration(&CalendarDuration{Nanos: int64(24 * time.Hour)}).ToString())
+	})
+}
+
+func (s *CalendarDurationSuite) TestCarbon_AddCalendarDurationNoOverflow() {
+	d := ParseCalendarDuration("P1M1DT1H")
+
+	s.Run("invalid carbon", func() {
+		s.True(((*Carbon)(nil)).AddCalendarDurationNoOverflow(d).IsNil())
+		s.True(Parse("").AddCalendarDurationNoOverflow(d).IsEmpty())
+		s.Error(Parse("xxx").AddCalendarDurationNoOverflow(d).Error)
+	})
+
+	s.Run("invalid duration", func() {
+		s.Error(Parse("2020-01-31").AddCalendarDurationNoOverflow(nil).Error)
+		s.Error(Parse("2020-01-31").AddCalendarDurationNoOverflow(ParseCalendarDuration("xxx")).Error)
+	})
+
+	s.Run("valid duration", func() {
+		c := Parse("2020-01-31 13:14:15")
+		s.Equal("2020-03-01 14:14:15", c.AddCalendarDurationNoOverflow(d).ToDateTimeString())
+		s.Equal("2021-02-28 13:14:15", c.AddCalendarDurationNoOverflow(&CalendarDuration{Years: 1, Months: 1}).ToDateTimeString())
+	})
+}
+
+func (s *CalendarDurationSuite) TestCarbon_SubCalendarDuration() {
+	d := ParseCalendarDuration("P1M1DT1H")
+
+	s.Run("invalid duration", func() {
+		s.Error(Parse("2020-03-31").SubCalendarDuration(nil).Error)
+		s.Error(Parse("2020-03-31").SubCalendarDuration(ParseCalendarDuration("xxx")).Error)
+	})
+
+	s.Run("valid duration", func() {
+		c := Parse("2020-03-31 13:14:15")
+		s.Equal("2020-03-01 12:14:15", c.SubCalendarDuration(d).ToDateTimeString())
+		s.Equal("2020-03-31 13:14:15", c.ToDateTimeString())
+	})
+}
+
+func (s *CalendarDurationSuite) TestCarbon_SubCalendarDurationNoOverflow() {
+	d := ParseCalendarDuration("P1M1DT1H")
+
+	s.Run("invalid duration", func() {
+		s.Error(Parse("2020-03-31").SubCalendarDurationNoOverflow(nil).Error)
+		s.Error(Parse("2020-03-31").SubCalendarDurationNoOverflow(ParseCalendarDuration("xxx")).Error)
+	})
+
+	s.Run("valid duration", func() {
+		c := Parse("2020-03-31 13:14:15")
+		s.Equal("2020-02-28 12:14:15", c.SubCalendarDurationNoOverflow(d).ToDateTimeString())
+	})
+}
+
+func (s *CalendarDurationSuite) TestCarbon_DiffInCalendarDuration() {
+	s.Run("invalid carbon", func() {
+		s.True(((*Carbon)(nil)).DiffInCalendarDuration(Now()).IsZero())
+		s.True(Parse("").DiffInCalendarDuration(Now()).IsZero())
+		s.True(Now().DiffInCalendarDuration(Parse("xxx")).IsZero())
+	})
+
+	s.Run("without end", func() {
+		s.True(Now().SubYear().DiffInCalendarDuration().Years <= 1)
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("PT0S", Parse("2020-08-05 13:14:15").DiffInCalendarDuration(Parse("2020-08-05 13:14:15")).String())
+		s.Equal("P1Y2M10DT2H30M", Parse("2020-01-01 00:00:00").DiffInCalendarDuration(Parse("2021-03-11 02:30:00")).String())
+		s.Equal("-P1Y2M10DT2H30M", Parse("2021-03-11 02:30:00").DiffInCalendarDuration(Parse("2020-01-01 00:00:00")).String())
+		s.Equal("P29DT23H", Parse("2021-01-31 01:00:00").DiffInCalendarDuration(Parse("2021-03-02 00:00:00")).String())
+		s.Equal("PT8H", Parse("2020-08-05 08:00:00", PRC).DiffInCalendarDuration(Parse("2020-08-05 08:00:00", UTC)).String())
+	})
+
+	s.Run("daylight saving time", func() {
+		s.Equal("P1D", Parse("2024-03-09 12:00:00", "America/New_York").DiffInCalendarDuration(Parse("2024-03-10 12:00:00", "America/New_York")).String())
+		s.Equal("PT22H", Parse("2024-03-09 12:00:00", "America/New_York").DiffInCalendarDuration(Parse("2024-03-10 11:00:00", "America/New_York")).String())
+	})
+
+	s.Run("inverse of add calendar duration", func() {
+		dates := []string{
+			"2020-01-31 13:14:15", "2020-02-29 00:00:00", "2020-03-31 23:59:59", "2021-02-28 12:00:00",
+			"2019-12-31 00:00:00.999999999", "2024-03-10 03:30:00", "2024-11-03 01:30:00",
+		}
+		for _, start := range dates {
+			for _, end := range dates {
+				c := Parse(start, "America/New_York")
+				e := Parse(end, "America/New_York")
+				s.True(c.AddCalendarDuration(c.DiffInCalendarDuration(e)).Eq(e), start+" "+end)
+			}
+		}
+	})
+}
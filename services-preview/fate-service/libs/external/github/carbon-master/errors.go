@@ -85,8 +85,123 @@ var (
 		return fmt.Errorf("format cannot be empty")
 	}
 
+	// ErrNilDialect nil dialect error.
+	ErrNilDialect = func() error {
+		return fmt.Errorf("dialect cannot be nil")
+	}
+
+	// ErrEmptyPattern empty pattern error.
+	ErrEmptyPattern = func() error {
+		return fmt.Errorf("pattern cannot be empty")
+	}
+
+	// ErrInvalidPattern invalid pattern error.
+	ErrInvalidPattern = func(pattern, token string) error {
+		return fmt.Errorf("invalid pattern %q, %q isn't supported", pattern, token)
+	}
+
 	// ErrMismatchedFormat mismatched format error.
 	ErrMismatchedFormat = func(value, format string) error {
 		return fmt.Errorf("value %q and format %q are mismatched", value, format)
 	}
+
+	// ErrNilCarbon nil carbon error.
+	ErrNilCarbon = func() error {
+		return fmt.Errorf("carbon cannot be nil")
+	}
+
+	// ErrNilFactory nil factory error.
+	ErrNilFactory = func() error {
+		return fmt.Errorf("factory cannot be nil")
+	}
+
+	// ErrInvalidBounds invalid bounds error.
+	ErrInvalidBounds = func(bounds string) error {
+		return fmt.Errorf("invalid bounds %q, please use one of %q, %q, %q and %q", bounds, BoundsClosed, BoundsOpen, BoundsOpenStart, BoundsOpenEnd)
+	}
+
+	// ErrEmptyPeriod empty period error.
+	ErrEmptyPeriod = func() error {
+		return fmt.Errorf("start and end time of period cannot be empty")
+	}
+
+	// ErrInvalidPeriod invalid period error.
+	ErrInvalidPeriod = func(start, end *Carbon) error {
+		return fmt.Errorf("start time %q of period cannot be after end time %q", start, end)
+	}
+
+	// ErrFailedParsePeriod failed to parse period error.
+	ErrFailedParsePeriod = func(value any) error {
+		return fmt.Errorf("failed to parse %v as period", value)
+	}
+
+	// ErrNoBusinessDay no business day error.
+	ErrNoBusinessDay = func(c *Carbon) error {
+		return fmt.Errorf("no business day within a year from %q", c)
+	}
+
+	// ErrNotExistCalendar not exist calendar error.
+	ErrNotExistCalendar = func(name string) error {
+		return fmt.Errorf("calendar %q doesn't exist", name)
+	}
+
+	// ErrInvalidCoordinate invalid coordinate error.
+	ErrInvalidCoordinate = func(latitude, longitude float64) error {
+		return fmt.Errorf("invalid coordinate (%v, %v), latitude must be in [-90, 90] and longitude must be in [-180, 180]", latitude, longitude)
+	}
+
+	// ErrInvalidLongitude invalid longitude error.
+	ErrInvalidLongitude = func(longitude float64) error {
+		return fmt.Errorf("invalid longitude %v, longitude must be in [-180, 180]", longitude)
+	}
+
+	// ErrInvalidDSTPolicy invalid dst policy error.
+	ErrInvalidDSTPolicy = func(policy DSTPolicy) error {
+		return fmt.Errorf("invalid dst policy %q, it must be %q, %q or %q", policy, DSTEarlier, DSTLater, DSTError)
+	}
+
+	// ErrAmbiguousTime ambiguous time error.
+	ErrAmbiguousTime = func(value, timezone string) error {
+		return fmt.Errorf("local time %q is ambiguous in timezone %q", value, timezone)
+	}
+
+	// ErrNonExistentTime non-existent time error.
+	ErrNonExistentTime = func(value, timezone string) error {
+		return fmt.Errorf("local time %q doesn't exist in timezone %q", value, timezone)
+	}
+
+	// ErrFailedUnmarshalBinary failed to unmarshal binary error.
+	ErrFailedUnmarshalBinary = func(data []byte) error {
+		return fmt.Errorf("failed to unmarshal binary %x as carbon", data)
+	}
+
+	// ErrFailedUnmarshalBSON failed to unmarshal bson error.
+	ErrFailedUnmarshalBSON = func(typ byte, data []byte) error {
+		return fmt.Errorf("failed to unmarshal bson value %x of type 0x%02X as carbon", data, typ)
+	}
+
+	// ErrInvalidProtoTimestamp invalid protobuf timestamp error.
+	ErrInvalidProtoTimestamp = func(seconds int64, nanos int32) error {
+		return fmt.Errorf("invalid protobuf timestamp with seconds %d and nanos %d", seconds, nanos)
+	}
+
+	// ErrInvalidProtoDuration invalid protobuf duration error.
+	ErrInvalidProtoDuration = func(seconds int64, nanos int32) error {
+		return fmt.Errorf("invalid protobuf duration with seconds %d and nanos %d", seconds, nanos)
+	}
+
+	// ErrInvalidMonth invalid month error.
+	ErrInvalidMonth = func(month int) error {
+		return fmt.Errorf("invalid month %d, month must be in [1, 12]", month)
+	}
+
+	// ErrInvalidWeekday invalid weekday error.
+	ErrInvalidWeekday = func(weekday Weekday) error {
+		return fmt.Errorf("invalid weekday %d, weekday must be in [0, 6]", weekday)
+	}
+
+	// ErrInvalidISOWeekDate invalid ISO 8601 week date error.
+	ErrInvalidISOWeekDate = func(year, week, day int) error {
+		return fmt.Errorf("invalid ISO 8601 week date %04d-W%02d-%d, week must be in [1, %d] and day must be in [1, 7]", year, week, day, isoWeeksInYear(year))
+	}
 )
@@ -0,0 +1,473 @@
+package carbon
+
+import (
+	"time"
+
+	"github.com/dromara/carbon/v2/calendar"
+	"github.com/dromara/carbon/v2/calendar/coptic"
+	"github.com/dromara/carbon/v2/calendar/ethiopian"
+	"github.com/dromara/carbon/v2/calendar/hebrew"
+	"github.com/dromara/carbon/v2/calendar/hijri"
+	"github.com/dromara/carbon/v2/calendar/julian"
+	"github.com/dromara/carbon/v2/calendar/lunar"
+	"github.com/dromara/carbon/v2/calendar/persian"
+)
+
+// Factory defines a Factory struct which creates Carbon instances with its own layout, timezone, locale, week rules and dst policy
+// instead of the package-level defaults, it is immutable and safe for concurrent use.
+type Factory struct {
+	layout       string
+	timezone     string
+	lang         *Language
+	weekStartsAt Weekday
+	weekendDays  []Weekday
+	clock        Clock
+	dstPolicy    DSTPolicy
+	Error        error
+}
+
+// NewFactory returns a new Factory instance built from Default like SetDefault does,
+// the empty fields follow the package-level defaults at the time of building,
+// note that WeekStartsAt is empty if it's Sunday, the zero value, so use WithWeekStartsAt to start weeks on Sunday.
+func NewFactory(d Default) *Factory {
+	f := &Factory{
+		layout:       DefaultLayout,
+		timezone:     DefaultTimezone,
+		weekStartsAt: DefaultWeekStartsAt,
+		weekendDays:  DefaultWeekendDays,
+		dstPolicy:    DefaultDSTPolicy,
+	}
+	locale := DefaultLocale
+	if d.Layout != "" {
+		f.layout = d.Layout
+	}
+	if d.Timezone != "" {
+		f.timezone = d.Timezone
+	}
+	if d.Locale != "" {
+		locale = d.Locale
+	}
+	if d.WeekStartsAt != Sunday {
+		f.weekStartsAt = d.WeekStartsAt
+	}
+	if len(d.WeekendDays) > 0 {
+		f.weekendDays = d.WeekendDays
+	}
+	if d.DSTPolicy != "" {
+		f.dstPolicy = d.DSTPolicy
+	}
+	f.weekendDays = append([]Weekday(nil), f.weekendDays...)
+	if f.dstPolicy != "" && !isValidDSTPolicy(f.dstPolicy) {
+		f.Error = ErrInvalidDSTPolicy(f.dstPolicy)
+		return f
+	}
+	if f.weekStartsAt < Sunday || f.weekStartsAt > Saturday {
+		f.Error = ErrInvalidWeekday(f.weekStartsAt)
+		return f
+	}
+	if _, f.Error = parseTimezone(f.timezone); f.Error != nil {
+		return f
+	}
+	f.lang = NewLanguage().SetLocale(locale)
+	f.Error = f.lang.Error
+	return f
+}
+
+// WithClock returns a copy of the Factory instance with the clock, a nil clock means the default clock.
+func (f *Factory) WithClock(clock Clock) *Factory {
+	if f == nil {
+		return nil
+	}
+	nf := *f
+	nf.clock = clock
+	return &nf
+}
+
+// WithWeekStartsAt returns a copy of the Factory instance with the start day of the week.
+func (f *Factory) WithWeekStartsAt(weekDay Weekday) *Factory {
+	if f == nil {
+		return nil
+	}
+	nf := *f
+	nf.weekStartsAt = weekDay
+	if nf.Error == nil && (weekDay < Sunday || weekDay > Saturday) {
+		nf.Error = ErrInvalidWeekday(weekDay)
+	}
+	return &nf
+}
+
+// Layout returns the default layout of the Factory instance.
+func (f *Factory) Layout() string {
+	if f == nil {
+		return ""
+	}
+	return f.layout
+}
+
+// Timezone returns the default timezone of the Factory instance.
+func (f *Factory) Timezone() string {
+	if f == nil {
+		return ""
+	}
+	return f.timezone
+}
+
+// Locale returns the default locale of the Factory instance.
+func (f *Factory) Locale() string {
+	if f == nil || f.lang == nil {
+		return ""
+	}
+	return f.lang.locale
+}
+
+// WeekStartsAt returns the default start day of the week of the Factory instance.
+func (f *Factory) WeekStartsAt() Weekday {
+	if f == nil {
+		return 0
+	}
+	return f.weekStartsAt
+}
+
+// WeekendDays returns the default weekend days of the Factory instance.
+func (f *Factory) WeekendDays() []Weekday {
+	if f == nil {
+		return nil
+	}
+	return append([]Weekday(nil), f.weekendDays...)
+}
+
+// DSTPolicy returns the default dst policy of the Factory instance.
+func (f *Factory) DSTPolicy() DSTPolicy {
+	if f == nil {
+		return ""
+	}
+	return f.dstPolicy
+}
+
+// NewCarbon returns a new Carbon instance like NewCarbon with the settings of the Factory instance.
+func (f *Factory) NewCarbon(stdTime ...StdTime) *Carbon {
+	if len(stdTime) > 0 {
+		return f.apply(NewCarbon(stdTime[0]), true)
+	}
+	c := NewCarbon()
+	c.loc, c.Error = parseTimezone(f.Timezone())
+	return f.apply(c, true)
+}
+
+// Now returns a Carbon instance for now by the clock of the Factory instance.
+func (f *Factory) Now(timezone ...string) *Carbon {
+	return f.apply(NowWithClock(f.getClock(), f.timezones(timezone)...), true)
+}
+
+// Tomorrow returns a Carbon instance for tomorrow by the clock of the Factory instance.
+func (f *Factory) Tomorrow(timezone ...string) *Carbon {
+	return f.apply(TomorrowWithClock(f.getClock(), f.timezones(timezone)...), true)
+}
+
+// Yesterday returns a Carbon instance for yesterday by the clock of the Factory instance.
+func (f *Factory) Yesterday(timezone ...string) *Carbon {
+	return f.apply(YesterdayWithClock(f.getClock(), f.timezones(timezone)...), true)
+}
+
+// CreateFromStdTime creates a Carbon instance from standard time.Time like CreateFromStdTime.
+func (f *Factory) CreateFromStdTime(stdTime StdTime, timezone ...string) *Carbon {
+	return f.apply(CreateFromStdTime(stdTime, timezone...), true)
+}
+
+// CreateFromTimestamp creates a Carbon instance from a given timestamp with second precision.
+func (f *Factory) CreateFromTimestamp(timestamp int64, timezone ...string) *Carbon {
+	return f.apply(CreateFromTimestamp(timestamp, f.timezones(timezone)...), true)
+}
+
+// CreateFromTimestampMilli creates a Carbon instance from a given timestamp with millisecond precision.
+func (f *Factory) CreateFromTimestampMilli(timestampMilli int64, timezone ...string) *Carbon {
+	return f.apply(CreateFromTimestampMilli(timestampMilli, f.timezones(timezone)...), true)
+}
+
+// CreateFromTimestampMicro creates a Carbon instance from a given timestamp with microsecond precision.
+func (f *Factory) CreateFromTimestampMicro(timestampMicro int64, timezone ...string) *Carbon {
+	return f.apply(CreateFromTimestampMicro(timestampMicro, f.timezones(timezone)...), true)
+}
+
+// CreateFromTimestampNano creates a Carbon instance from a given timestamp with nanosecond precision.
+func (f *Factory) CreateFromTimestampNano(timestampNano int64, timezone ...string) *Carbon {
+	return f.apply(CreateFromTimestampNano(timestampNano, f.timezones(timezone)...), true)
+}
+
+// CreateFromDateTime creates a Carbon instance from a given date and time.
+func (f *Factory) CreateFromDateTime(year, month, day, hour, minute, second int, timezone ...string) *Carbon {
+	return f.apply(createByPolicy(f.DSTPolicy(), year, month, day, hour, minute, second, MinNanosecond, f.timezones(timezone)...), true)
+}
+
+// CreateFromDateTimeMilli creates a Carbon instance from a given date, time and millisecond.
+func (f *Factory) CreateFromDateTimeMilli(year, month, day, hour, minute, second, millisecond int, timezone ...string) *Carbon {
+	return f.apply(createByPolicy(f.DSTPolicy(), year, month, day, hour, minute, second, millisecond*1e6, f.timezones(timezone)...), true)
+}
+
+// CreateFromDateTimeMicro creates a Carbon instance from a given date, time and microsecond.
+func (f *Factory) CreateFromDateTimeMicro(year, month, day, hour, minute, second, microsecond int, timezone ...string) *Carbon {
+	return f.apply(createByPolicy(f.DSTPolicy(), year, month, day, hour, minute, second, microsecond*1e3, f.timezones(timezone)...), true)
+}
+
+// CreateFromDateTimeNano creates a Carbon instance from a given date, time and nanosecond.
+func (f *Factory) CreateFromDateTimeNano(year, month, day, hour, minute, second, nanosecond int, timezone ...string) *Carbon {
+	return f.apply(createByPolicy(f.DSTPolicy(), year, month, day, hour, minute, second, nanosecond, f.timezones(timezone)...), true)
+}
+
+// CreateFromDate creates a Carbon instance from a given date.
+func (f *Factory) CreateFromDate(year, month, day int, timezone ...string) *Carbon {
+	return f.apply(createByPolicy(f.DSTPolicy(), year, month, day, MinHour, MinMinute, MinSecond, MinNanosecond, f.timezones(timezone)...), true)
+}
+
+// CreateFromDateMilli creates a Carbon instance from a given date and millisecond.
+func (f *Factory) CreateFromDateMilli(year, month, day, millisecond int, timezone ...string) *Carbon {
+	return f.apply(createByPolicy(f.DSTPolicy(), year, month, day, MinHour, MinMinute, MinSecond, millisecond*1e6, f.timezones(timezone)...), true)
+}
+
+// CreateFromDateMicro creates a Carbon instance from a given date and microsecond.
+func (f *Factory) CreateFromDateMicro(year, month, day, microsecond int, timezone ...string) *Carbon {
+	return f.apply(createByPolicy(f.DSTPolicy(), year, month, day, MinHour, MinMinute, MinSecond, microsecond*1e3, f.timezones(timezone)...), true)
+}
+
+// CreateFromDateNano creates a Carbon instance from a given date and nanosecond.
+func (f *Factory) CreateFromDateNano(year, month, day, nanosecond int, timezone ...string) *Carbon {
+	return f.apply(createByPolicy(f.DSTPolicy(), year, month, day, MinHour, MinMinute, MinSecond, nanosecond, f.timezones(timezone)...), true)
+}
+
+// CreateFromISOWeekDate creates a Carbon instance from a given ISO 8601 week-numbering year, week and day of week.
+func (f *Factory) CreateFromISOWeekDate(year, week, day int, timezone ...string) *Carbon {
+	c := createByPolicy(f.DSTPolicy(), year, int(time.January), isoWeekDay(year, week, day), MinHour, MinMinute, MinSecond, MinNanosecond, f.timezones(timezone)...)
+	if !c.HasError() && !isValidISOWeekDate(year, week, day) {
+		c.Error = ErrInvalidISOWeekDate(year, week, day)
+	}
+	return f.apply(c, true)
+}
+
+// CreateFromTime creates a Carbon instance from a given time(year, month and day are taken from the current time by the clock of the Factory instance).
+func (f *Factory) CreateFromTime(hour, minute, second int, timezone ...string) *Carbon {
+	year, month, day := f.Now(timezone...).Date()
+	return f.apply(createByPolicy(f.DSTPolicy(), year, month, day, hour, minute, second, MinNanosecond, f.timezones(timezone)...), true)
+}
+
+// CreateFromTimeMilli creates a Carbon instance from a given time and millisecond.
+func (f *Factory) CreateFromTimeMilli(hour, minute, second, millisecond int, timezone ...string) *Carbon {
+	year, month, day := f.Now(timezone...).Date()
+	return f.apply(createByPolicy(f.DSTPolicy(), year, month, day, hour, minute, second, millisecond*1e6, f.timezones(timezone)...), true)
+}
+
+// CreateFromTimeMicro creates a Carbon instance from a given time and microsecond.
+func (f *Factory) CreateFromTimeMicro(hour, minute, second, microsecond int, timezone ...string) *Carbon {
+	year, month, day := f.Now(timezone...).Date()
+	return f.apply(createByPolicy(f.DSTPolicy(), year, month, day, hour, minute, second, microsecond*1e3, f.timezones(timezone)...), true)
+}
+
+// CreateFromTimeNano creates a Carbon instance from a given time and nanosecond.
+func (f *Factory) CreateFromTimeNano(hour, minute, second, nanosecond int, timezone ...string) *Carbon {
+	year, month, day := f.Now(timezone...).Date()
+	return f.apply(createByPolicy(f.DSTPolicy(), year, month, day, hour, minute, second, nanosecond, f.timezones(timezone)...), true)
+}
+
+// Parse parses a time string as a Carbon instance by default layouts.
+func (f *Factory) Parse(value string, timezone ...string) *Carbon {
+	switch value {
+	case "now":
+		return f.Now(timezone...)
+	case "yesterday":
+		return f.Yesterday(timezone...)
+	case "tomorrow":
+		return f.Tomorrow(timezone...)
+	}
+	return f.apply(Parse(value, f.timezones(timezone)...), false)
+}
+
+// ParseByLayout parses a time string as a Carbon instance by a confirmed layout.
+func (f *Factory) ParseByLayout(value, layout string, timezone ...string) *Carbon {
+	return f.apply(ParseByLayout(value, layout, f.timezones(timezone)...), false)
+}
+
+// ParseByFormat parses a time string as a Carbon instance by a confirmed format.
+func (f *Factory) ParseByFormat(value, format string, timezone ...string) *Carbon {
+	return f.apply(ParseByFormat(value, format, f.timezones(timezone)...), false)
+}
+
+// ParseWith parses a time string as a Carbon instance by a pattern of the dialect.
+func (f *Factory) ParseWith(dialect Dialect, value, pattern string, timezone ...string) *Carbon {
+	return f.apply(ParseWith(dialect, value, pattern, f.timezones(timezone)...), false)
+}
+
+// ParseDetailed parses a time string and reports every matching layout, the timezone, locale and base of
+// the hints default to the timezone, locale and now by the clock of the Factory instance.
+func (f *Factory) ParseDetailed(value string, hints ...ParseHints) *ParseDetail {
+	if err := f.err(); err != nil {
+		return &ParseDetail{Carbon: &Carbon{Error: err}, Error: err}
+	}
+	var h ParseHints
+	if len(hints) > 0 {
+		h = hints[0]
+	}
+	if h.Timezone == "" {
+		h.Timezone = f.Timezone()
+	}
+	if h.Locale == "" && f.lang != nil {
+		h.Locale = f.lang.locale
+	}
+	if h.Base == nil {
+		h.Base = f.Now(h.Timezone)
+	}
+	d := ParseDetailed(value, h)
+	d.Carbon = f.apply(d.Carbon, false)
+	for _, candidate := range d.Candidates {
+		f.apply(candidate.Carbon, false)
+	}
+	return d
+}
+
+// ParseByLayouts parses a time string as a Carbon instance by multiple fuzzy layouts.
+func (f *Factory) ParseByLayouts(value string, layouts []string, timezone ...string) *Carbon {
+	return f.apply(ParseByLayouts(value, layouts, f.timezones(timezone)...), false)
+}
+
+// ParseByFormats parses a time string as a Carbon instance by multiple fuzzy formats.
+func (f *Factory) ParseByFormats(value string, formats []string, timezone ...string) *Carbon {
+	return f.apply(ParseByFormats(value, formats, f.timezones(timezone)...), false)
+}
+
+// ParseRelative parses a relative time expression as a Carbon instance relative to base,
+// which defaults to now by the clock of the Factory instance, localized expressions follow the locale of the Factory instance.
+func (f *Factory) ParseRelative(expr string, base ...*Carbon) *Carbon {
+	if err := f.err(); err != nil {
+		return &Carbon{Error: err}
+	}
+	if len(base) > 0 {
+		return ParseRelative(expr, base...)
+	}
+	return ParseRelative(expr, f.Now())
+}
+
+// CreateFromLunar creates a Carbon instance from Lunar date, meridian is lunar.ChinaMeridian by default.
+func (f *Factory) CreateFromLunar(year, month, day int, isLeapMonth bool, meridian ...lunar.Meridian) *Carbon {
+	l := lunar.NewLunar(year, month, day, isLeapMonth, meridian...)
+	if l.Error != nil {
+		return &Carbon{Error: l.Error}
+	}
+	return f.apply(NewCarbon(l.ToGregorian(f.Timezone()).Time), true)
+}
+
+// CreateFromJulian creates a Carbon instance from Julian Day or Modified Julian Day.
+func (f *Factory) CreateFromJulian(j float64) *Carbon {
+	return f.apply(NewCarbon(julian.NewJulian(j).ToGregorian(f.Timezone()).Time), true)
+}
+
+// CreateFromPersian creates a Carbon instance from Persian date.
+func (f *Factory) CreateFromPersian(year, month, day int) *Carbon {
+	p := persian.NewPersian(year, month, day)
+	if p.Error != nil {
+		return &Carbon{Error: p.Error}
+	}
+	return f.apply(NewCarbon(p.ToGregorian(f.Timezone()).Time), true)
+}
+
+// CreateFromHebrew creates a Carbon instance from Hebrew date.
+func (f *Factory) CreateFromHebrew(year, month, day int) *Carbon {
+	h := hebrew.NewHebrew(year, month, day)
+	if h.Error != nil {
+		return &Carbon{Error: h.Error}
+	}
+	return f.apply(NewCarbon(h.ToGregorian(f.Timezone()).Time), true)
+}
+
+// CreateFromHijri creates a Carbon instance from Hijri date, variant is hijri.Tabular by default.
+func (f *Factory) CreateFromHijri(year, month, day int, variant ...hijri.Variant) *Carbon {
+	h := hijri.NewHijri(year, month, day, variant...)
+	if h.Error != nil {
+		return &Carbon{Error: h.Error}
+	}
+	return f.apply(NewCarbon(h.ToGregorian(f.Timezone()).Time), true)
+}
+
+// CreateFromEthiopian creates a Carbon instance from Ethiopian date, era is ethiopian.AmeteMihret by default.
+func (f *Factory) CreateFromEthiopian(year, month, day int, era ...ethiopian.Era) *Carbon {
+	e := ethiopian.NewEthiopian(year, month, day, era...)
+	if e.Error != nil {
+		return &Carbon{Error: e.Error}
+	}
+	return f.apply(NewCarbon(e.ToGregorian(f.Timezone()).Time), true)
+}
+
+// CreateFromCoptic creates a Carbon instance from Coptic date.
+func (f *Factory) CreateFromCoptic(year, month, day int) *Carbon {
+	c := coptic.NewCoptic(year, month, day)
+	if c.Error != nil {
+		return &Carbon{Error: c.Error}
+	}
+	return f.apply(NewCarbon(c.ToGregorian(f.Timezone()).Time), true)
+}
+
+// CreateFromCalendar creates a Carbon instance from the date of the calendar registered by the name.
+func (f *Factory) CreateFromCalendar(name string, year, month, day int) *Carbon {
+	_, creator, ok := calendar.Lookup(name)
+	if !ok {
+		return &Carbon{Error: ErrNotExistCalendar(name)}
+	}
+	cal, err := creator(year, month, day)
+	if err != nil {
+		return &Carbon{Error: err}
+	}
+	return f.apply(NewCarbon(cal.ToGregorian(f.Timezone()).Time), true)
+}
+
+// CreateFromSolarTerm creates a Carbon instance at the exact moment of the solar term in the gregorian year,
+// name can be chinese, english or localized by the locale of the Factory instance.
+func (f *Factory) CreateFromSolarTerm(year int, name string) *Carbon {
+	if err := f.err(); err != nil {
+		return &Carbon{Error: err}
+	}
+	s := newSolarTerm(f.lang, year, name)
+	if s.Error != nil {
+		return &Carbon{Error: s.Error}
+	}
+	return f.apply(NewCarbon(s.ToGregorian(f.Timezone()).Time), true)
+}
+
+// timezones returns the given timezone, or the timezone of the Factory instance if there isn't any.
+func (f *Factory) timezones(timezone []string) []string {
+	if len(timezone) > 0 || f == nil {
+		return timezone
+	}
+	return []string{f.timezone}
+}
+
+// getClock returns the clock of the Factory instance, nil means the default clock.
+func (f *Factory) getClock() Clock {
+	if f == nil {
+		return nil
+	}
+	return f.clock
+}
+
+// err returns the error of the Factory instance, or ErrNilFactory if it's nil.
+func (f *Factory) err() error {
+	if f == nil {
+		return ErrNilFactory()
+	}
+	return f.Error
+}
+
+// apply applies the settings of the Factory instance to the Carbon instance,
+// the layout is replaced only if the Carbon instance isn't parsed by a specific layout.
+func (f *Factory) apply(c *Carbon, withLayout bool) *Carbon {
+	if err := f.err(); err != nil {
+		return &Carbon{Error: err}
+	}
+	if c.IsNil() || c.isEmpty || c.HasError() {
+		return c
+	}
+	c.lang = f.lang.Copy()
+	c.weekStartsAt = f.weekStartsAt
+	c.weekendDays = append([]Weekday(nil), f.weekendDays...)
+	c.clock = f.clock
+	if withLayout {
+		c.currentLayout = f.layout
+	}
+	return c
+}
@@ -0,0 +1,137 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+)
+
+func BenchmarkNewFactory(b *testing.B) {
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			NewFactory(Default{Timezone: PRC, Locale: "zh-CN"})
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				NewFactory(Default{Timezone: PRC, Locale: "zh-CN"})
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				NewFactory(Default{Timezone: PRC, Locale: "zh-CN"})
+			}
+		})
+	})
+}
+
+func BenchmarkFactory_Now(b *testing.B) {
+	f := NewFactory(Default{Timezone: PRC, Locale: "zh-CN"})
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			f.Now()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				f.Now()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				f.Now()
+			}
+		})
+	})
+}
+
+func BenchmarkFactory_Parse(b *testing.B) {
+	f := NewFactory(Default{Timezone: PRC, Locale: "zh-CN"})
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			f.Parse("2020-08-05 13:14:15")
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				f.Parse("2020-08-05 13:14:15")
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				f.Parse("2020-08-05 13:14:15")
+			}
+		})
+	})
+}
+
+func BenchmarkFactory_CreateFromDate(b *testing.B) {
+	f := NewFactory(Default{Timezone: PRC, Locale: "zh-CN"})
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			f.CreateFromDate(2020, 8, 5)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				f.CreateFromDate(2020, 8, 5)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				f.CreateFromDate(2020, 8, 5)
+			}
+		})
+	})
+}
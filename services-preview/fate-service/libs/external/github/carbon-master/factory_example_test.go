@@ -0,0 +1,41 @@
+package carbon_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func ExampleNewFactory() {
+	shanghai := carbon.NewFactory(carbon.Default{Timezone: carbon.PRC, Locale: "zh-CN", WeekStartsAt: carbon.Monday})
+	tehran := carbon.NewFactory(carbon.Default{Timezone: "Asia/Tehran", Locale: "fa", WeekStartsAt: carbon.Saturday})
+
+	fmt.Println(shanghai.Parse("2020-08-05 13:14:15").ToString())
+	fmt.Println(shanghai.Parse("2020-08-05 13:14:15").ToMonthString())
+	fmt.Println(shanghai.Parse("2020-08-05 13:14:15").StartOfWeek().ToDateString())
+
+	fmt.Println(tehran.Parse("2020-08-05 13:14:15").ToString())
+	fmt.Println(tehran.Parse("2020-08-05 13:14:15").StartOfWeek().ToDateString())
+
+	// Output:
+	// 2020-08-05 13:14:15 +0800 CST
+	// 八月
+	// 2020-08-03
+	// 2020-08-05 13:14:15 +0430 +0430
+	// 2020-08-01
+}
+
+func ExampleFactory_WithClock() {
+	f := carbon.NewFactory(carbon.Default{Timezone: carbon.PRC, WeekStartsAt: carbon.Monday})
+	f = f.WithClock(carbon.NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC)))
+
+	fmt.Println(f.Now().ToString())
+	fmt.Println(f.CreateFromTime(9, 0, 0).ToString())
+	fmt.Println(f.Parse("2020-08-05").IsToday())
+
+	// Output:
+	// 2020-08-05 21:14:15 +0800 CST
+	// 2020-08-05 09:00:00 +0800 CST
+	// true
+}
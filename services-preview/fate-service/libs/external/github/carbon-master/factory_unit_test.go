@@ -0,0 +1,429 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/dromara/carbon/v2/calendar/ethiopian"
+	"github.com/dromara/carbon/v2/calendar/lunar"
+)
+
+type FactorySuite struct {
+	suite.Suite
+}
+
+func TestFactorySuite(t *testing.T) {
+	suite.Run(t, new(FactorySuite))
+}
+
+func (s *FactorySuite) TearDownTest() {
+	ClearTestNow()
+	ResetDefault()
+}
+
+func (s *FactorySuite) TestNewFactory() {
+	s.Run("empty default", func() {
+		f := NewFactory(Default{})
+		s.Nil(f.Error)
+		s.Equal(DateTimeLayout, f.Layout())
+		s.Equal(UTC, f.Timezone())
+		s.Equal("en", f.Locale())
+		s.Equal(Monday, f.WeekStartsAt())
+		s.Equal([]Weekday{Saturday, Sunday}, f.WeekendDays())
+	})
+
+	s.Run("valid default", func() {
+		f := NewFactory(Default{
+			Layout:       DateLayout,
+			Timezone:     PRC,
+			Locale:       "zh-CN",
+			WeekStartsAt: Saturday,
+			WeekendDays:  []Weekday{Friday, Saturday},
+		})
+		s.Nil(f.Error)
+		s.Equal(DateLayout, f.Layout())
+		s.Equal(PRC, f.Timezone())
+		s.Equal("zh-CN", f.Locale())
+		s.Equal(Saturday, f.WeekStartsAt())
+		s.Equal([]Weekday{Friday, Saturday}, f.WeekendDays())
+	})
+
+	s.Run("follow package-level defaults", func() {
+		SetDefault(Default{Timezone: Tokyo, Locale: "ja", WeekStartsAt: Saturday})
+		f := NewFactory(Default{})
+		ResetDefault()
+		s.Equal(Saturday, f.WeekStartsAt())
+		s.Equal(Tokyo, f.Timezone())
+		s.Equal("ja", f.Locale())
+		s.Equal(Tokyo, f.Now().Timezone())
+	})
+
+	s.Run("error timezone", func() {
+		f := NewFactory(Default{Timezone: "xxx"})
+		s.Error(f.Error)
+		s.Error(f.Now().Error)
+		s.Error(f.Parse("2020-08-05").Error)
+		s.Error(f.ParseDetailed("2020-08-05").Error)
+	})
+
+	s.Run("error locale", func() {
+		f := NewFactory(Default{Locale: "xxx"})
+		s.Error(f.Error)
+		s.Error(f.CreateFromDate(2020, 8, 5).Error)
+		s.Error(f.ParseRelative("tomorrow").Error)
+	})
+
+	s.Run("error week starts at", func() {
+		f := NewFactory(Default{WeekStartsAt: 7})
+		s.Equal(ErrInvalidWeekday(7), f.Error)
+		s.Error(f.Now().Error)
+	})
+
+	s.Run("error dst policy", func() {
+		f := NewFactory(Default{DSTPolicy: "xxx"})
+		s.Equal(ErrInvalidDSTPolicy("xxx"), f.Error)
+		s.Error(f.CreateFromDateTime(2020, 8, 5, 13, 14, 15).Error)
+	})
+
+	s.Run("dst policy", func() {
+		s.Empty(NewFactory(Default{}).DSTPolicy())
+		s.Equal(DSTError, NewFactory(Default{DSTPolicy: DSTError}).DSTPolicy())
+
+		SetDefault(Default{DSTPolicy: DSTLater})
+		f := NewFactory(Default{})
+		ResetDefault()
+		s.Equal(DSTLater, f.DSTPolicy())
+	})
+
+	s.Run("immutable", func() {
+		weekendDays := []Weekday{Friday, Saturday}
+		f := NewFactory(Default{WeekendDays: weekendDays})
+		weekendDays[0] = Monday
+		f.WeekendDays()[1] = Monday
+		s.Equal([]Weekday{Friday, Saturday}, f.WeekendDays())
+
+		c := f.CreateFromDate(2020, 8, 5)
+		c.SetLanguage(NewLanguage().SetLocale("zh-CN"))
+		c.SetWeekendDays([]Weekday{Sunday})
+		s.Equal("en", f.Locale())
+		s.Equal("en", f.CreateFromDate(2020, 8, 5).Locale())
+		s.Equal([]Weekday{Friday, Saturday}, f.CreateFromDate(2020, 8, 5).weekendDays)
+	})
+
+	s.Run("not affect package-level defaults", func() {
+		NewFactory(Default{Layout: DateLayout, Timezone: PRC, Locale: "zh-CN", WeekStartsAt: Saturday})
+		s.Equal(DateTimeLayout, DefaultLayout)
+		s.Equal(UTC, DefaultTimezone)
+		s.Equal("en", DefaultLocale)
+		s.Equal(Monday, DefaultWeekStartsAt)
+	})
+}
+
+func (s *FactorySuite) TestFactory_WithClock() {
+	f := NewFactory(Default{Timezone: PRC, WeekStartsAt: Monday})
+	clock := NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+
+	s.Run("nil factory", func() {
+		var f *Factory
+		s.Nil(f.WithClock(clock))
+	})
+
+	s.Run("valid clock", func() {
+		g := f.WithClock(clock)
+		s.Equal("2020-08-05 21:14:15 +0800 CST", g.Now().ToString())
+		s.Equal("2020-08-04 21:14:15 +0800 CST", g.Yesterday().ToString())
+		s.Equal("2020-08-06 21:14:15 +0800 CST", g.Tomorrow().ToString())
+		s.Equal("2020-08-05 13:14:15 +0000 UTC", g.Now(UTC).ToString())
+		s.True(g.Parse("2020-08-05 08:00:00").IsToday())
+		s.Nil(f.clock)
+	})
+
+	s.Run("nil clock", func() {
+		SetTestNow(Parse("2020-08-05 13:14:15"))
+		s.Equal("2020-08-05 21:14:15 +0800 CST", f.WithClock(nil).Now().ToString())
+	})
+}
+
+func (s *FactorySuite) TestFactory_WithWeekStartsAt() {
+	f := NewFactory(Default{})
+
+	s.Run("nil factory", func() {
+		var f *Factory
+		s.Nil(f.WithWeekStartsAt(Sunday))
+	})
+
+	s.Run("invalid weekday", func() {
+		s.Equal(ErrInvalidWeekday(-1), f.WithWeekStartsAt(-1).Error)
+		s.Error(f.WithWeekStartsAt(7).CreateFromDate(2020, 8, 5).Error)
+	})
+
+	s.Run("valid weekday", func() {
+		g := f.WithWeekStartsAt(Sunday)
+		s.Equal(Sunday, g.WeekStartsAt())
+		s.Equal(Sunday, g.CreateFromDate(2020, 8, 5).WeekStartsAt())
+		s.Equal(Monday, f.WeekStartsAt())
+	})
+}
+
+func (s *FactorySuite) TestFactory_Nil() {
+	var f *Factory
+	s.Equal(ErrNilFactory(), f.NewCarbon().Error)
+	s.Equal(ErrNilFactory(), f.Now().Error)
+	s.Equal(ErrNilFactory(), f.CreateFromDateTime(2020, 8, 5, 13, 14, 15).Error)
+	s.Equal(ErrNilFactory(), f.CreateFromTime(13, 14, 15).Error)
+	s.Equal(ErrNilFactory(), f.CreateFromLunar(2020, 6, 16, false).Error)
+	s.Equal(ErrNilFactory(), f.CreateFromSolarTerm(2020, "立春").Error)
+	s.Equal(ErrNilFactory(), f.Parse("2020-08-05").Error)
+	s.Equal(ErrNilFactory(), f.ParseDetailed("2020-08-05").Error)
+	s.Equal(ErrNilFactory(), f.ParseRelative("tomorrow").Error)
+}
+
+func (s *FactorySuite) TestFactory_Getters() {
+	var f *Factory
+	s.Empty(f.Layout())
+	s.Empty(f.Timezone())
+	s.Empty(f.Locale())
+	s.Zero(f.WeekStartsAt())
+	s.Nil(f.WeekendDays())
+	s.Empty(f.DSTPolicy())
+}
+
+func (s *FactorySuite) TestFactory_NewCarbon() {
+	f := NewFactory(Default{Layout: DateLayout, Timezone: PRC, Locale: "zh-CN"}).WithWeekStartsAt(Sunday)
+
+	s.Run("without time", func() {
+		c := f.NewCarbon()
+		s.True(c.IsZero())
+		s.Equal(PRC, c.Timezone())
+		s.Equal("zh-CN", c.Locale())
+		s.Equal(Sunday, c.WeekStartsAt())
+		s.Equal(DateLayout, c.CurrentLayout())
+	})
+
+	s.Run("with time", func() {
+		c := f.NewCarbon(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+		s.Equal("2020-08-05", c.String())
+		s.Equal(UTC, c.Timezone())
+		s.Equal("zh-CN", c.Locale())
+	})
+}
+
+func (s *FactorySuite) TestFactory_Creator() {
+	f := NewFactory(Default{Layout: DateTimeMilliLayout, Timezone: PRC, Locale: "zh-CN", WeekendDays: []Weekday{Friday, Saturday}}).WithWeekStartsAt(Sunday)
+	clock := NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+	g := f.WithClock(clock)
+
+	s.Run("with factory settings", func() {
+		for _, c := range []*Carbon{
+			g.Now(), g.Tomorrow(), g.Yesterday(),
+			f.CreateFromStdTime(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC), PRC),
+			f.CreateFromTimestamp(1596604455),
+			f.CreateFromTimestampMilli(1596604455000),
+			f.CreateFromTimestampMicro(1596604455000000),
+			f.CreateFromTimestampNano(1596604455000000000),
+			f.CreateFromDateTime(2020, 8, 5, 13, 14, 15),
+			f.CreateFromDateTimeMilli(2020, 8, 5, 13, 14, 15, 999),
+			f.CreateFromDateTimeMicro(2020, 8, 5, 13, 14, 15, 999999),
+			f.CreateFromDateTimeNano(2020, 8, 5, 13, 14, 15, 999999999),
+			f.CreateFromDate(2020, 8, 5),
+			f.CreateFromDateMilli(2020, 8, 5, 999),
+			f.CreateFromDateMicro(2020, 8, 5, 999999),
+			f.CreateFromDateNano(2020, 8, 5, 999999999),
+			f.CreateFromISOWeekDate(2020, 32, 3),
+			g.CreateFromTime(13, 14, 15),
+			g.CreateFromTimeMilli(13, 14, 15, 999),
+			g.CreateFromTimeMicro(13, 14, 15, 999999),
+			g.CreateFromTimeNano(13, 14, 15, 999999999),
+			f.CreateFromLunar(2020, 6, 16, false),
+			f.CreateFromJulian(2459067),
+			f.CreateFromPersian(1399, 5, 15),
+			f.CreateFromHebrew(5780, 5, 15),
+			f.CreateFromHijri(1441, 12, 15),
+			f.CreateFromEthiopian(2012, 11, 29),
+			f.CreateFromCoptic(1736, 11, 29),
+			f.CreateFromCalendar("persian", 1399, 5, 15),
+			f.CreateFromSolarTerm(2020, "白露"),
+		} {
+			s.Nil(c.Error)
+			s.Equal(PRC, c.Timezone())
+			s.Equal("zh-CN", c.Locale())
+			s.Equal(Sunday, c.WeekStartsAt())
+			s.Equal(DateTimeMilliLayout, c.CurrentLayout())
+			s.True(c.IsWeekday())
+		}
+	})
+
+	s.Run("valid value", func() {
+		s.Equal("2020-08-05 13:14:15 +0800 CST", f.CreateFromDateTime(2020, 8, 5, 13, 14, 15).ToString())
+		s.Equal("2020-08-05 13:14:15 +0000 UTC", f.CreateFromDateTime(2020, 8, 5, 13, 14, 15, UTC).ToString())
+		s.Equal("2020-08-05 13:14:15 +0000 UTC", f.CreateFromStdTime(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC)).ToString())
+		s.Equal("2020-08-05 21:14:15 +0800 CST", f.CreateFromTimestamp(1596633255).ToString())
+		s.Equal("2020-08-05 09:10:11 +0800 CST", g.CreateFromTime(9, 10, 11).ToString())
+		s.Equal("2020-08-05 09:10:11.999 +0800 CST", g.CreateFromTimeMilli(9, 10, 11, 999).ToString())
+		s.Equal("2020-08-05 09:10:11.999999 +0800 CST", g.CreateFromTimeMicro(9, 10, 11, 999999).ToString())
+		s.Equal("2020-08-05 09:10:11.999999999 +0800 CST", g.CreateFromTimeNano(9, 10, 11, 999999999).ToString())
+		s.Equal("2020-08-05 00:00:00 +0800 CST", f.CreateFromLunar(2020, 6, 16, false).ToString())
+		s.Equal("2020-08-05 01:00:00 +0800 CST", f.CreateFromLunar(2020, 6, 16, false, lunar.VietnamMeridian).ToString())
+		s.Equal("2020-08-04 23:00:00 +0800 CST", f.CreateFromLunar(2020, 6, 16, false, lunar.KoreaMeridian).ToString())
+		s.Equal("2020-08-05 00:00:00 +0800 CST", f.CreateFromPersian(1399, 5, 15).ToString())
+		s.Equal(CreateFromHebrew(5780, 5, 15).ToDateTimeString(), f.CreateFromHebrew(5780, 5, 15).ToDateTimeString())
+		s.Equal("2020-08-05 00:00:00 +0800 CST", f.CreateFromHijri(1441, 12, 15).ToString())
+		s.Equal("2020-08-05 00:00:00 +0800 CST", f.CreateFromEthiopian(2012, 11, 29).ToString())
+		s.Equal("2020-08-05 00:00:00 +0800 CST", f.CreateFromEthiopian(7512, 11, 29, ethiopian.AmeteAlem).ToString())
+		s.Equal("2020-08-05 00:00:00 +0800 CST", f.CreateFromCoptic(1736, 11, 29).ToString())
+		s.Equal("2020-08-05 00:00:00 +0800 CST", f.CreateFromCalendar("persian", 1399, 5, 15).ToString())
+		s.Equal("2020-08-05 12:00:00 +0800 CST", f.CreateFromJulian(2459067).ToString())
+		s.Equal("2020-08-07 09:06:11 +0800 CST", f.CreateFromSolarTerm(2020, "立秋").ToString())
+		s.Equal("2020-08-07 09:06:11 +0800 CST", f.CreateFromSolarTerm(2020, "Start of Autumn").ToString())
+	})
+
+	s.Run("error value", func() {
+		s.Error(f.CreateFromDate(2020, 8, 5, "xxx").Error)
+		s.Error(f.CreateFromTime(13, 14, 15, "xxx").Error)
+		s.Error(f.CreateFromLunar(10000, 12, 14, false).Error)
+		s.Error(f.CreateFromPersian(1399, 13, 1).Error)
+		s.Error(f.CreateFromHebrew(5780, 14, 1).Error)
+		s.Error(f.CreateFromHijri(1441, 13, 1).Error)
+		s.Error(f.CreateFromEthiopian(2016, 13, 6).Error)
+		s.Error(f.CreateFromCoptic(1740, 13, 6).Error)
+		s.Error(f.CreateFromCalendar("persian", 1399, 13, 1).Error)
+		s.Error(f.CreateFromCalendar("xxx", 2020, 8, 5).Error)
+		s.Error(f.CreateFromSolarTerm(2020, "xxx").Error)
+	})
+}
+
+func (s *FactorySuite) TestFactory_DSTPolicy() {
+	s.Run("earlier policy", func() {
+		f := NewFactory(Default{Timezone: PRC, DSTPolicy: DSTEarlier})
+		s.Equal("1986-09-14 01:30:00 +0900 CDT", f.CreateFromDateTime(1986, 9, 14, 1, 30, 0).ToString())
+		s.Equal("1986-05-04 01:30:00 +0800 CST", f.CreateFromDateTime(1986, 5, 4, 2, 30, 0).ToString())
+		s.Equal("1986-09-14 01:30:00.999 +0900 CDT", f.CreateFromDateTimeMilli(1986, 9, 14, 1, 30, 0, 999).ToString())
+		s.Equal("1986-09-14 01:30:00.999999 +0900 CDT", f.CreateFromDateTimeMicro(1986, 9, 14, 1, 30, 0, 999999).ToString())
+		s.Equal("1986-09-14 01:30:00.999999999 +0900 CDT", f.CreateFromDateTimeNano(1986, 9, 14, 1, 30, 0, 999999999).ToString())
+		// midnight is skipped on 1940-06-01 in PRC
+		s.Equal("1940-05-31 23:00:00 +0800 CST", f.CreateFromDate(1940, 6, 1).ToString())
+		s.Equal("1940-05-31 23:00:00.999 +0800 CST", f.CreateFromDateMilli(1940, 6, 1, 999).ToString())
+		s.Equal("1940-05-31 23:00:00.999999 +0800 CST", f.CreateFromDateMicro(1940, 6, 1, 999999).ToString())
+		s.Equal("1940-05-31 23:00:00.999999999 +0800 CST", f.CreateFromDateNano(1940, 6, 1, 999999999).ToString())
+		// the 1st day of the 23rd ISO week of 1940 is 1940-06-03
+		s.Equal("1940-06-03 00:00:00 +0900 CDT", f.CreateFromISOWeekDate(1940, 23, 1).ToString())
+		s.Equal(ErrInvalidISOWeekDate(1941, 53, 1), f.CreateFromISOWeekDate(1941, 53, 1).Error)
+	})
+
+	s.Run("later policy", func() {
+		f := NewFactory(Default{Timezone: "America/New_York", DSTPolicy: DSTLater})
+		s.Equal("2020-11-01 01:30:00 -0500 EST", f.CreateFromDateTime(2020, 11, 1, 1, 30, 0).ToString())
+		s.Equal("2020-03-08 03:30:00 -0400 EDT", f.CreateFromDateTime(2020, 3, 8, 2, 30, 0).ToString())
+	})
+
+	s.Run("error policy", func() {
+		f := NewFactory(Default{Timezone: PRC, DSTPolicy: DSTError})
+		s.Equal(ErrAmbiguousTime("1986-09-14 01:30:00", PRC), f.CreateFromDateTime(1986, 9, 14, 1, 30, 0).Error)
+		s.Equal(ErrNonExistentTime("1986-05-04 02:30:00", PRC), f.CreateFromDateTime(1986, 5, 4, 2, 30, 0).Error)
+		s.Equal("1986-08-05 13:14:15 +0900 CDT", f.CreateFromDateTime(1986, 8, 5, 13, 14, 15).ToString())
+
+		clock := NewFixedClock(time.Date(1986, 9, 13, 20, 0, 0, 0, time.UTC))
+		g := f.WithClock(clock)
+		s.Error(g.CreateFromTime(1, 30, 0).Error)
+		s.Error(g.CreateFromTimeMilli(1, 30, 0, 999).Error)
+		s.Error(g.CreateFromTimeMicro(1, 30, 0, 999999).Error)
+		s.Error(g.CreateFromTimeNano(1, 30, 0, 999999999).Error)
+		s.Equal("1986-09-14 13:14:15 +0800 CST", g.CreateFromTime(13, 14, 15).ToString())
+	})
+}
+
+func (s *FactorySuite) TestFactory_Parser() {
+	f := NewFactory(Default{Layout: DateLayout, Timezone: PRC, Locale: "zh-CN"}).WithWeekStartsAt(Sunday)
+	clock := NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+	g := f.WithClock(clock)
+
+	s.Run("empty value", func() {
+		s.True(f.Parse("").IsEmpty())
+		s.True(f.ParseByLayout("", DateLayout).IsEmpty())
+		s.True(f.ParseByFormat("", DateFormat).IsEmpty())
+		s.True(f.ParseByLayouts("", []string{DateLayout}).IsEmpty())
+		s.True(f.ParseByFormats("", []string{DateFormat}).IsEmpty())
+		s.True(f.ParseWith(StrftimeDialect, "", "%Y-%m-%d").IsEmpty())
+		s.True(f.ParseDetailed("").Carbon.IsEmpty())
+		s.True(f.ParseRelative("").IsEmpty())
+	})
+
+	s.Run("error value", func() {
+		s.Error(f.Parse("xxx").Error)
+		s.Error(f.ParseByLayout("xxx", DateLayout).Error)
+		s.Error(f.ParseByFormat("xxx", DateFormat).Error)
+		s.Error(f.ParseByLayouts("xxx", []string{DateLayout}).Error)
+		s.Error(f.ParseByFormats("xxx", []string{DateFormat}).Error)
+		s.Error(f.ParseWith(StrftimeDialect, "xxx", "%Y-%m-%d").Error)
+		s.Error(f.ParseDetailed("xxx").Error)
+		s.Error(f.ParseRelative("xxx").Error)
+	})
+
+	s.Run("valid value", func() {
+		c := f.Parse("2020-08-05 13:14:15")
+		s.Equal("2020-08-05 13:14:15 +0800 CST", c.ToString())
+		s.Equal(DateTimeLayout, c.CurrentLayout())
+		s.Equal("zh-CN", c.Locale())
+		s.Equal(Sunday, c.WeekStartsAt())
+
+		s.Equal("2020-08-05 13:14:15 +0000 UTC", f.Parse("2020-08-05 13:14:15", UTC).ToString())
+		s.Equal("2020-08-05 13:14:15 +0800 CST", f.ParseByLayout("2020-08-05 13:14:15", DateTimeLayout).ToString())
+		s.Equal("2020-08-05 13:14:15 +0800 CST", f.ParseByFormat("2020-08-05 13:14:15", DateTimeFormat).ToString())
+		s.Equal("2020-08-05 13:14:15 +0800 CST", f.ParseByLayouts("2020-08-05 13:14:15", []string{DateLayout, DateTimeLayout}).ToString())
+		s.Equal("2020-08-05 13:14:15 +0800 CST", f.ParseByFormats("2020-08-05 13:14:15", []string{DateFormat, DateTimeFormat}).ToString())
+		s.Equal("zh-CN", f.ParseByFormat("2020-08-05", DateFormat).Locale())
+		s.Equal("2020-08-05 13:14:15 +0800 CST", f.ParseWith(ICUDialect, "2020-08-05 13:14:15", "yyyy-MM-dd HH:mm:ss").ToString())
+		s.Equal("zh-CN", f.ParseWith(MomentDialect, "2020-08-05", "YYYY-MM-DD").Locale())
+
+		d := f.ParseDetailed("03/04/2020")
+		s.True(d.IsAmbiguous)
+		s.Equal("2020-03-04 00:00:00 +0800 CST", d.Carbon.ToString())
+		s.Equal("zh-CN", d.Carbon.Locale())
+		s.Equal(Sunday, d.Candidates[1].Carbon.WeekStartsAt())
+		s.Equal("2020-04-03 00:00:00 +0800 CST", f.ParseDetailed("03/04/2020", ParseHints{Locale: "fr"}).Carbon.ToString())
+		s.Equal("2020-09-24 00:00:00 +0800 CST", g.ParseDetailed("农历八月初八").Carbon.ToString())
+	})
+
+	s.Run("keyword value", func() {
+		s.Equal("2020-08-05", g.Parse("now").String())
+		s.Equal("2020-08-04", g.Parse("yesterday").String())
+		s.Equal("2020-08-06", g.Parse("tomorrow").String())
+	})
+
+	s.Run("relative value", func() {
+		s.Equal("2020-08-06 00:00:00 +0800 CST", g.ParseRelative("明天").ToString())
+		s.Equal("2020-08-08 21:14:15 +0800 CST", g.ParseRelative("三天后").ToString())
+		s.Equal("2020-08-06 00:00:00 +0800 CST", g.ParseRelative("tomorrow", f.Parse("2020-08-05")).ToString())
+		s.Equal("1 天前", g.ParseRelative("昨天").DiffForHumans())
+	})
+}
+
+func (s *FactorySuite) TestFactory_Concurrent() {
+	shanghai := NewFactory(Default{Timezone: PRC, Locale: "zh-CN", WeekStartsAt: Monday})
+	tehran := NewFactory(Default{Timezone: "Asia/Tehran", Locale: "fa", WeekStartsAt: Saturday, WeekendDays: []Weekday{Friday}})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			c := shanghai.Parse("2020-08-05 13:14:15")
+			s.Equal("2020-08-05 13:14:15 +0800 CST", c.ToString())
+			s.Equal("zh-CN", c.Locale())
+			s.Equal("8月", c.ToShortMonthString())
+			s.Equal("2020-08-03", c.StartOfWeek().ToDateString())
+		}()
+		go func() {
+			defer wg.Done()
+			c := tehran.Parse("2020-08-05 13:14:15")
+			s.Equal("2020-08-05 13:14:15 +0430 +0430", c.ToString())
+			s.Equal("fa", c.Locale())
+			s.Equal("2020-08-01", c.StartOfWeek().ToDateString())
+			s.True(c.SetDay(7).IsWeekend())
+		}()
+	}
+	wg.Wait()
+}
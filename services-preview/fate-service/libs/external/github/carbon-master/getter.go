@@ -77,6 +77,36 @@ func (c *Carbon) WeekOfYear() int {
 	return week
 }
 
+// ISOYear gets the ISO 8601 week-numbering year like 2020, which may differ from Year around New Year.
+//
+// refer to https://en.wikipedia.org/wiki/ISO_week_date.
+func (c *Carbon) ISOYear() int {
+	if c.IsInvalid() {
+		return 0
+	}
+	year, _ := c.StdTime().ISOWeek()
+	return year
+}
+
+// ISOWeek gets the ISO 8601 week number like 32, ranging from 1 to 53.
+//
+// refer to https://en.wikipedia.org/wiki/ISO_week_date.
+func (c *Carbon) ISOWeek() int {
+	if c.IsInvalid() {
+		return 0
+	}
+	_, week := c.StdTime().ISOWeek()
+	return week
+}
+
+// ISOWeekday gets the ISO 8601 day of week like 3, Monday is 1 and Sunday is 7 regardless of WeekStartsAt.
+func (c *Carbon) ISOWeekday() int {
+	if c.IsInvalid() {
+		return 0
+	}
+	return (int(c.StdTime().Weekday())+DaysPerWeek-1)%DaysPerWeek + 1
+}
+
 // WeekOfMonth gets week of month like 1.
 func (c *Carbon) WeekOfMonth() int {
 	if c.IsInvalid() {
@@ -376,6 +406,14 @@ func (c *Carbon) Locale() string {
 	return c.lang.locale
 }
 
+// Clock returns the clock, or the default clock which follows SetTestNow if there isn't any.
+func (c *Carbon) Clock() Clock {
+	if c.IsNil() || c.clock == nil {
+		return frozenClock{}
+	}
+	return c.clock
+}
+
 // WeekStartsAt returns start day of the week.
 func (c *Carbon) WeekStartsAt() Weekday {
 	if c.IsInvalid() {
@@ -405,7 +443,7 @@ func (c *Carbon) Age() int {
 	if c.IsInvalid() {
 		return 0
 	}
-	now := Now().SetLocation(c.loc)
+	now := c.now()
 	if c.Gte(now) {
 		return 0
 	}
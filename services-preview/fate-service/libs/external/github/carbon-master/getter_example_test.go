@@ -98,6 +98,37 @@ func ExampleCarbon_WeekOfYear() {
 	// 13
 }
 
+func ExampleCarbon_ISOYear() {
+	fmt.Println(carbon.Parse("2021-01-03").ISOYear())
+	fmt.Println(carbon.Parse("2021-01-04").ISOYear())
+	fmt.Println(carbon.Parse("2019-12-30").ISOYear())
+
+	// Output:
+	// 2020
+	// 2021
+	// 2020
+}
+
+func ExampleCarbon_ISOWeek() {
+	fmt.Println(carbon.Parse("2021-01-03").ISOWeek())
+	fmt.Println(carbon.Parse("2021-01-04").ISOWeek())
+	fmt.Println(carbon.Parse("2019-12-30").ISOWeek())
+
+	// Output:
+	// 53
+	// 1
+	// 1
+}
+
+func ExampleCarbon_ISOWeekday() {
+	fmt.Println(carbon.Parse("2020-08-03").ISOWeekday())
+	fmt.Println(carbon.Parse("2020-08-09").ISOWeekday())
+
+	// Output:
+	// 1
+	// 7
+}
+
 func ExampleCarbon_WeekOfMonth() {
 	fmt.Println(carbon.Parse("2021-07-01").WeekOfMonth())
 	fmt.Println(carbon.Parse("2021-07-02").WeekOfMonth())
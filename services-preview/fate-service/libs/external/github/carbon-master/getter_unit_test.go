@@ -240,6 +240,101 @@ func (s *GetterSuite) TestCarbon_WeekOfYear() {
 	})
 }
 
+func (s *GetterSuite) TestCarbon_ISOYear() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Zero(c.ISOYear())
+	})
+
+	s.Run("zero carbon", func() {
+		s.Equal(1, NewCarbon().ISOYear())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Zero(Parse("").ISOYear())
+	})
+
+	s.Run("error carbon", func() {
+		s.Zero(Parse("xxx").ISOYear())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal(2020, Parse("2021-01-03").ISOYear())
+		s.Equal(2021, Parse("2021-01-04").ISOYear())
+		s.Equal(2020, Parse("2019-12-30").ISOYear())
+		s.Equal(2015, Parse("2016-01-03").ISOYear())
+		s.Equal(2026, Parse("2026-12-31").ISOYear())
+	})
+}
+
+func (s *GetterSuite) TestCarbon_ISOWeek() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Zero(c.ISOWeek())
+	})
+
+	s.Run("zero carbon", func() {
+		s.Equal(1, NewCarbon().ISOWeek())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Zero(Parse("").ISOWeek())
+	})
+
+	s.Run("error carbon", func() {
+		s.Zero(Parse("xxx").ISOWeek())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal(53, Parse("2021-01-03").ISOWeek())
+		s.Equal(1, Parse("2021-01-04").ISOWeek())
+		s.Equal(1, Parse("2019-12-30").ISOWeek())
+		s.Equal(53, Parse("2015-12-31").ISOWeek())
+		s.Equal(53, Parse("2026-12-31").ISOWeek())
+		s.Equal(52, Parse("2025-12-28").ISOWeek())
+	})
+
+	s.Run("long year", func() {
+		for year := 1990; year <= 2030; year++ {
+			c := CreateFromDate(year, 12, 28)
+			if c.IsLongYear() {
+				s.Equal(WeeksPerLongYear, c.ISOWeek(), year)
+			} else {
+				s.Equal(WeeksPerNormalYear, c.ISOWeek(), year)
+			}
+		}
+	})
+}
+
+func (s *GetterSuite) TestCarbon_ISOWeekday() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Zero(c.ISOWeekday())
+	})
+
+	s.Run("zero carbon", func() {
+		s.Equal(1, NewCarbon().ISOWeekday())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Zero(Parse("").ISOWeekday())
+	})
+
+	s.Run("error carbon", func() {
+		s.Zero(Parse("xxx").ISOWeekday())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal(1, Parse("2020-08-03").ISOWeekday())
+		s.Equal(3, Parse("2020-08-05").ISOWeekday())
+		s.Equal(7, Parse("2020-08-09").ISOWeekday())
+		s.Equal(7, Parse("2020-08-09").SetWeekStartsAt(Sunday).ISOWeekday())
+	})
+}
+
 func (s *GetterSuite) TestCarbon_WeekOfMonth() {
 	s.Run("nil carbon", func() {
 		var c *Carbon
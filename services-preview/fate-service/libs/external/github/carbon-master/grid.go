@@ -0,0 +1,376 @@
+package carbon
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// annotation name constants of the built-in annotators
+const (
+	AnnotationLunarDay      = "lunar_day"
+	AnnotationFestival      = "festival"
+	AnnotationSolarTerm     = "solar_term"
+	AnnotationConstellation = "constellation"
+	AnnotationHoliday       = "holiday"
+)
+
+// max number of weeks in a month grid
+const maxGridWeeks = 6
+
+// Annotator defines an Annotator interface which annotates the day cells of a Grid.
+type Annotator interface {
+	// Name gets the annotation name like "lunar_day", which is the key of the annotation.
+	Name() string
+	// Annotate gets the annotation of the date, an empty string means no annotation.
+	Annotate(c *Carbon) string
+}
+
+// HolidayNamer defines a HolidayNamer interface which is optionally implemented by a HolidayProvider
+// to name the holidays and make-up workdays, like holiday.China.
+type HolidayNamer interface {
+	// HolidayName gets the name of the holiday or make-up workday like "春节", or an empty string if it is neither.
+	HolidayName(c *Carbon) string
+}
+
+// annotator defines an annotator struct which implements the Annotator interface by a function.
+type annotator struct {
+	name     string
+	annotate func(c *Carbon) string
+}
+
+// Name implements the Annotator interface.
+func (a annotator) Name() string {
+	return a.name
+}
+
+// Annotate implements the Annotator interface.
+func (a annotator) Annotate(c *Carbon) string {
+	if a.annotate == nil || c.IsInvalid() {
+		return ""
+	}
+	return a.annotate(c)
+}
+
+// NewAnnotator returns a new Annotator instance which annotates the day cells by the function.
+func NewAnnotator(name string, annotate func(c *Carbon) string) Annotator {
+	return annotator{name: name, annotate: annotate}
+}
+
+// LunarDayAnnotator returns an Annotator instance which annotates the lunar day like "廿一".
+func LunarDayAnnotator() Annotator {
+	return NewAnnotator(AnnotationLunarDay, func(c *Carbon) string {
+		return c.Lunar().ToDayString()
+	})
+}
+
+// FestivalAnnotator returns an Annotator instance which annotates the lunar festival like "春节".
+func FestivalAnnotator() Annotator {
+	return NewAnnotator(AnnotationFestival, func(c *Carbon) string {
+		return c.Lunar().Festival()
+	})
+}
+
+// SolarTermAnnotator returns an Annotator instance which annotates the solar term like "Start of Spring"
+// on the day it begins, i18n is supported.
+func SolarTermAnnotator() Annotator {
+	return NewAnnotator(AnnotationSolarTerm, func(c *Carbon) string {
+		if !c.IsSolarTermDay() {
+			return ""
+		}
+		// the solar term begins within the day, so it's in effect at the end of the day
+		return c.EndOfDay().SolarTerm()
+	})
+}
+
+// ConstellationAnnotator returns an Annotator instance which annotates the constellation like "Aries", i18n is supported.
+func ConstellationAnnotator() Annotator {
+	return NewAnnotator(AnnotationConstellation, func(c *Carbon) string {
+		return c.Constellation()
+	})
+}
+
+// HolidayAnnotator returns an Annotator instance which annotates the holidays and make-up workdays of the providers,
+// they are named by the providers implementing the HolidayNamer interface, or annotated as "holiday" and "workday".
+func HolidayAnnotator(providers ...HolidayProvider) Annotator {
+	bc := NewBusinessCalendar(providers...)
+	return NewAnnotator(AnnotationHoliday, func(c *Carbon) string {
+		for _, p := range bc.providers {
+			if n, ok := p.(HolidayNamer); ok {
+				if name := n.HolidayName(c); name != "" {
+					return name
+				}
+			}
+		}
+		switch {
+		case bc.isHoliday(c):
+			return "holiday"
+		case bc.isWorkday(c):
+			return "workday"
+		}
+		return ""
+	})
+}
+
+// GridOptions defines a GridOptions struct which controls the month grid.
+type GridOptions struct {
+	// WeekStartsAt is the start day of the week, DefaultWeekStartsAt by default.
+	WeekStartsAt *Weekday
+	// Timezone is the timezone of the dates, DefaultTimezone by default.
+	Timezone string
+	// Locale is the locale of the weekday names and the i18n annotations, DefaultLocale by default.
+	Locale string
+	// Annotators annotate the day cells in order.
+	Annotators []Annotator
+	// FixedWeeks always gives 6 weeks so that grids of all months have the same height.
+	FixedWeeks bool
+}
+
+// GridCell defines a GridCell struct which is a day cell of a Grid.
+type GridCell struct {
+	// Carbon is the date at the start of the day.
+	Carbon *Carbon
+	// InMonth reports whether the date is in the month of the grid rather than the adjacent months.
+	InMonth bool
+	// IsWeekend reports whether the date is weekend.
+	IsWeekend bool
+	// Annotations are the non-empty annotations keyed by the annotator names.
+	Annotations map[string]string
+}
+
+// Grid defines a Grid struct which is a month calendar of weeks × days for UI rendering.
+type Grid struct {
+	Year         int
+	Month        int
+	WeekStartsAt Weekday
+	Weeks        [][]GridCell
+	Error        error
+
+	lang       *Language
+	annotators []Annotator
+}
+
+// MonthGrid returns a Grid instance of the month, which consists of whole weeks starting at WeekStartsAt,
+// so the first and last weeks may contain days of the adjacent months.
+func MonthGrid(year, month int, opts ...GridOptions) *Grid {
+	var o GridOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	g := &Grid{Year: year, Month: month, WeekStartsAt: DefaultWeekStartsAt}
+	if o.WeekStartsAt != nil {
+		g.WeekStartsAt = *o.WeekStartsAt
+	}
+	if month < 1 || month > MonthsPerYear {
+		g.Error = ErrInvalidMonth(month)
+		return g
+	}
+	if g.WeekStartsAt < Sunday || g.WeekStartsAt > Saturday {
+		g.Error = ErrInvalidWeekday(g.WeekStartsAt)
+		return g
+	}
+	timezone, locale := o.Timezone, o.Locale
+	if timezone == "" {
+		timezone = DefaultTimezone
+	}
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	loc, err := parseTimezone(timezone)
+	if err != nil {
+		g.Error = err
+		return g
+	}
+	if g.lang = NewLanguage().SetLocale(locale); g.lang.Error != nil {
+		g.Error = g.lang.Error
+		return g
+	}
+	for _, a := range o.Annotators {
+		if a != nil {
+			g.annotators = append(g.annotators, a)
+		}
+	}
+
+	first := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, loc)
+	offset := (int(first.Weekday()) - int(g.WeekStartsAt) + DaysPerWeek) % DaysPerWeek
+	days := time.Date(year, time.Month(month+1), 0, 0, 0, 0, 0, loc).Day()
+	weeks := (offset + days + DaysPerWeek - 1) / DaysPerWeek
+	if o.FixedWeeks {
+		weeks = maxGridWeeks
+	}
+	g.Weeks = make([][]GridCell, weeks)
+	for i := range g.Weeks {
+		g.Weeks[i] = make([]GridCell, DaysPerWeek)
+		for j := range g.Weeks[i] {
+			g.Weeks[i][j] = g.newCell(startOfLocalDay(year, month, 1-offset+i*DaysPerWeek+j, loc))
+		}
+	}
+	return g
+}
+
+// Weekdays gets the short weekday names in grid order like "Mon", i18n is supported.
+func (g *Grid) Weekdays() []string {
+	if g == nil || g.Error != nil || g.lang == nil {
+		return []string{}
+	}
+	g.lang.rw.RLock()
+	defer g.lang.rw.RUnlock()
+
+	names := make([]string, DaysPerWeek)
+	slice := strings.Split(g.lang.resources["short_weeks"], "|")
+	for i := range names {
+		weekday := (int(g.WeekStartsAt) + i) % DaysPerWeek
+		if len(slice) == DaysPerWeek {
+			names[i] = slice[weekday]
+		} else {
+			names[i] = Weekday(weekday).String()[:3]
+		}
+	}
+	return names
+}
+
+// String implements "Stringer" interface for Grid, it's the text renderer which outputs a weekday header
+// and a line of day numbers for each week followed by a line for each annotator having annotations in the week,
+// the days of the adjacent months are left blank.
+func (g *Grid) String() string {
+	if g == nil || g.Error != nil || len(g.Weeks) == 0 {
+		return ""
+	}
+	rows := [][]string{g.Weekdays()}
+	for _, week := range g.Weeks {
+		row := make([]string, DaysPerWeek)
+		for j, cell := range week {
+			if cell.InMonth {
+				row[j] = strconv.Itoa(cell.Carbon.Day())
+			}
+		}
+		rows = append(rows, row)
+		for _, a := range g.annotators {
+			row, empty := make([]string, DaysPerWeek), true
+			for j, cell := range week {
+				if cell.InMonth && cell.Annotations[a.Name()] != "" {
+					row[j], empty = cell.Annotations[a.Name()], false
+				}
+			}
+			if !empty {
+				rows = append(rows, row)
+			}
+		}
+	}
+
+	width := 0
+	for _, row := range rows {
+		for _, s := range row {
+			if w := stringWidth(s); w > width {
+				width = w
+			}
+		}
+	}
+	var b strings.Builder
+	for i, row := range rows {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		line := make([]string, len(row))
+		for j, s := range row {
+			line[j] = strings.Repeat(" ", width-stringWidth(s)) + s
+		}
+		b.WriteString(strings.TrimRight(strings.Join(line, " "), " "))
+	}
+	return b.String()
+}
+
+// MarshalJSON implements "json.Marshaler" interface for Grid, it's the JSON renderer.
+func (g *Grid) MarshalJSON() ([]byte, error) {
+	if g == nil {
+		return []byte("null"), nil
+	}
+	if g.Error != nil {
+		return nil, g.Error
+	}
+	type cell struct {
+		Date        string            `json:"date"`
+		Day         int               `json:"day"`
+		Weekday     int               `json:"weekday"`
+		InMonth     bool              `json:"in_month"`
+		IsWeekend   bool              `json:"is_weekend"`
+		Annotations map[string]string `json:"annotations,omitempty"`
+	}
+	v := struct {
+		Year         int      `json:"year"`
+		Month        int      `json:"month"`
+		WeekStartsAt int      `json:"week_starts_at"`
+		Weekdays     []string `json:"weekdays"`
+		Weeks        [][]cell `json:"weeks"`
+	}{
+		Year:         g.Year,
+		Month:        g.Month,
+		WeekStartsAt: int(g.WeekStartsAt),
+		Weekdays:     g.Weekdays(),
+		Weeks:        make([][]cell, len(g.Weeks)),
+	}
+	for i, week := range g.Weeks {
+		v.Weeks[i] = make([]cell, len(week))
+		for j, c := range week {
+			v.Weeks[i][j] = cell{
+				Date:        c.Carbon.ToDateString(),
+				Day:         c.Carbon.Day(),
+				Weekday:     int(c.Carbon.StdTime().Weekday()),
+				InMonth:     c.InMonth,
+				IsWeekend:   c.IsWeekend,
+				Annotations: c.Annotations,
+			}
+		}
+	}
+	return json.Marshal(v)
+}
+
+// newCell creates a day cell of the grid and annotates it.
+func (g *Grid) newCell(t StdTime) GridCell {
+	c := NewCarbon(t).SetLanguage(g.lang).SetWeekStartsAt(g.WeekStartsAt)
+	cell := GridCell{
+		Carbon:    c,
+		InMonth:   int(t.Month()) == g.Month,
+		IsWeekend: c.IsWeekend(),
+	}
+	for _, a := range g.annotators {
+		if s := a.Annotate(c); s != "" {
+			if cell.Annotations == nil {
+				cell.Annotations = make(map[string]string, len(g.annotators))
+			}
+			cell.Annotations[a.Name()] = s
+		}
+	}
+	return cell
+}
+
+// gets the first instant of the date in the location, the date is normalized like time.Date,
+// and it's the end of the gap if the midnight is skipped by daylight saving time.
+func startOfLocalDay(year, month, day int, loc *Location) StdTime {
+	y, m, d := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).Date()
+	instants, gap := getLocalInstants(y, int(m), d, 0, 0, 0, 0, loc)
+	if gap {
+		return instants[len(instants)-1]
+	}
+	return instants[0]
+}
+
+// gets the display width of a string in monospace fonts, east asian wide characters take two columns.
+func stringWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		switch {
+		case r < utf8.RuneSelf:
+			width++
+		case unicode.In(r, unicode.Han, unicode.Hangul, unicode.Hiragana, unicode.Katakana),
+			r >= 0x3000 && r <= 0x303F, r >= 0xFF01 && r <= 0xFF60, r >= 0xFFE0 && r <= 0xFFE6:
+			width += 2
+		default:
+			width++
+		}
+	}
+	return width
+}
@@ -0,0 +1,142 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+)
+
+func BenchmarkMonthGrid(b *testing.B) {
+	monday := Monday
+	opts := GridOptions{WeekStartsAt: &monday}
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			MonthGrid(2025, 2, opts)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				MonthGrid(2025, 2, opts)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				MonthGrid(2025, 2, opts)
+			}
+		})
+	})
+}
+
+func BenchmarkMonthGrid_Annotators(b *testing.B) {
+	monday := Monday
+	opts := GridOptions{WeekStartsAt: &monday, Annotators: []Annotator{LunarDayAnnotator(), FestivalAnnotator(), SolarTermAnnotator()}}
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			MonthGrid(2025, 2, opts)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				MonthGrid(2025, 2, opts)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				MonthGrid(2025, 2, opts)
+			}
+		})
+	})
+}
+
+func BenchmarkGrid_String(b *testing.B) {
+	monday := Monday
+	g := MonthGrid(2025, 2, GridOptions{WeekStartsAt: &monday, Annotators: []Annotator{LunarDayAnnotator()}})
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			_ = g.String()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = g.String()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = g.String()
+			}
+		})
+	})
+}
+
+func BenchmarkGrid_MarshalJSON(b *testing.B) {
+	monday := Monday
+	g := MonthGrid(2025, 2, GridOptions{WeekStartsAt: &monday, Annotators: []Annotator{LunarDayAnnotator()}})
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			_, _ = g.MarshalJSON()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _ = g.MarshalJSON()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, _ = g.MarshalJSON()
+			}
+		})
+	})
+}
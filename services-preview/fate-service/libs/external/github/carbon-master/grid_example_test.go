@@ -0,0 +1,102 @@
+package carbon_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dromara/carbon/v2"
+	"github.com/dromara/carbon/v2/holiday"
+)
+
+func ExampleMonthGrid() {
+	sunday := carbon.Sunday
+	g := carbon.MonthGrid(2025, 2, carbon.GridOptions{WeekStartsAt: &sunday})
+	fmt.Println(len(g.Weeks))
+	fmt.Println(g.Weeks[0][0].Carbon.ToDateString(), g.Weeks[0][0].InMonth)
+	fmt.Println(g.Weeks[0][6].Carbon.ToDateString(), g.Weeks[0][6].InMonth, g.Weeks[0][6].IsWeekend)
+
+	// Output:
+	// 5
+	// 2025-01-26 false
+	// 2025-02-01 true true
+}
+
+func ExampleNewAnnotator() {
+	monday := carbon.Monday
+	payday := carbon.NewAnnotator("payday", func(c *carbon.Carbon) string {
+		if c.Day() == 10 {
+			return "payday"
+		}
+		return ""
+	})
+	g := carbon.MonthGrid(2025, 2, carbon.GridOptions{WeekStartsAt: &monday, Annotators: []carbon.Annotator{payday}})
+	fmt.Println(g.Weeks[2][0].Carbon.ToDateString(), g.Weeks[2][0].Annotations["payday"])
+
+	// Output:
+	// 2025-02-10 payday
+}
+
+func ExampleHolidayAnnotator() {
+	monday := carbon.Monday
+	g := carbon.MonthGrid(2025, 1, carbon.GridOptions{
+		WeekStartsAt: &monday,
+		Annotators:   []carbon.Annotator{carbon.HolidayAnnotator(holiday.NewChina())},
+	})
+	fmt.Println(g.Weeks[3][6].Carbon.ToDateString(), g.Weeks[3][6].Annotations[carbon.AnnotationHoliday])
+	fmt.Println(g.Weeks[4][1].Carbon.ToDateString(), g.Weeks[4][1].Annotations[carbon.AnnotationHoliday])
+
+	// Output:
+	// 2025-01-26 春节调休
+	// 2025-01-28 春节
+}
+
+func ExampleGrid_Weekdays() {
+	sunday, monday := carbon.Sunday, carbon.Monday
+	fmt.Println(carbon.MonthGrid(2025, 2, carbon.GridOptions{WeekStartsAt: &sunday}).Weekdays())
+	fmt.Println(carbon.MonthGrid(2025, 2, carbon.GridOptions{WeekStartsAt: &monday, Locale: "zh-CN"}).Weekdays())
+
+	// Output:
+	// [Sun Mon Tue Wed Thu Fri Sat]
+	// [周一 周二 周三 周四 周五 周六 周日]
+}
+
+func ExampleGrid_String() {
+	monday := carbon.Monday
+	g := carbon.MonthGrid(2025, 2, carbon.GridOptions{
+		WeekStartsAt: &monday,
+		Locale:       "zh-CN",
+		Annotators:   []carbon.Annotator{carbon.LunarDayAnnotator(), carbon.FestivalAnnotator(), carbon.SolarTermAnnotator()},
+	})
+	fmt.Println(g.String())
+
+	// Output:
+	//   周一   周二   周三   周四   周五   周六   周日
+	//                                         1      2
+	//                                      初四   初五
+	//      3      4      5      6      7      8      9
+	//   初六   初七   初八   初九   初十   十一   十二
+	//   立春
+	//     10     11     12     13     14     15     16
+	//   十三   十四   十五   十六   十七   十八   十九
+	//               元宵节
+	//     17     18     19     20     21     22     23
+	//   二十   廿一   廿二   廿三   廿四   廿五   廿六
+	//          雨水
+	//     24     25     26     27     28
+	//   廿七   廿八   廿九   三十   初一
+}
+
+func ExampleGrid_MarshalJSON() {
+	monday := carbon.Monday
+	g := carbon.MonthGrid(2025, 2, carbon.GridOptions{
+		WeekStartsAt: &monday,
+		Annotators:   []carbon.Annotator{carbon.ConstellationAnnotator()},
+	})
+	b, _ := json.Marshal(g)
+	// the first cell
+	fmt.Println(string(b[:strings.Index(string(b), "},")+1]))
+
+	// Output:
+	// {"year":2025,"month":2,"week_starts_at":1,"weekdays":["Mon","Tue","Wed","Thu","Fri","Sat","Sun"],"weeks":[[{"date":"2025-01-27","day":27,"weekday":1,"in_month":false,"is_weekend":false,"annotations":{"constellation":"Aquarius"}}
+}
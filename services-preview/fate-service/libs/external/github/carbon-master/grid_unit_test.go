@@ -0,0 +1,337 @@
+package carbon
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// testHolidayNamer is testHolidayProvider naming the National Day holidays of China in 2020.
+type testHolidayNamer struct {
+	testHolidayProvider
+}
+
+func (p testHolidayNamer) HolidayName(c *Carbon) string {
+	if p.IsHoliday(c) {
+		return "国庆节"
+	}
+	return ""
+}
+
+type GridSuite struct {
+	suite.Suite
+}
+
+func TestGridSuite(t *testing.T) {
+	suite.Run(t, new(GridSuite))
+}
+
+func (s *GridSuite) TestMonthGrid() {
+	sunday, monday := Sunday, Monday
+
+	s.Run("invalid month", func() {
+		s.Error(MonthGrid(2025, 0).Error)
+		s.Error(MonthGrid(2025, 13).Error)
+		s.Empty(MonthGrid(2025, 13).Weeks)
+	})
+
+	s.Run("invalid week starts at", func() {
+		invalid1, invalid2 := Weekday(7), Weekday(-1)
+		s.Error(MonthGrid(2025, 2, GridOptions{WeekStartsAt: &invalid1}).Error)
+		s.Error(MonthGrid(2025, 2, GridOptions{WeekStartsAt: &invalid2}).Error)
+	})
+
+	s.Run("invalid timezone", func() {
+		s.Error(MonthGrid(2025, 2, GridOptions{Timezone: "xxx"}).Error)
+	})
+
+	s.Run("invalid locale", func() {
+		s.Error(MonthGrid(2025, 2, GridOptions{Locale: "xxx"}).Error)
+	})
+
+	s.Run("without options", func() {
+		g := MonthGrid(2025, 2)
+		s.Nil(g.Error)
+		s.Equal(2025, g.Year)
+		s.Equal(2, g.Month)
+		s.Equal(DefaultWeekStartsAt, g.WeekStartsAt)
+		s.Len(g.Weeks, 5)
+		s.Equal("2025-01-27", g.Weeks[0][0].Carbon.ToDateString())
+		s.Equal("2025-03-02", g.Weeks[4][6].Carbon.ToDateString())
+		s.Equal(DefaultTimezone, g.Weeks[0][0].Carbon.Timezone())
+		s.Equal("2025-02-01 00:00:00", g.Weeks[0][5].Carbon.ToDateTimeString())
+		s.Nil(g.Weeks[0][5].Annotations)
+	})
+
+	s.Run("without week starts at", func() {
+		g := MonthGrid(2025, 2, GridOptions{Annotators: []Annotator{FestivalAnnotator()}})
+		s.Nil(g.Error)
+		s.Equal(DefaultWeekStartsAt, g.WeekStartsAt)
+		s.Equal(Monday, g.WeekStartsAt)
+		s.Equal("2025-01-27", g.Weeks[0][0].Carbon.ToDateString())
+		s.Equal("2025-03-02", g.Weeks[4][6].Carbon.ToDateString())
+	})
+
+	s.Run("in month", func() {
+		g := MonthGrid(2025, 2)
+		s.False(g.Weeks[0][4].InMonth)
+		s.True(g.Weeks[0][5].InMonth)
+		s.True(g.Weeks[4][4].InMonth)
+		s.False(g.Weeks[4][5].InMonth)
+	})
+
+	s.Run("weekend", func() {
+		g := MonthGrid(2025, 2)
+		s.False(g.Weeks[1][4].IsWeekend)
+		s.True(g.Weeks[1][5].IsWeekend)
+		s.True(g.Weeks[1][6].IsWeekend)
+	})
+
+	s.Run("week starts at sunday", func() {
+		g := MonthGrid(2025, 2, GridOptions{WeekStartsAt: &sunday})
+		s.Len(g.Weeks, 5)
+		s.Equal("2025-01-26", g.Weeks[0][0].Carbon.ToDateString())
+		s.Equal("2025-02-01", g.Weeks[0][6].Carbon.ToDateString())
+		s.Equal(Sunday, g.Weeks[0][0].Carbon.WeekStartsAt())
+
+		g = MonthGrid(2026, 2, GridOptions{WeekStartsAt: &sunday})
+		s.Len(g.Weeks, 4)
+		s.Equal("2026-02-01", g.Weeks[0][0].Carbon.ToDateString())
+		s.Equal("2026-02-28", g.Weeks[3][6].Carbon.ToDateString())
+	})
+
+	s.Run("six weeks", func() {
+		g := MonthGrid(2025, 3, GridOptions{WeekStartsAt: &monday})
+		s.Len(g.Weeks, 6)
+		s.Equal("2025-02-24", g.Weeks[0][0].Carbon.ToDateString())
+		s.Equal("2025-04-06", g.Weeks[5][6].Carbon.ToDateString())
+	})
+
+	s.Run("fixed weeks", func() {
+		g := MonthGrid(2026, 2, GridOptions{WeekStartsAt: &sunday, FixedWeeks: true})
+		s.Len(g.Weeks, 6)
+		s.Equal("2026-03-14", g.Weeks[5][6].Carbon.ToDateString())
+	})
+
+	s.Run("timezone", func() {
+		g := MonthGrid(2025, 2, GridOptions{WeekStartsAt: &monday, Timezone: PRC})
+		s.Equal(PRC, g.Weeks[0][0].Carbon.Timezone())
+		s.Equal("2025-02-01 00:00:00", g.Weeks[0][5].Carbon.ToDateTimeString())
+	})
+
+	s.Run("daylight saving time", func() {
+		// the midnight of 2018-11-04 doesn't exist in Sao Paulo
+		g := MonthGrid(2018, 11, GridOptions{WeekStartsAt: &sunday, Timezone: "America/Sao_Paulo"})
+		s.Equal("2018-11-04 01:00:00", g.Weeks[1][0].Carbon.ToDateTimeString())
+		s.Equal("2018-11-05", g.Weeks[1][1].Carbon.ToDateString())
+		s.Equal("2018-11-03", g.Weeks[0][6].Carbon.ToDateString())
+	})
+
+	s.Run("locale", func() {
+		g := MonthGrid(2025, 2, GridOptions{Locale: "zh-CN"})
+		s.Equal("zh-CN", g.Weeks[0][0].Carbon.Locale())
+		s.Equal("星期六", g.Weeks[0][5].Carbon.ToWeekString())
+	})
+}
+
+func (s *GridSuite) TestAnnotator() {
+	monday := Monday
+
+	s.Run("nil annotator", func() {
+		g := MonthGrid(2025, 2, GridOptions{WeekStartsAt: &monday, Annotators: []Annotator{nil}})
+		s.Nil(g.Error)
+		s.Nil(g.Weeks[0][5].Annotations)
+	})
+
+	s.Run("custom annotator", func() {
+		a := NewAnnotator("even", func(c *Carbon) string {
+			if c.Day()%2 == 0 {
+				return "even"
+			}
+			return ""
+		})
+		s.Equal("even", a.Name())
+		s.Empty(a.Annotate(nil))
+		s.Empty(a.Annotate(Parse("xxx")))
+		s.Empty(NewAnnotator("nil", nil).Annotate(Parse("2025-02-01")))
+
+		g := MonthGrid(2025, 2, GridOptions{WeekStartsAt: &monday, Annotators: []Annotator{a}})
+		s.Nil(g.Weeks[0][5].Annotations)
+		s.Equal(map[string]string{"even": "even"}, g.Weeks[0][6].Annotations)
+	})
+
+	s.Run("lunar day annotator", func() {
+		a := LunarDayAnnotator()
+		s.Equal(AnnotationLunarDay, a.Name())
+		s.Equal("初四", a.Annotate(Parse("2025-02-01")))
+		s.Equal("廿一", a.Annotate(Parse("2025-02-18")))
+		s.Empty(a.Annotate(Parse("")))
+	})
+
+	s.Run("festival annotator", func() {
+		a := FestivalAnnotator()
+		s.Equal(AnnotationFestival, a.Name())
+		s.Equal("春节", a.Annotate(Parse("2025-01-29")))
+		s.Equal("元宵节", a.Annotate(Parse("2025-02-12")))
+		s.Empty(a.Annotate(Parse("2025-02-13")))
+	})
+
+	s.Run("solar term annotator", func() {
+		a := SolarTermAnnotator()
+		s.Equal(AnnotationSolarTerm, a.Name())
+		s.Equal("Start of Spring", a.Annotate(Parse("2025-02-03")))
+		s.Equal("立春", a.Annotate(Parse("2025-02-03").SetLocale("zh-CN")))
+		s.Empty(a.Annotate(Parse("2025-02-04")))
+	})
+
+	s.Run("constellation annotator", func() {
+		a := ConstellationAnnotator()
+		s.Equal(AnnotationConstellation, a.Name())
+		s.Equal("Aquarius", a.Annotate(Parse("2025-02-18")))
+		s.Equal("Pisces", a.Annotate(Parse("2025-02-19")))
+	})
+
+	s.Run("holiday annotator", func() {
+		a := HolidayAnnotator(testHolidayProvider{}, nil)
+		s.Equal(AnnotationHoliday, a.Name())
+		s.Equal("holiday", a.Annotate(Parse("2020-10-01")))
+		s.Equal("workday", a.Annotate(Parse("2020-10-10")))
+		s.Empty(a.Annotate(Parse("2020-10-09")))
+
+		a = HolidayAnnotator(testHolidayNamer{})
+		s.Equal("国庆节", a.Annotate(Parse("2020-10-01")))
+		s.Equal("workday", a.Annotate(Parse("2020-10-10")))
+
+		s.Empty(HolidayAnnotator().Annotate(Parse("2020-10-01")))
+	})
+
+	s.Run("annotators", func() {
+		g := MonthGrid(2025, 2, GridOptions{
+			WeekStartsAt: &monday,
+			Locale:       "zh-CN",
+			Annotators:   []Annotator{LunarDayAnnotator(), FestivalAnnotator(), SolarTermAnnotator(), ConstellationAnnotator()},
+		})
+		s.Equal(map[string]string{
+			AnnotationLunarDay:      "初六",
+			AnnotationSolarTerm:     "立春",
+			AnnotationConstellation: "水瓶座",
+		}, g.Weeks[1][0].Annotations)
+		s.Equal("元宵节", g.Weeks[2][2].Annotations[AnnotationFestival])
+		s.Equal("双鱼座", g.Weeks[3][2].Annotations[AnnotationConstellation])
+	})
+}
+
+func (s *GridSuite) TestGrid_Weekdays() {
+	sunday, monday, saturday := Sunday, Monday, Saturday
+
+	s.Run("nil grid", func() {
+		var g *Grid
+		s.Empty(g.Weekdays())
+	})
+
+	s.Run("error grid", func() {
+		s.Empty(MonthGrid(2025, 13).Weekdays())
+	})
+
+	s.Run("valid grid", func() {
+		s.Equal([]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}, MonthGrid(2025, 2, GridOptions{WeekStartsAt: &monday}).Weekdays())
+		s.Equal([]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}, MonthGrid(2025, 2, GridOptions{WeekStartsAt: &sunday}).Weekdays())
+		s.Equal([]string{"周六", "周日", "周一", "周二", "周三", "周四", "周五"}, MonthGrid(2025, 2, GridOptions{WeekStartsAt: &saturday, Locale: "zh-CN"}).Weekdays())
+	})
+}
+
+func (s *GridSuite) TestGrid_String() {
+	monday := Monday
+
+	s.Run("nil grid", func() {
+		var g *Grid
+		s.Empty(g.String())
+	})
+
+	s.Run("error grid", func() {
+		s.Empty(MonthGrid(2025, 13).String())
+	})
+
+	s.Run("without annotators", func() {
+		s.Equal("Mon Tue Wed Thu Fri Sat Sun\n"+
+			"                      1   2\n"+
+			"  3   4   5   6   7   8   9\n"+
+			" 10  11  12  13  14  15  16\n"+
+			" 17  18  19  20  21  22  23\n"+
+			" 24  25  26  27  28", MonthGrid(2025, 2, GridOptions{WeekStartsAt: &monday}).String())
+	})
+
+	s.Run("with annotators", func() {
+		g := MonthGrid(2025, 2, GridOptions{
+			WeekStartsAt: &monday,
+			Locale:       "zh-CN",
+			Annotators:   []Annotator{LunarDayAnnotator(), FestivalAnnotator(), SolarTermAnnotator()},
+		})
+		s.Equal("  周一   周二   周三   周四   周五   周六   周日\n"+
+			"                                        1      2\n"+
+			"                                     初四   初五\n"+
+			"     3      4      5      6      7      8      9\n"+
+			"  初六   初七   初八   初九   初十   十一   十二\n"+
+			"  立春\n"+
+			"    10     11     12     13     14     15     16\n"+
+			"  十三   十四   十五   十六   十七   十八   十九\n"+
+			"              元宵节\n"+
+			"    17     18     19     20     21     22     23\n"+
+			"  二十   廿一   廿二   廿三   廿四   廿五   廿六\n"+
+			"         雨水\n"+
+			"    24     25     26     27     28\n"+
+			"  廿七   廿八   廿九   三十   初一", g.String())
+	})
+}
+
+func (s *GridSuite) TestGrid_MarshalJSON() {
+	monday := Monday
+
+	s.Run("nil grid", func() {
+		var g *Grid
+		b, err := json.Marshal(g)
+		s.Nil(err)
+		s.Equal("null", string(b))
+	})
+
+	s.Run("error grid", func() {
+		_, err := json.Marshal(MonthGrid(2025, 13))
+		s.Error(err)
+	})
+
+	s.Run("valid grid", func() {
+		g := MonthGrid(2025, 2, GridOptions{WeekStartsAt: &monday, Annotators: []Annotator{FestivalAnnotator()}})
+		b, err := json.Marshal(g)
+		s.Nil(err)
+
+		var v struct {
+			Year         int      `json:"year"`
+			Month        int      `json:"month"`
+			WeekStartsAt int      `json:"week_starts_at"`
+			Weekdays     []string `json:"weekdays"`
+			Weeks        [][]map[string]any
+		}
+		s.Nil(json.Unmarshal(b, &v))
+		s.Equal(2025, v.Year)
+		s.Equal(2, v.Month)
+		s.Equal(1, v.WeekStartsAt)
+		s.Len(v.Weekdays, 7)
+		s.Len(v.Weeks, 5)
+		s.Equal(map[string]any{
+			"date":        "2025-01-29",
+			"day":         float64(29),
+			"weekday":     float64(3),
+			"in_month":    false,
+			"is_weekend":  false,
+			"annotations": map[string]any{"festival": "春节"},
+		}, v.Weeks[0][2])
+		s.Equal(map[string]any{
+			"date":       "2025-02-01",
+			"day":        float64(1),
+			"weekday":    float64(6),
+			"in_month":   true,
+			"is_weekend": true,
+		}, v.Weeks[0][5])
+	})
+}
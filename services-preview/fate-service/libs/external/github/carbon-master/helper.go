@@ -85,6 +85,26 @@ var defaultLayouts = []string{
 	"20060102150405Z07:00", "20060102150405.999999999Z07:00",
 }
 
+// isoWeekDay gets the day of January for a given ISO 8601 week-numbering year, week and day of week,
+// which may be less than 1 or greater than 31 and is normalized by time.Date.
+func isoWeekDay(year, week, day int) int {
+	// January 4th is always in the first week
+	weekday := (int(time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC).Weekday()) + DaysPerWeek - 1) % DaysPerWeek
+	return 4 - weekday + (week-1)*DaysPerWeek + day - 1
+}
+
+// isValidISOWeekDate reports whether the week is in the ISO 8601 week-numbering year and the day of week is in [1, 7].
+func isValidISOWeekDate(year, week, day int) bool {
+	return week >= 1 && week <= isoWeeksInYear(year) && day >= 1 && day <= DaysPerWeek
+}
+
+// isoWeeksInYear gets the number of ISO 8601 weeks in a given week-numbering year, which is 52 or 53.
+func isoWeeksInYear(year int) int {
+	// December 28th is always in the last week
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
 // layoutCache caches format to layout conversions to avoid repeated parsing
 var layoutCache sync.Map
 
@@ -192,3 +212,44 @@ func getAbsValue(value int64) int64 {
 	// For negative numbers: value ^ -1 - (-1) = ^value + 1 = -value
 	return (value ^ (value >> 63)) - (value >> 63)
 }
+
+// chinese numeral digits and units
+var (
+	chineseDigits = map[rune]int{'〇': 0, '零': 0, '一': 1, '二': 2, '两': 2, '兩': 2, '三': 3, '四': 4, '五': 5, '六': 6, '七': 7, '八': 8, '九': 9}
+	chineseUnits  = map[rune]int{'十': 10, '百': 100, '千': 1000, '万': 10000, '萬': 10000}
+)
+
+// parses a chinese numeral like "三", "十二", "一百零五" or "二〇二五" as an integer.
+func parseChineseNumber(value string) (int, bool) {
+	if value == "" {
+		return 0, false
+	}
+	total, section, number, isDigit := 0, 0, 0, false
+	for _, r := range value {
+		if d, ok := chineseDigits[r]; ok {
+			// consecutive digits like "二〇二五" are read one by one
+			if isDigit {
+				number = number*10 + d
+			} else {
+				number = d
+			}
+			isDigit = true
+			continue
+		}
+		u, ok := chineseUnits[r]
+		if !ok {
+			return 0, false
+		}
+		if u == 10000 {
+			total += (section + number) * u
+			section = 0
+		} else {
+			if number == 0 && !isDigit {
+				number = 1
+			}
+			section += number * u
+		}
+		number, isDigit = 0, false
+	}
+	return total + section + number, true
+}
@@ -454,3 +454,34 @@ func TestGetAbsValue(t *testing.T) {
 		})
 	}
 }
+
+func TestParseChineseNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected int
+		ok       bool
+	}{
+		{name: "Empty value", value: "", expected: 0, ok: false},
+		{name: "Invalid value", value: "三x", expected: 0, ok: false},
+		{name: "Digit", value: "三", expected: 3, ok: true},
+		{name: "Zero", value: "零", expected: 0, ok: true},
+		{name: "Two", value: "两", expected: 2, ok: true},
+		{name: "Ten", value: "十", expected: 10, ok: true},
+		{name: "Twelve", value: "十二", expected: 12, ok: true},
+		{name: "Twenty five", value: "二十五", expected: 25, ok: true},
+		{name: "One hundred and five", value: "一百零五", expected: 105, ok: true},
+		{name: "Thousands", value: "三千二百", expected: 3200, ok: true},
+		{name: "Ten thousands", value: "三万五千零一", expected: 35001, ok: true},
+		{name: "Digits one by one", value: "二〇二五", expected: 2025, ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, ok := parseChineseNumber(tt.value)
+			if actual != tt.expected || ok != tt.ok {
+				t.Errorf("parseChineseNumber(%q) = %d, %v, want %d, %v", tt.value, actual, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
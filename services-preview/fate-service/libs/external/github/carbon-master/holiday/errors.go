@@ -0,0 +1,12 @@
+package holiday
+
+import (
+	"fmt"
+)
+
+var (
+	// ErrInvalidData invalid holiday data error.
+	ErrInvalidData = func(data string) error {
+		return fmt.Errorf("invalid holiday data %q", data)
+	}
+)
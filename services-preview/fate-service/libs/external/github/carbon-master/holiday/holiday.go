@@ -0,0 +1,29 @@
+// Package holiday provides holiday providers for carbon.BusinessCalendar.
+package holiday
+
+import (
+	"sort"
+)
+
+// Holiday defines a Holiday struct.
+type Holiday struct {
+	Date      string // the date like "2025-01-28"
+	Name      string // the name like "春节"
+	IsWorkday bool   // whether it is a make-up workday rather than a day off
+	Target    string // the date of the festival like "2025-01-29"
+}
+
+// String implements "Stringer" interface for Holiday.
+func (h Holiday) String() string {
+	if h.IsWorkday {
+		return h.Date + " " + h.Name + "调休 " + h.Target
+	}
+	return h.Date + " " + h.Name + " " + h.Target
+}
+
+// sortHolidays sorts the holidays in date order.
+func sortHolidays(holidays []Holiday) {
+	sort.Slice(holidays, func(i, j int) bool {
+		return holidays[i].Date < holidays[j].Date
+	})
+}
@@ -0,0 +1,39 @@
+package holiday
+
+import (
+	"testing"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func BenchmarkNewChina(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NewChina()
+	}
+}
+
+func BenchmarkChina_IsHoliday(b *testing.B) {
+	p := NewChina()
+	dates := []*carbon.Carbon{
+		carbon.Parse("2025-01-01"),
+		carbon.Parse("2025-01-26"),
+		carbon.Parse("2025-01-28"),
+		carbon.Parse("2025-05-01"),
+		carbon.Parse("2025-08-05"),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.IsHoliday(dates[i%len(dates)])
+	}
+}
+
+func BenchmarkChina_Holidays(b *testing.B) {
+	p := NewChina()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Holidays(2002 + i%24)
+	}
+}
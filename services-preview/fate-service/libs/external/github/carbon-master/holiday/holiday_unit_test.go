@@ -0,0 +1,126 @@
+package holiday
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func TestHoliday_String(t *testing.T) {
+	assert.Equal(t, "2025-01-28 春节 2025-01-29", Holiday{Date: "2025-01-28", Name: "春节", Target: "2025-01-29"}.String())
+	assert.Equal(t, "2025-01-26 春节调休 2025-01-29", Holiday{Date: "2025-01-26", Name: "春节", IsWorkday: true, Target: "2025-01-29"}.String())
+}
+
+func TestChina_Holiday(t *testing.T) {
+	p := NewChina()
+
+	t.Run("invalid carbon", func(t *testing.T) {
+		assert.Nil(t, p.Holiday(nil))
+		assert.Nil(t, p.Holiday(carbon.Parse("")))
+		assert.Nil(t, p.Holiday(carbon.Parse("xxx")))
+
+		var nilChina *China
+		assert.Nil(t, nilChina.Holiday(carbon.Parse("2025-01-28")))
+		assert.False(t, nilChina.IsHoliday(carbon.Parse("2025-01-28")))
+		assert.Empty(t, nilChina.Holidays(2025))
+	})
+
+	t.Run("holiday", func(t *testing.T) {
+		h := p.Holiday(carbon.Parse("2025-01-28 13:14:15"))
+		assert.Equal(t, &Holiday{Date: "2025-01-28", Name: "春节", Target: "2025-01-29"}, h)
+		assert.True(t, p.IsHoliday(carbon.Parse("2025-01-28")))
+		assert.False(t, p.IsWorkday(carbon.Parse("2025-01-28")))
+		assert.True(t, p.IsWorkday(carbon.Parse("2001-12-29")))
+	})
+
+	t.Run("make-up workday", func(t *testing.T) {
+		h := p.Holiday(carbon.Parse("2025-01-26"))
+		assert.Equal(t, &Holiday{Date: "2025-01-26", Name: "春节", IsWorkday: true, Target: "2025-01-29"}, h)
+		assert.False(t, p.IsHoliday(carbon.Parse("2025-01-26")))
+		assert.True(t, p.IsWorkday(carbon.Parse("2025-01-26")))
+	})
+
+	t.Run("ordinary day", func(t *testing.T) {
+		assert.Nil(t, p.Holiday(carbon.Parse("2025-01-27")))
+		assert.False(t, p.IsHoliday(carbon.Parse("2025-01-27")))
+		assert.False(t, p.IsWorkday(carbon.Parse("2025-01-27")))
+		assert.False(t, p.IsHoliday(carbon.Parse("2000-01-01")))
+	})
+
+	t.Run("date of the carbon", func(t *testing.T) {
+		c := carbon.Parse("2025-01-27 20:00:00", carbon.UTC)
+		assert.Nil(t, p.Holiday(c))
+		assert.NotNil(t, p.Holiday(c.SetTimezone(carbon.PRC)))
+	})
+}
+
+func TestChina_HolidayName(t *testing.T) {
+	p := NewChina()
+	assert.Equal(t, "春节", p.HolidayName(carbon.Parse("2025-01-28")))
+	assert.Equal(t, "春节调休", p.HolidayName(carbon.Parse("2025-01-26")))
+	assert.Empty(t, p.HolidayName(carbon.Parse("2025-01-27")))
+	assert.Empty(t, p.HolidayName(carbon.Parse("xxx")))
+
+	var nilChina *China
+	assert.Empty(t, nilChina.HolidayName(carbon.Parse("2025-01-28")))
+
+	var _ carbon.HolidayNamer = p
+}
+
+func TestChina_Holidays(t *testing.T) {
+	p := NewChina()
+	assert.Empty(t, p.Holidays(2000))
+	assert.Len(t, p.Holidays(2001), 2)
+
+	holidays := p.Holidays(2025)
+	assert.Len(t, holidays, 33)
+	assert.Equal(t, "2025-01-01 元旦节 2025-01-01", holidays[0].String())
+	assert.Equal(t, "2025-10-11 国庆中秋调休 2025-10-01", holidays[32].String())
+	for i := 1; i < len(holidays); i++ {
+		assert.Less(t, holidays[i-1].Date, holidays[i].Date)
+	}
+}
+
+func TestNewChina(t *testing.T) {
+	t.Run("invalid fixes", func(t *testing.T) {
+		assert.Error(t, NewChina("2025").Error)
+		assert.Error(t, NewChina("20251301112025010").Error)
+		assert.Error(t, NewChina("202513011120250101").Error)
+		assert.Error(t, NewChina("202501019120250101").Error)
+		assert.Error(t, NewChina("202501010220250101").Error)
+		assert.Error(t, NewChina("202501010120251301").Error)
+	})
+
+	t.Run("valid fixes", func(t *testing.T) {
+		// adds the holidays of 2027 and removes a make-up workday
+		p := NewChina("202701010120270101202701020120270101", "20250126~000000000")
+		assert.Nil(t, p.Error)
+		assert.Len(t, p.Holidays(2027), 2)
+		assert.True(t, p.IsHoliday(carbon.Parse("2027-01-02")))
+		assert.Nil(t, p.Holiday(carbon.Parse("2025-01-26")))
+
+		// the built-in data is not changed
+		assert.Empty(t, NewChina().Holidays(2027))
+		assert.True(t, NewChina().IsWorkday(carbon.Parse("2025-01-26")))
+	})
+
+	t.Run("replace holiday", func(t *testing.T) {
+		p := NewChina("202501270120250129")
+		assert.True(t, p.IsHoliday(carbon.Parse("2025-01-27")))
+		p = NewChina("202501280020250129")
+		assert.True(t, p.IsWorkday(carbon.Parse("2025-01-28")))
+	})
+}
+
+func TestBusinessCalendar(t *testing.T) {
+	bc := carbon.NewBusinessCalendar(NewChina())
+	c := carbon.Parse("2025-01-24 13:14:15")
+	assert.Equal(t, "2025-01-26 13:14:15", bc.NextBusinessDay(c).ToDateTimeString())
+	assert.Equal(t, "2025-02-05 13:14:15", bc.AddBusinessDays(c, 3).ToDateTimeString())
+	assert.Equal(t, "2025-01-24 13:14:15", bc.SubBusinessDays(carbon.Parse("2025-02-05 13:14:15"), 3).ToDateTimeString())
+	assert.Equal(t, int64(3), bc.DiffInBusinessDays(c, carbon.Parse("2025-02-05")))
+	assert.True(t, bc.IsBusinessDay(carbon.Parse("2025-02-08")))
+	assert.False(t, bc.IsBusinessDay(carbon.Parse("2025-02-03")))
+}
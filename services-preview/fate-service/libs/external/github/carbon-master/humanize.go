@@ -0,0 +1,172 @@
+package carbon
+
+import (
+	"strings"
+	"time"
+)
+
+// DiffStyle defines a DiffStyle type which is the style of units in human-readable differences.
+type DiffStyle string
+
+// diff style constants
+const (
+	DiffStyleLong  DiffStyle = "long"  // like "1 year 3 months"
+	DiffStyleShort DiffStyle = "short" // like "1y 3mo"
+)
+
+// DiffOptions defines a DiffOptions struct which controls human-readable differences and durations.
+type DiffOptions struct {
+	// Parts is the max number of non-zero units, 1 by default.
+	Parts int
+	// Style is the style of units, DiffStyleLong by default.
+	Style DiffStyle
+	// Join joins the units with the joiner words of the language like "1 year, 3 months and 2 days"
+	// instead of spaces.
+	Join bool
+	// Approximate prefixes the "about" or "almost" words of the language if smaller units are dropped,
+	// the last unit is rounded up in the latter case.
+	Approximate bool
+	// Locale overrides the locale of the Carbon instance, FormatDuration uses DefaultLocale by default.
+	Locale string
+}
+
+// units of human-readable differences from the largest to the smallest
+var humanUnits = []string{"year", "month", "week", "day", "hour", "minute", "second"}
+
+// approximate seconds of the human units, only used to round the last unit
+var humanUnitSeconds = []int64{
+	DaysPerNormalYear * SecondsPerDay, 30 * SecondsPerDay, SecondsPerWeek, SecondsPerDay, SecondsPerHour, SecondsPerMinute, 1,
+}
+
+// numbers of the human units carried to the previous unit, 0 means it isn't carried
+var humanUnitCarries = []int64{0, MonthsPerYear, 0, DaysPerWeek, HoursPerDay, MinutesPerHour, SecondsPerMinute}
+
+// FormatDuration formats a duration in a human-readable format like "2 days 3 hours" or "2d 3h", i18n is supported,
+// years and months aren't used since their lengths vary, and a negative duration is prefixed with a minus sign.
+func FormatDuration(d Duration, opts ...DiffOptions) string {
+	var o DiffOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	locale := o.Locale
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	lang := NewLanguage().SetLocale(locale)
+	if lang.Error != nil {
+		return ""
+	}
+
+	var values [7]int64
+	seconds := int64(d / time.Second)
+	negative := seconds < 0
+	seconds = getAbsValue(seconds)
+	for i := 2; i < len(humanUnits); i++ {
+		values[i] = seconds / humanUnitSeconds[i]
+		seconds %= humanUnitSeconds[i]
+	}
+	if str := lang.humanize(values, negative, o); str != "" {
+		return str
+	}
+	return lang.translate("second", 0)
+}
+
+// gets the values of the human units between the Carbon instance and end,
+// and reports whether end is before the Carbon instance.
+func (c *Carbon) diffValues(end *Carbon) (values [7]int64, negative bool) {
+	start := c
+	if end.Lt(c) {
+		start, end, negative = end, start, true
+	}
+	months := getDiffInMonths(start, end)
+	values[0], values[1] = months/MonthsPerYear, months%MonthsPerYear
+
+	y, m, d, h, i, s, ns := start.DateTimeNano()
+	cursor := time.Date(y, time.Month(m)+time.Month(months), d, h, i, s, ns, start.StdTime().Location())
+	seconds := int64(end.StdTime().Sub(cursor) / time.Second)
+	for j := 2; j < len(humanUnits); j++ {
+		values[j] = seconds / humanUnitSeconds[j]
+		seconds %= humanUnitSeconds[j]
+	}
+	return
+}
+
+// gets the human-readable string of the values of the human units by the options,
+// the first unit is negative if negative is true, it returns an empty string if all values are zero.
+func (lang *Language) humanize(values [7]int64, negative bool, opts DiffOptions) string {
+	parts := opts.Parts
+	if parts < 1 {
+		parts = 1
+	}
+
+	// finds the last unit to show and rounds it by the dropped units
+	last, count := -1, 0
+	for i, value := range values {
+		if value != 0 && count < parts {
+			last, count = i, count+1
+		}
+	}
+	if last == -1 {
+		return ""
+	}
+	var rest int64
+	for i := last + 1; i < len(values); i++ {
+		rest += values[i] * humanUnitSeconds[i]
+	}
+	qualifier := ""
+	if opts.Approximate && rest > 0 {
+		qualifier = "about"
+		if rest*2 >= humanUnitSeconds[last] {
+			qualifier = "almost"
+			values[last]++
+			for i := last; i > 0 && humanUnitCarries[i] > 0 && values[i] >= humanUnitCarries[i]; i-- {
+				values[i] -= humanUnitCarries[i]
+				values[i-1]++
+			}
+		}
+	}
+
+	lang.rw.RLock()
+	resources := lang.resources
+	lang.rw.RUnlock()
+	if len(resources) == 0 {
+		lang.SetLocale(DefaultLocale)
+		lang.rw.RLock()
+		resources = lang.resources
+		lang.rw.RUnlock()
+	}
+
+	var strs []string
+	for i := 0; i <= last; i++ {
+		value := values[i]
+		if value == 0 {
+			continue
+		}
+		if negative && len(strs) == 0 {
+			value = -value
+		}
+		str := ""
+		if opts.Style == DiffStyleShort {
+			str = lang.translate("short_"+humanUnits[i], value)
+		}
+		if str == "" {
+			str = lang.translate(humanUnits[i], value)
+		}
+		strs = append(strs, str)
+	}
+
+	separator, lastSeparator := " ", " "
+	if opts.Join {
+		if joiners := strings.Split(resources["joiners"], "|"); len(joiners) == 2 {
+			separator, lastSeparator = joiners[0], joiners[1]
+		}
+	}
+	str := strs[len(strs)-1]
+	if len(strs) > 1 {
+		str = strings.Join(strs[:len(strs)-1], separator) + lastSeparator + str
+	}
+	if format := resources[qualifier]; qualifier != "" && format != "" {
+		str = strings.Replace(format, "%s", str, 1)
+	}
+	return str
+}
@@ -0,0 +1,41 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func BenchmarkFormatDuration(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		d := 10*24*time.Hour + 23*time.Hour + 59*time.Minute
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			FormatDuration(d, DiffOptions{Parts: 3})
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		d := 10*24*time.Hour + 23*time.Hour + 59*time.Minute
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				FormatDuration(d, DiffOptions{Parts: 3})
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		d := 10*24*time.Hour + 23*time.Hour + 59*time.Minute
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				FormatDuration(d, DiffOptions{Parts: 3})
+			}
+		})
+	})
+}
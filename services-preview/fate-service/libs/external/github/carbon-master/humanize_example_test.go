@@ -0,0 +1,29 @@
+package carbon_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func ExampleFormatDuration() {
+	d := 10*24*time.Hour + 23*time.Hour + 59*time.Minute + 45*time.Second
+
+	fmt.Println(carbon.FormatDuration(d))
+	fmt.Println(carbon.FormatDuration(d, carbon.DiffOptions{Parts: 3}))
+	fmt.Println(carbon.FormatDuration(d, carbon.DiffOptions{Parts: 5, Style: carbon.DiffStyleShort}))
+	fmt.Println(carbon.FormatDuration(d, carbon.DiffOptions{Parts: 3, Join: true}))
+	fmt.Println(carbon.FormatDuration(d, carbon.DiffOptions{Parts: 2, Approximate: true}))
+	fmt.Println(carbon.FormatDuration(90*time.Minute, carbon.DiffOptions{Parts: 2, Locale: "zh-CN"}))
+	fmt.Println(carbon.FormatDuration(0))
+
+	// Output:
+	// 1 week
+	// 1 week 3 days 23 hours
+	// 1w 3d 23h 59m 45s
+	// 1 week, 3 days and 23 hours
+	// almost 1 week 4 days
+	// 1 小时 30 分钟
+	// 0 seconds
+}
@@ -0,0 +1,60 @@
+package carbon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type HumanizeSuite struct {
+	suite.Suite
+}
+
+func TestHumanizeSuite(t *testing.T) {
+	suite.Run(t, new(HumanizeSuite))
+}
+
+func (s *HumanizeSuite) TearDownTest() {
+	ResetDefault()
+}
+
+func (s *HumanizeSuite) TestFormatDuration() {
+	d := 10*24*time.Hour + 23*time.Hour + 59*time.Minute + 45*time.Second
+
+	s.Run("zero duration", func() {
+		s.Equal("0 seconds", FormatDuration(0))
+		s.Equal("0 seconds", FormatDuration(999*time.Millisecond))
+		s.Equal("0 秒", FormatDuration(0, DiffOptions{Locale: "zh-CN"}))
+	})
+
+	s.Run("error locale", func() {
+		s.Empty(FormatDuration(d, DiffOptions{Locale: "xxx"}))
+	})
+
+	s.Run("valid duration", func() {
+		s.Equal("1 week", FormatDuration(d))
+		s.Equal("1 week 3 days 23 hours", FormatDuration(d, DiffOptions{Parts: 3}))
+		s.Equal("1w 3d 23h 59m 45s", FormatDuration(d, DiffOptions{Parts: 5, Style: DiffStyleShort}))
+		s.Equal("1 week, 3 days and 23 hours", FormatDuration(d, DiffOptions{Parts: 3, Join: true}))
+		s.Equal("1 hour 30 minutes", FormatDuration(90*time.Minute, DiffOptions{Parts: 2}))
+		s.Equal("-1 day 2 hours", FormatDuration(-(26*time.Hour+5*time.Second), DiffOptions{Parts: 2}))
+		s.Equal("15250w 1d 23h 47m 16s", FormatDuration(maxDuration, DiffOptions{Parts: 5, Style: DiffStyleShort}))
+		s.Equal("-15250w 1d 23h 47m 16s", FormatDuration(minDuration, DiffOptions{Parts: 5, Style: DiffStyleShort}))
+	})
+
+	s.Run("approximate", func() {
+		s.Equal("almost 1 week 4 days", FormatDuration(d, DiffOptions{Parts: 2, Approximate: true}))
+		s.Equal("about 1 week", FormatDuration(8*24*time.Hour, DiffOptions{Approximate: true}))
+		s.Equal("almost 2 weeks", FormatDuration(d, DiffOptions{Approximate: true}))
+		s.Equal("almost 1 day", FormatDuration(23*time.Hour+59*time.Minute+30*time.Second, DiffOptions{Parts: 2, Approximate: true}))
+		s.Equal("将近1 周 4 天", FormatDuration(d, DiffOptions{Parts: 2, Approximate: true, Locale: "zh-CN"}))
+	})
+
+	s.Run("default locale", func() {
+		SetLocale("zh-CN")
+		s.Equal("1 小时 30 分钟", FormatDuration(90*time.Minute, DiffOptions{Parts: 2}))
+		s.Equal("1小时 30分", FormatDuration(90*time.Minute, DiffOptions{Parts: 2, Style: DiffStyleShort}))
+		s.Equal("1週 3天 23小時", FormatDuration(d, DiffOptions{Parts: 3, Style: DiffStyleShort, Locale: "zh-TW"}))
+	})
+}
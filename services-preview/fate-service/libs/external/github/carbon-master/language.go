@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
-	"strings"
 	"sync"
 )
 
@@ -164,17 +163,49 @@ func (lang *Language) translate(unit string, value int64) string {
 	if !exists {
 		return ""
 	}
-	slice := strings.Split(resource, "|")
-	number := getAbsValue(value)
-	str := strconv.FormatInt(value, 10)
-	if len(slice) == 1 {
-		return strings.Replace(slice[0], "%d", str, 1)
+	return selectPluralForm(resource, value, lang.PluralCategory(value))
+}
+
+// PluralCategory gets the CLDR cardinal plural category of a number like "one", "few" or "other" in the language.
+func (lang *Language) PluralCategory(n int64) string {
+	if lang == nil {
+		return PluralOther
+	}
+	lang.rw.RLock()
+	locale := lang.locale
+	lang.rw.RUnlock()
+	return pluralRuleSetOf(locale, false).category(n)
+}
+
+// OrdinalCategory gets the CLDR ordinal plural category of a number like "one", "two" or "other" in the language.
+func (lang *Language) OrdinalCategory(n int64) string {
+	if lang == nil {
+		return PluralOther
+	}
+	lang.rw.RLock()
+	locale := lang.locale
+	lang.rw.RUnlock()
+	return pluralRuleSetOf(locale, true).category(n)
+}
+
+// Ordinal gets the ordinal of a number like "1st", "1er" or "第1" in the language.
+func (lang *Language) Ordinal(n int64) string {
+	if lang == nil {
+		return ""
 	}
-	if int64(len(slice)) <= number {
-		return strings.Replace(slice[len(slice)-1], "%d", str, 1)
+	str := strconv.FormatInt(n, 10)
+	lang.rw.RLock()
+	resources := lang.resources
+	lang.rw.RUnlock()
+	if len(resources) == 0 {
+		lang.SetLocale(DefaultLocale)
+		lang.rw.RLock()
+		resources = lang.resources
+		lang.rw.RUnlock()
 	}
-	if !strings.Contains(slice[number-1], "%d") && value < 0 {
-		return "-" + slice[number-1]
+	resource, exists := resources["ordinals"]
+	if !exists || resource == "" {
+		return str
 	}
-	return strings.Replace(slice[number-1], "%d", str, 1)
+	return selectPluralForm(resource, n, lang.OrdinalCategory(n))
 }
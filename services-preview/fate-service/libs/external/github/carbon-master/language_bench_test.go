@@ -155,3 +155,114 @@ func BenchmarkLanguage_translate(b *testing.B) {
 		})
 	})
 }
+
+func BenchmarkLanguage_PluralCategory(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		lang := NewLanguage()
+		lang.SetLocale("en")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			lang.PluralCategory(22)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		lang := NewLanguage()
+		lang.SetLocale("en")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				lang.PluralCategory(22)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		lang := NewLanguage()
+		lang.SetLocale("en")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				lang.PluralCategory(22)
+			}
+		})
+	})
+}
+
+func BenchmarkLanguage_OrdinalCategory(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		lang := NewLanguage()
+		lang.SetLocale("en")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			lang.OrdinalCategory(22)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		lang := NewLanguage()
+		lang.SetLocale("en")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				lang.OrdinalCategory(22)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		lang := NewLanguage()
+		lang.SetLocale("en")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				lang.OrdinalCategory(22)
+			}
+		})
+	})
+}
+
+func BenchmarkLanguage_Ordinal(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		lang := NewLanguage()
+		lang.SetLocale("en")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			lang.Ordinal(22)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		lang := NewLanguage()
+		lang.SetLocale("en")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				lang.Ordinal(22)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		lang := NewLanguage()
+		lang.SetLocale("en")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				lang.Ordinal(22)
+			}
+		})
+	})
+}
@@ -109,3 +109,34 @@ func ExampleLanguage_SetResources() {
 	// lang2:Wednesday
 	// lang2:Wed
 }
+
+func ExampleLanguage_PluralCategory() {
+	fmt.Println(carbon.NewLanguage().SetLocale("en").PluralCategory(1))
+	fmt.Println(carbon.NewLanguage().SetLocale("ru").PluralCategory(22))
+	fmt.Println(carbon.NewLanguage().SetLocale("ar").PluralCategory(0))
+
+	// Output:
+	// one
+	// few
+	// zero
+}
+
+func ExampleLanguage_OrdinalCategory() {
+	fmt.Println(carbon.NewLanguage().SetLocale("en").OrdinalCategory(22))
+	fmt.Println(carbon.NewLanguage().SetLocale("it").OrdinalCategory(11))
+
+	// Output:
+	// two
+	// many
+}
+
+func ExampleLanguage_Ordinal() {
+	fmt.Println(carbon.NewLanguage().SetLocale("en").Ordinal(23))
+	fmt.Println(carbon.NewLanguage().SetLocale("fr").Ordinal(1))
+	fmt.Println(carbon.NewLanguage().SetLocale("zh-CN").Ordinal(5))
+
+	// Output:
+	// 23rd
+	// 1er
+	// 第5
+}
@@ -297,6 +297,15 @@ func (s *LanguageSuite) TestLanguage_translate() {
 		s.Equal("10 months", lang.translate("month", 10))
 	})
 
+	s.Run("zero value", func() {
+		lang := NewLanguage()
+		lang.SetResources(map[string]string{
+			"month": "1 month|%d months",
+		})
+		// getAbsValue(0) = 0, there is no slice[-1], use slice[len(slice)-1]
+		s.Equal("0 months", lang.translate("month", 0))
+	})
+
 	s.Run("negative value without placeholder", func() {
 		lang := NewLanguage()
 		lang.SetResources(map[string]string{
@@ -362,3 +371,135 @@ func (s *LanguageSuite) TestLanguage_translate() {
 		s.NotEmpty(result) // Should have loaded default locale successfully
 	})
 }
+
+func (s *LanguageSuite) TestLanguage_translatePlural() {
+	s.Run("categorized resources", func() {
+		lang := NewLanguage()
+		lang.SetLocale("ru")
+		s.Equal("1 год", lang.translate("year", 1))
+		s.Equal("2 года", lang.translate("year", 2))
+		s.Equal("5 лет", lang.translate("year", 5))
+		s.Equal("11 лет", lang.translate("year", 11))
+		s.Equal("21 год", lang.translate("year", 21))
+		s.Equal("22 года", lang.translate("year", 22))
+		s.Equal("-21 год", lang.translate("year", -21))
+	})
+
+	s.Run("fallback to other", func() {
+		lang := NewLanguage()
+		lang.SetLocale("pl")
+		lang.SetResources(map[string]string{
+			"month": "one:%d miesiąc|other:%d miesięcy",
+		})
+		s.Equal("1 miesiąc", lang.translate("month", 1))
+		s.Equal("2 miesięcy", lang.translate("month", 2))
+	})
+
+	s.Run("fallback to last form", func() {
+		lang := NewLanguage()
+		lang.SetLocale("ru")
+		lang.SetResources(map[string]string{
+			"month": "one:%d месяц|few:%d месяца",
+		})
+		s.Equal("5 месяца", lang.translate("month", 5))
+	})
+
+	s.Run("arabic dual", func() {
+		lang := NewLanguage()
+		lang.SetLocale("ar")
+		s.Equal("2 سنتان", lang.translate("year", 2))
+		s.Equal("3 سنوات", lang.translate("year", 3))
+		s.Equal("11 سنة", lang.translate("year", 11))
+	})
+
+	s.Run("non category prefix", func() {
+		lang := NewLanguage()
+		lang.SetResources(map[string]string{
+			"hour": "time:1 hour|%d hours",
+		})
+		s.Equal("time:1 hour", lang.translate("hour", 1))
+		s.Equal("5 hours", lang.translate("hour", 5))
+	})
+}
+
+func (s *LanguageSuite) TestLanguage_PluralCategory() {
+	s.Run("nil language", func() {
+		var lang *Language
+		s.Equal(PluralOther, lang.PluralCategory(1))
+	})
+
+	s.Run("unknown locale", func() {
+		lang := NewLanguage()
+		lang.locale = "xx"
+		s.Equal(PluralOther, lang.PluralCategory(1))
+	})
+
+	s.Run("valid locale", func() {
+		s.Equal(PluralOne, NewLanguage().SetLocale("en").PluralCategory(1))
+		s.Equal(PluralOther, NewLanguage().SetLocale("en").PluralCategory(0))
+		s.Equal(PluralOne, NewLanguage().SetLocale("fr").PluralCategory(0))
+		s.Equal(PluralMany, NewLanguage().SetLocale("fr").PluralCategory(1000000))
+		s.Equal(PluralOther, NewLanguage().SetLocale("zh-CN").PluralCategory(1))
+		s.Equal(PluralOne, NewLanguage().SetLocale("se").PluralCategory(1))
+		s.Equal(PluralOne, NewLanguage().SetLocale("ms-MY").OrdinalCategory(1))
+		s.Equal(PluralZero, NewLanguage().SetLocale("ar").PluralCategory(0))
+		s.Equal(PluralMany, NewLanguage().SetLocale("ar").PluralCategory(99))
+		s.Equal(PluralOther, NewLanguage().SetLocale("ar").PluralCategory(100))
+		s.Equal(PluralFew, NewLanguage().SetLocale("ro").PluralCategory(119))
+		s.Equal(PluralOther, NewLanguage().SetLocale("ro").PluralCategory(120))
+		s.Equal(PluralMany, NewLanguage().SetLocale("pl").PluralCategory(21))
+		s.Equal(PluralFew, NewLanguage().SetLocale("uk").PluralCategory(-3))
+	})
+}
+
+func (s *LanguageSuite) TestLanguage_OrdinalCategory() {
+	s.Run("nil language", func() {
+		var lang *Language
+		s.Equal(PluralOther, lang.OrdinalCategory(1))
+	})
+
+	s.Run("valid locale", func() {
+		lang := NewLanguage().SetLocale("en")
+		s.Equal(PluralOne, lang.OrdinalCategory(21))
+		s.Equal(PluralTwo, lang.OrdinalCategory(102))
+		s.Equal(PluralFew, lang.OrdinalCategory(3))
+		s.Equal(PluralOther, lang.OrdinalCategory(13))
+		s.Equal(PluralMany, NewLanguage().SetLocale("it").OrdinalCategory(800))
+		s.Equal(PluralOne, NewLanguage().SetLocale("hu").OrdinalCategory(5))
+		s.Equal(PluralOther, NewLanguage().SetLocale("ru").OrdinalCategory(1))
+	})
+}
+
+func (s *LanguageSuite) TestLanguage_Ordinal() {
+	s.Run("nil language", func() {
+		var lang *Language
+		s.Empty(lang.Ordinal(1))
+	})
+
+	s.Run("empty resources", func() {
+		lang := NewLanguage()
+		s.Equal("1st", lang.Ordinal(1))
+	})
+
+	s.Run("without ordinals", func() {
+		s.Equal("1", NewLanguage().SetLocale("th").Ordinal(1))
+	})
+
+	s.Run("valid locale", func() {
+		lang := NewLanguage().SetLocale("en")
+		s.Equal("1st", lang.Ordinal(1))
+		s.Equal("2nd", lang.Ordinal(2))
+		s.Equal("3rd", lang.Ordinal(3))
+		s.Equal("4th", lang.Ordinal(4))
+		s.Equal("11th", lang.Ordinal(11))
+		s.Equal("12th", lang.Ordinal(12))
+		s.Equal("13th", lang.Ordinal(13))
+		s.Equal("21st", lang.Ordinal(21))
+		s.Equal("1er", NewLanguage().SetLocale("fr").Ordinal(1))
+		s.Equal("2e", NewLanguage().SetLocale("fr").Ordinal(2))
+		s.Equal("5.", NewLanguage().SetLocale("de").Ordinal(5))
+		s.Equal("第5", NewLanguage().SetLocale("zh-CN").Ordinal(5))
+		s.Equal("2:a", NewLanguage().SetLocale("se").Ordinal(2))
+		s.Equal("3:e", NewLanguage().SetLocale("se").Ordinal(3))
+	})
+}
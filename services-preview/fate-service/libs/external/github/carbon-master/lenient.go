@@ -0,0 +1,359 @@
+package carbon
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/dromara/carbon/v2/calendar/lunar"
+)
+
+// DateOrder defines a DateOrder type which is the order of day and month in numeric dates like "03/04/2025".
+type DateOrder string
+
+// date order constants
+const (
+	DayFirst   DateOrder = "day-first"   // like "03/04/2025" is April 3
+	MonthFirst DateOrder = "month-first" // like "03/04/2025" is March 4
+)
+
+// locales putting month before day in numeric dates, the other locales put day first
+var monthFirstLocales = map[string]bool{
+	"en": true, "zh-CN": true, "zh-TW": true, "ja": true, "ko": true, "hu": true, "mn": true, "fa": true,
+}
+
+// max years searched around the base for lunar dates without a year, leap months are rare
+const maxLunarYearOffset = 100
+
+// prefixes of the lunar dates like "农历三月初四"
+var lunarDatePrefixes = []string{"农历", "農曆", "阴历", "陰曆"}
+
+// layouts of the numeric dates whose day and month are in the given order
+var (
+	monthFirstLayouts = getNumericDateLayouts("1", "2")
+	dayFirstLayouts   = getNumericDateLayouts("2", "1")
+)
+
+// layouts of the chinese dates whose numerals are converted to arabic numerals
+var chineseDateLayouts = []string{
+	"2006年1月2日", "2006年1月2日15时", "2006年1月2日15时4分", "2006年1月2日15时4分5秒",
+	"2006年1月2日15:4", "2006年1月2日15:4:5", "2006年1月",
+}
+
+// ParseHints defines a ParseHints struct which guides ParseDetailed.
+type ParseHints struct {
+	// Timezone is the timezone of the value, DefaultTimezone by default.
+	Timezone string
+	// Locale decides the order of day and month in numeric dates, DefaultLocale by default.
+	Locale string
+	// Order overrides the order of day and month decided by the locale.
+	Order DateOrder
+	// Layouts are tried before the default layouts.
+	Layouts []string
+	// Base picks the occurrence of lunar dates without a year like "农历三月初四" in its year,
+	// or the nearest one if there is none, now by default.
+	Base *Carbon
+}
+
+// ParseCandidate defines a ParseCandidate struct which is a layout matching the value and its result,
+// the layout of a lunar date is empty.
+type ParseCandidate struct {
+	Layout string
+	Carbon *Carbon
+}
+
+// ParseDetail defines a ParseDetail struct which is the result of ParseDetailed.
+type ParseDetail struct {
+	// Carbon is the preferred candidate.
+	Carbon *Carbon
+	// Candidates are all candidates in the order of preference.
+	Candidates []ParseCandidate
+	// IsAmbiguous reports whether the candidates denote different instants.
+	IsAmbiguous bool
+	// Confidence is the share of the candidates which denote the same instant as the preferred one.
+	Confidence float64
+	Error      error
+}
+
+// ParseDetailed parses a time string by all default layouts and reports every matching layout instead of the first one,
+// numeric dates like "03/04/2025" prefer the order of day and month of the locale and are reported as ambiguous,
+// chinese dates like "二〇二五年三月四日" and lunar dates like "农历三月初四" are also supported.
+func ParseDetailed(value string, hints ...ParseHints) *ParseDetail {
+	var h ParseHints
+	if len(hints) > 0 {
+		h = hints[0]
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return &ParseDetail{Carbon: &Carbon{isEmpty: true}}
+	}
+	var timezone []string
+	if h.Timezone != "" {
+		timezone = append(timezone, h.Timezone)
+	}
+	loc, err := parseTimezone(timezone...)
+	if err != nil {
+		return &ParseDetail{Carbon: &Carbon{Error: err}, Error: err}
+	}
+
+	var candidates []ParseCandidate
+	if c, ok := parseLunarDate(value, loc, h.Base); ok {
+		if c.HasError() {
+			return &ParseDetail{Carbon: c, Error: c.Error}
+		}
+		candidates = append(candidates, ParseCandidate{Carbon: c})
+	} else {
+		normalized := normalizeDateValue(value)
+		for _, layout := range getDetailedLayouts(h) {
+			if tt, err := time.ParseInLocation(layout, normalized, loc); err == nil {
+				c := NewCarbon().SetLocation(loc)
+				c.time = tt
+				c.currentLayout = layout
+				candidates = append(candidates, ParseCandidate{Layout: layout, Carbon: c})
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		err = ErrFailedParse(value)
+		return &ParseDetail{Carbon: &Carbon{Error: err}, Error: err}
+	}
+
+	d := &ParseDetail{Carbon: candidates[0].Carbon, Candidates: candidates}
+	agreed := 0
+	for _, candidate := range candidates {
+		if candidate.Carbon.StdTime().Equal(d.Carbon.StdTime()) {
+			agreed++
+		} else {
+			d.IsAmbiguous = true
+		}
+	}
+	d.Confidence = float64(agreed) / float64(len(candidates))
+	return d
+}
+
+// gets the layouts tried by ParseDetailed in the order of preference without duplicates.
+func getDetailedLayouts(h ParseHints) []string {
+	order := h.Order
+	if order == "" {
+		locale := h.Locale
+		if locale == "" {
+			locale = DefaultLocale
+		}
+		order = DayFirst
+		if monthFirstLocales[locale] {
+			order = MonthFirst
+		}
+	}
+	groups := [][]string{h.Layouts, monthFirstLayouts, dayFirstLayouts, defaultLayouts, chineseDateLayouts}
+	if order == DayFirst {
+		groups[1], groups[2] = dayFirstLayouts, monthFirstLayouts
+	}
+
+	seen := make(map[string]bool)
+	var layouts []string
+	for _, group := range groups {
+		for _, layout := range group {
+			if !seen[layout] {
+				seen[layout] = true
+				layouts = append(layouts, layout)
+			}
+		}
+	}
+	return layouts
+}
+
+// gets the layouts of numeric dates like "1/2/2006" by the layouts of the first and second element.
+func getNumericDateLayouts(first, second string) (layouts []string) {
+	for _, sep := range []string{"/", "-", "."} {
+		for _, clock := range []string{"", " 15:4", " 15:4:5", " 15:4:5.999999999"} {
+			layouts = append(layouts, first+sep+second+sep+"2006"+clock)
+		}
+	}
+	return
+}
+
+// normalizes a date string by converting full-width characters and chinese numerals like "二〇二五年三月四日"
+// to ascii characters and arabic numerals, and removing the spaces after the chinese date words.
+func normalizeDateValue(value string) string {
+	var b strings.Builder
+	var numeral []rune
+	flush := func() {
+		if len(numeral) == 0 {
+			return
+		}
+		if n, ok := parseChineseNumber(string(numeral)); ok {
+			b.WriteString(strconv.Itoa(n))
+		} else {
+			b.WriteString(string(numeral))
+		}
+		numeral = numeral[:0]
+	}
+
+	runes := []rune(value)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		_, isDigit := chineseDigits[r]
+		_, isUnit := chineseUnits[r]
+		if isDigit || isUnit {
+			numeral = append(numeral, r)
+			continue
+		}
+		flush()
+		switch {
+		case unicode.IsSpace(r) && i > 0 && strings.ContainsRune("年月日号號时点點時分秒", runes[i-1]):
+			continue
+		case r >= '！' && r <= '～':
+			// full-width ascii characters are shifted by 0xFEE0
+			b.WriteRune(r - 0xFEE0)
+		case r == '\u3000':
+			b.WriteRune(' ')
+		case r == '号' || r == '號':
+			b.WriteRune('日')
+		case r == '点' || r == '點' || r == '時':
+			b.WriteRune('时')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	flush()
+	return b.String()
+}
+
+// parses a lunar date like "农历三月初四", "农历2025年闰六月十五" or "農曆二〇二五年腊月廿三" as a Carbon instance,
+// it reports false if the value isn't a lunar date.
+func parseLunarDate(value string, loc *Location, base *Carbon) (*Carbon, bool) {
+	var rest string
+	for _, prefix := range lunarDatePrefixes {
+		if strings.HasPrefix(value, prefix) {
+			rest = strings.TrimSpace(strings.TrimPrefix(value, prefix))
+			break
+		}
+	}
+	if rest == "" {
+		return nil, false
+	}
+	failed := &Carbon{Error: ErrFailedParse(value)}
+
+	year := 0
+	if before, after, found := strings.Cut(rest, "年"); found {
+		n, ok := parseDateNumber(before)
+		if !ok {
+			return failed, true
+		}
+		year, rest = n, after
+	}
+
+	isLeapMonth := false
+	for _, leap := range []string{"闰", "閏"} {
+		if strings.HasPrefix(rest, leap) {
+			isLeapMonth, rest = true, strings.TrimPrefix(rest, leap)
+		}
+	}
+	before, after, found := strings.Cut(rest, "月")
+	if !found {
+		return failed, true
+	}
+	month, ok := 0, false
+	switch before {
+	case "正":
+		month, ok = 1, true
+	case "冬":
+		month, ok = 11, true
+	case "腊", "臘":
+		month, ok = 12, true
+	default:
+		month, ok = parseDateNumber(before)
+	}
+	if !ok {
+		return failed, true
+	}
+
+	day, ok := parseLunarDay(strings.TrimSuffix(strings.TrimSuffix(after, "日"), "号"))
+	if !ok {
+		return failed, true
+	}
+	if month < 1 || month > 12 || day < 1 || day > 30 {
+		return failed, true
+	}
+	if year > 0 {
+		c := lunarToCarbon(year, month, day, isLeapMonth, loc)
+		if c == nil {
+			return failed, true
+		}
+		return c, true
+	}
+
+	// the occurrence in the year of base, or the nearest one as a leap month may be years away
+	if base == nil {
+		base = Now(loc.String())
+	}
+	if base.IsInvalid() {
+		return failed, true
+	}
+	var inYear, nearest *Carbon
+	year = base.Lunar().Year()
+	for offset := 0; offset <= maxLunarYearOffset; offset++ {
+		for _, y := range []int{year - offset, year + offset} {
+			c := lunarToCarbon(y, month, day, isLeapMonth, loc)
+			if c == nil {
+				continue
+			}
+			if nearest == nil || c.DiffAbsInSeconds(base) < nearest.DiffAbsInSeconds(base) {
+				nearest = c
+			}
+			if c.Year() == base.Year() && (inYear == nil || c.DiffAbsInSeconds(base) < inYear.DiffAbsInSeconds(base)) {
+				inYear = c
+			}
+		}
+		if offset == 0 || nearest == nil {
+			continue
+		}
+		if inYear != nil {
+			return inYear, true
+		}
+		return nearest, true
+	}
+	return failed, true
+}
+
+// converts a lunar date as a Carbon instance at the start of the day in loc, it returns nil if the date doesn't exist.
+func lunarToCarbon(year, month, day int, isLeapMonth bool, loc *Location) *Carbon {
+	l := lunar.NewLunar(year, month, day, isLeapMonth)
+	if !l.IsValid() || (isLeapMonth && l.LeapMonth() != month) {
+		return nil
+	}
+	g := l.ToGregorian(PRC)
+	if g.Error != nil || g.Time.IsZero() {
+		return nil
+	}
+	y, m, d := g.Time.Date()
+	return NewCarbon(time.Date(y, m, d, 0, 0, 0, 0, loc))
+}
+
+// parses a lunar day like "初四", "十五", "廿三", "卅" or "4".
+func parseLunarDay(value string) (int, bool) {
+	switch {
+	case strings.HasPrefix(value, "初"):
+		n, ok := parseChineseNumber(strings.TrimPrefix(value, "初"))
+		return n, ok && n >= 1 && n <= 10
+	case strings.HasPrefix(value, "廿"):
+		rest := strings.TrimPrefix(value, "廿")
+		if rest == "" {
+			return 20, true
+		}
+		n, ok := parseChineseNumber(rest)
+		return 20 + n, ok && n >= 1 && n <= 9
+	case value == "卅":
+		return 30, true
+	}
+	return parseDateNumber(value)
+}
+
+// parses a number of a date like "2025", "二〇二五" or "十二".
+func parseDateNumber(value string) (int, bool) {
+	if n, err := strconv.Atoi(value); err == nil {
+		return n, true
+	}
+	return parseChineseNumber(value)
+}
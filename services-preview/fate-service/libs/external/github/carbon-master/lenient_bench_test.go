@@ -0,0 +1,37 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+)
+
+func BenchmarkParseDetailed(b *testing.B) {
+	hints := ParseHints{Timezone: PRC, Locale: "en"}
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			ParseDetailed("03/04/2025", hints)
+		}
+	})
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ParseDetailed("03/04/2025", hints)
+			}()
+		}
+		wg.Wait()
+	})
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				ParseDetailed("03/04/2025", hints)
+			}
+		})
+	})
+}
@@ -0,0 +1,38 @@
+package carbon_test
+
+import (
+	"fmt"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func ExampleParseDetailed() {
+	d := carbon.ParseDetailed("03/04/2025", carbon.ParseHints{Timezone: carbon.PRC, Locale: "en"})
+	fmt.Println(d.Carbon.ToDateString(), d.IsAmbiguous, d.Confidence)
+	for _, candidate := range d.Candidates {
+		fmt.Println(candidate.Layout, candidate.Carbon.ToDateString())
+	}
+
+	d = carbon.ParseDetailed("03/04/2025", carbon.ParseHints{Timezone: carbon.PRC, Locale: "fr"})
+	fmt.Println(d.Carbon.ToDateString(), d.IsAmbiguous)
+
+	d = carbon.ParseDetailed("13/04/2025", carbon.ParseHints{Timezone: carbon.PRC, Locale: "en"})
+	fmt.Println(d.Carbon.ToDateString(), d.IsAmbiguous)
+
+	fmt.Println(carbon.ParseDetailed("二〇二五年三月四日", carbon.ParseHints{Timezone: carbon.PRC}).Carbon.ToDateString())
+
+	base := carbon.Parse("2025-06-01", carbon.PRC)
+	fmt.Println(carbon.ParseDetailed("农历三月初四", carbon.ParseHints{Timezone: carbon.PRC, Base: base}).Carbon.ToDateString())
+
+	fmt.Println(carbon.ParseDetailed("xxx").Error)
+
+	// Output:
+	// 2025-03-04 true 0.5
+	// 1/2/2006 2025-03-04
+	// 2/1/2006 2025-04-03
+	// 2025-04-03 true
+	// 2025-04-13 false
+	// 2025-03-04
+	// 2025-04-01
+	// failed to parse xxx as carbon
+}
@@ -0,0 +1,144 @@
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LenientSuite struct {
+	suite.Suite
+}
+
+func TestLenientSuite(t *testing.T) {
+	suite.Run(t, new(LenientSuite))
+}
+
+func (s *LenientSuite) TearDownTest() {
+	ResetDefault()
+}
+
+func (s *LenientSuite) TestParseDetailed() {
+	base := Parse("2025-06-01", PRC)
+
+	s.Run("empty value", func() {
+		d := ParseDetailed("")
+		s.True(d.Carbon.IsEmpty())
+		s.Empty(d.Candidates)
+		s.Nil(d.Error)
+
+		s.True(ParseDetailed("  ").Carbon.IsEmpty())
+	})
+
+	s.Run("error value", func() {
+		d := ParseDetailed("xxx")
+		s.Equal(ErrFailedParse("xxx"), d.Error)
+		s.Equal(d.Error, d.Carbon.Error)
+		s.Empty(d.Candidates)
+		s.Zero(d.Confidence)
+
+		s.Error(ParseDetailed("32/13/2025").Error)
+		s.Error(ParseDetailed("2025-03-04", ParseHints{Timezone: "xxx"}).Error)
+	})
+
+	s.Run("ambiguous value", func() {
+		d := ParseDetailed("03/04/2025", ParseHints{Timezone: PRC, Locale: "en"})
+		s.Nil(d.Error)
+		s.True(d.IsAmbiguous)
+		s.Equal(0.5, d.Confidence)
+		s.Equal("2025-03-04 00:00:00 +0800 CST", d.Carbon.ToString())
+		s.Len(d.Candidates, 2)
+		s.Equal("1/2/2006", d.Candidates[0].Layout)
+		s.Equal("2/1/2006", d.Candidates[1].Layout)
+		s.Equal("2025-04-03 00:00:00 +0800 CST", d.Candidates[1].Carbon.ToString())
+
+		d = ParseDetailed("03/04/2025", ParseHints{Timezone: PRC, Locale: "fr"})
+		s.True(d.IsAmbiguous)
+		s.Equal("2025-04-03 00:00:00 +0800 CST", d.Carbon.ToString())
+		s.Equal("2/1/2006", d.Carbon.CurrentLayout())
+
+		d = ParseDetailed("03.04.2025 13:14:15", ParseHints{Timezone: PRC, Locale: "en", Order: DayFirst})
+		s.True(d.IsAmbiguous)
+		s.Equal("2025-04-03 13:14:15 +0800 CST", d.Carbon.ToString())
+	})
+
+	s.Run("unambiguous value", func() {
+		d := ParseDetailed("13/04/2025", ParseHints{Timezone: PRC, Locale: "en"})
+		s.False(d.IsAmbiguous)
+		s.Equal(1.0, d.Confidence)
+		s.Equal("2025-04-13 00:00:00 +0800 CST", d.Carbon.ToString())
+
+		d = ParseDetailed("2025-03-04 13:14:15", ParseHints{Timezone: PRC})
+		s.False(d.IsAmbiguous)
+		s.Equal(1.0, d.Confidence)
+		s.Equal("2025-03-04 13:14:15 +0800 CST", d.Carbon.ToString())
+
+		d = ParseDetailed("04/03/2025", ParseHints{Timezone: PRC, Locale: "en", Layouts: []string{"02/01/2006"}})
+		s.True(d.IsAmbiguous)
+		s.Equal("02/01/2006", d.Carbon.CurrentLayout())
+		s.Equal("2025-03-04 00:00:00 +0800 CST", d.Carbon.ToString())
+	})
+
+	s.Run("default locale", func() {
+		SetLocale("fr")
+		s.Equal("2025-04-03", ParseDetailed("03/04/2025").Carbon.ToDateString())
+		SetLocale("zh-CN")
+		s.Equal("2025-03-04", ParseDetailed("03/04/2025").Carbon.ToDateString())
+	})
+
+	s.Run("chinese value", func() {
+		s.Equal("2025-03-04 00:00:00 +0800 CST", ParseDetailed("二〇二五年三月四日", ParseHints{Timezone: PRC}).Carbon.ToString())
+		s.Equal("2025-03-04 00:00:00 +0800 CST", ParseDetailed("2025年3月4号", ParseHints{Timezone: PRC}).Carbon.ToString())
+		s.Equal("2025-12-01 00:00:00 +0800 CST", ParseDetailed("二〇二五年十二月", ParseHints{Timezone: PRC}).Carbon.ToString())
+		s.Equal("2025-03-04 15:30:00 +0800 CST", ParseDetailed("二〇二五年三月四日 十五点三十分", ParseHints{Timezone: PRC}).Carbon.ToString())
+		s.Equal("2025-03-04 13:14:15 +0800 CST", ParseDetailed("2025年3月4日 13:14:15", ParseHints{Timezone: PRC}).Carbon.ToString())
+		s.Equal("2025-03-04 00:00:00 +0800 CST", ParseDetailed("２０２５／０３／０４", ParseHints{Timezone: PRC}).Carbon.ToString())
+		s.Equal("2025-03-04 13:14:00 +0800 CST", ParseDetailed("２０２５－０３－０４　１３：１４", ParseHints{Timezone: PRC}).Carbon.ToString())
+
+		s.Error(ParseDetailed("二〇二五年十三月四日").Error)
+		s.Error(ParseDetailed("二〇二五年三月四日 下午").Error)
+	})
+
+	s.Run("lunar value", func() {
+		d := ParseDetailed("农历三月初四", ParseHints{Timezone: PRC, Base: base})
+		s.Nil(d.Error)
+		s.False(d.IsAmbiguous)
+		s.Equal(1.0, d.Confidence)
+		s.Empty(d.Candidates[0].Layout)
+		s.Equal("2025-04-01 00:00:00 +0800 CST", d.Carbon.ToString())
+
+		s.Equal("2025-04-01 00:00:00 +0800 CST", ParseDetailed("农历2025年3月4日", ParseHints{Timezone: PRC}).Carbon.ToString())
+		s.Equal("2026-02-10 00:00:00 +0800 CST", ParseDetailed("農曆二〇二五年臘月廿三", ParseHints{Timezone: PRC}).Carbon.ToString())
+		s.Equal("2025-08-08 00:00:00 +0800 CST", ParseDetailed("阴历2025年闰六月十五", ParseHints{Timezone: PRC}).Carbon.ToString())
+		s.Equal("2025-01-29 00:00:00 +0800 CST", ParseDetailed("农历 正月初一", ParseHints{Timezone: PRC, Base: base}).Carbon.ToString())
+		s.Equal("2025-12-20 00:00:00 +0800 CST", ParseDetailed("农历冬月初一", ParseHints{Timezone: PRC, Base: base}).Carbon.ToString())
+		s.Equal("2025-05-16 00:00:00 +0800 CST", ParseDetailed("农历四月十九", ParseHints{Timezone: PRC, Base: base}).Carbon.ToString())
+		s.Equal("2025-05-17 00:00:00 +0800 CST", ParseDetailed("农历四月二十", ParseHints{Timezone: PRC, Base: base}).Carbon.ToString())
+		s.Equal("2025-05-17 00:00:00 +0800 CST", ParseDetailed("农历四月廿", ParseHints{Timezone: PRC, Base: base}).Carbon.ToString())
+		s.Equal("2025-07-24 00:00:00 +0800 CST", ParseDetailed("农历六月卅", ParseHints{Timezone: PRC, Base: base}).Carbon.ToString())
+		s.False(ParseDetailed("农历三月初四").Carbon.IsInvalid())
+
+		s.Equal("2025-04-01 00:00:00 +0000 UTC", ParseDetailed("农历三月初四", ParseHints{Timezone: UTC, Base: Parse("2025-06-01", UTC)}).Carbon.ToString())
+		s.Equal("2025-04-01 00:00:00 +0000 UTC", ParseDetailed("农历2025年3月4日", ParseHints{Timezone: UTC}).Carbon.ToString())
+
+		newYear := Parse("2025-01-01", PRC)
+		s.Equal("2025-04-01 00:00:00 +0800 CST", ParseDetailed("农历三月初四", ParseHints{Timezone: PRC, Base: newYear}).Carbon.ToString())
+		s.Equal("2025-07-25 00:00:00 +0800 CST", ParseDetailed("农历闰六月初一", ParseHints{Timezone: PRC, Base: newYear}).Carbon.ToString())
+		s.Equal("2024-12-31 00:00:00 +0800 CST", ParseDetailed("农历腊月初一", ParseHints{Timezone: PRC, Base: newYear}).Carbon.ToString())
+		s.Equal("2025-01-29 00:00:00 +0800 CST", ParseDetailed("农历正月初一", ParseHints{Timezone: PRC, Base: newYear}).Carbon.ToString())
+		s.Equal("2023-03-22 00:00:00 +0800 CST", ParseDetailed("农历闰二月初一", ParseHints{Timezone: PRC, Base: base}).Carbon.ToString())
+
+		s.Error(ParseDetailed("农历").Error)
+		s.Error(ParseDetailed("农历xx年三月初四").Error)
+		s.Error(ParseDetailed("农历三月初四", ParseHints{Base: &Carbon{Error: ErrFailedParse("xxx")}}).Error)
+		s.Error(ParseDetailed("农历2025年三").Error)
+		s.Error(ParseDetailed("农历2025年xx月初四").Error)
+		s.Error(ParseDetailed("农历2025年十三月初一").Error)
+		s.Error(ParseDetailed("农历2025年三月初十一").Error)
+		s.Error(ParseDetailed("农历2025年三月廿十").Error)
+		s.Error(ParseDetailed("农历2025年三月xx").Error)
+		s.Error(ParseDetailed("农历2025年闰三月初四").Error)
+		s.Error(ParseDetailed("农历闰腊月初一", ParseHints{Base: base}).Error)
+		s.Error(ParseDetailed("农历10000年三月初四").Error)
+	})
+}
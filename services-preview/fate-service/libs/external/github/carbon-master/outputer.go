@@ -241,6 +241,56 @@ func (c *Carbon) ToDateString(timezone ...string) string {
 	return c.StdTime().Format(DateLayout)
 }
 
+// ToIso8601WeekString outputs a string in ISO 8601 week date format like "2020-W32-3".
+func (c *Carbon) ToIso8601WeekString(timezone ...string) string {
+	if len(timezone) > 0 {
+		c.loc, c.Error = parseTimezone(timezone...)
+	}
+	if c.IsInvalid() {
+		return ""
+	}
+	year, week := c.StdTime().ISOWeek()
+	return fmt.Sprintf("%04d-W%02d-%d", year, week, c.ISOWeekday())
+}
+
+// ToLocaleDateString outputs a string in the date format of the locale like "Aug 5, 2020" or "2020年8月5日",
+// which falls back to "2006-01-02" layout if the locale has no date format.
+func (c *Carbon) ToLocaleDateString(timezone ...string) string {
+	if len(timezone) > 0 {
+		c.loc, c.Error = parseTimezone(timezone...)
+	}
+	if c.IsInvalid() {
+		return ""
+	}
+	return c.Format(c.localeFormat("date_format", DateFormat))
+}
+
+// ToLocaleDateTimeString outputs a string in the datetime format of the locale like "Aug 5, 2020, 1:14:15 PM",
+// which falls back to "2006-01-02 15:04:05" layout if the locale has no datetime format.
+func (c *Carbon) ToLocaleDateTimeString(timezone ...string) string {
+	if len(timezone) > 0 {
+		c.loc, c.Error = parseTimezone(timezone...)
+	}
+	if c.IsInvalid() {
+		return ""
+	}
+	return c.Format(c.localeFormat("datetime_format", DateTimeFormat))
+}
+
+// gets a format from the language resources, or the fallback format if it doesn't exist.
+func (c *Carbon) localeFormat(key, fallback string) string {
+	lang := c.lang
+	if lang == nil {
+		return fallback
+	}
+	lang.rw.RLock()
+	defer lang.rw.RUnlock()
+	if format, ok := lang.resources[key]; ok && format != "" {
+		return format
+	}
+	return fallback
+}
+
 // ToDateMilliString outputs a string in "2006-01-02.999" layout.
 func (c *Carbon) ToDateMilliString(timezone ...string) string {
 	if len(timezone) > 0 {
@@ -747,6 +797,22 @@ func (c *Carbon) ToFormattedDayDateString(timezone ...string) string {
 	return c.StdTime().Format(FormattedDayDateLayout)
 }
 
+// FormatWith outputs a string by a pattern of the dialect like FormatWith(StrftimeDialect, "%Y-%m-%d"),
+// the names of months and weeks are in English like Layout, an empty string is returned if the pattern is invalid.
+func (c *Carbon) FormatWith(dialect Dialect, pattern string, timezone ...string) string {
+	if len(timezone) > 0 {
+		c.loc, c.Error = parseTimezone(timezone...)
+	}
+	if c.IsInvalid() || dialect == nil {
+		return ""
+	}
+	layout, err := dialect.Layout(pattern)
+	if err != nil {
+		return ""
+	}
+	return c.StdTime().Format(layout)
+}
+
 // Layout outputs a string by layout.
 func (c *Carbon) Layout(layout string, timezone ...string) string {
 	if len(timezone) > 0 {
@@ -806,6 +872,10 @@ func (c *Carbon) Format(format string, timezone ...string) string {
 			case 'W': // week number of the year, ranging from 1-52
 				week := fmt.Sprintf("%d", c.WeekOfYear())
 				buffer.WriteString(week)
+			case 'E': // ISO 8601 week-numbering year, such as 2020
+				buffer.WriteString(fmt.Sprintf("%04d", c.ISOYear()))
+			case 'J': // ISO 8601 week number of the year with leading zeros, ranging from 01-53
+				buffer.WriteString(fmt.Sprintf("%02d", c.ISOWeek()))
 			case 'N': // day of the week as a number, ranging from 1-7
 				week := fmt.Sprintf("%d", c.DayOfWeek())
 				buffer.WriteString(week)
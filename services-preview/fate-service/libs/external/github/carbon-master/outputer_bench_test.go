@@ -549,6 +549,108 @@ func BenchmarkCarbon_ToDateString(b *testing.B) {
 	})
 }
 
+func BenchmarkCarbon_ToIso8601WeekString(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.ToIso8601WeekString(PRC)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.ToIso8601WeekString(PRC)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.ToIso8601WeekString(PRC)
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_ToLocaleDateString(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.ToLocaleDateString(PRC)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.ToLocaleDateString(PRC)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.ToLocaleDateString(PRC)
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_ToLocaleDateTimeString(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.ToLocaleDateTimeString(PRC)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.ToLocaleDateTimeString(PRC)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.ToLocaleDateTimeString(PRC)
+			}
+		})
+	})
+}
+
 func BenchmarkCarbon_ToDateMilliString(b *testing.B) {
 	b.Run("sequential", func(b *testing.B) {
 		c := Now()
@@ -2081,3 +2183,35 @@ func BenchmarkCarbon_Format(b *testing.B) {
 		})
 	})
 }
+
+func BenchmarkCarbon_FormatWith(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.FormatWith(StrftimeDialect, "%Y-%m-%d %H:%M:%S", PRC)
+		}
+	})
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Now()
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.FormatWith(StrftimeDialect, "%Y-%m-%d %H:%M:%S", PRC)
+			}()
+		}
+		wg.Wait()
+	})
+	b.Run("parallel", func(b *testing.B) {
+		c := Now()
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.FormatWith(StrftimeDialect, "%Y-%m-%d %H:%M:%S", PRC)
+			}
+		})
+	})
+}
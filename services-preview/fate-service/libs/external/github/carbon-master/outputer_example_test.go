@@ -210,6 +210,37 @@ func ExampleCarbon_ToDateString() {
 	// 2020-08-05
 }
 
+func ExampleCarbon_ToIso8601WeekString() {
+	fmt.Println(carbon.Parse("2020-08-05 13:14:15").ToIso8601WeekString())
+	fmt.Println(carbon.Parse("2021-01-01 13:14:15").ToIso8601WeekString())
+
+	// Output:
+	// 2020-W32-3
+	// 2020-W53-5
+}
+
+func ExampleCarbon_ToLocaleDateString() {
+	fmt.Println(carbon.Parse("2020-08-05 13:14:15").ToLocaleDateString())
+	fmt.Println(carbon.Parse("2020-08-05 13:14:15").SetLocale("zh-CN").ToLocaleDateString())
+	fmt.Println(carbon.Parse("2020-08-05 13:14:15").SetLocale("de").ToLocaleDateString())
+
+	// Output:
+	// Aug 5, 2020
+	// 2020年8月5日
+	// 05.08.2020
+}
+
+func ExampleCarbon_ToLocaleDateTimeString() {
+	fmt.Println(carbon.Parse("2020-08-05 13:14:15").ToLocaleDateTimeString())
+	fmt.Println(carbon.Parse("2020-08-05 13:14:15").SetLocale("zh-CN").ToLocaleDateTimeString())
+	fmt.Println(carbon.Parse("2020-08-05 13:14:15").SetLocale("de").ToLocaleDateTimeString())
+
+	// Output:
+	// Aug 5, 2020, 1:14:15 PM
+	// 2020年8月5日 13:14:15
+	// 05.08.2020, 13:14:15
+}
+
 func ExampleCarbon_ToDateMilliString() {
 	fmt.Println(carbon.Parse("2020-08-05T13:14:15.999999999+00:00").ToDateMilliString())
 	fmt.Println(carbon.Parse("2020-08-05", carbon.PRC).ToDateMilliString())
@@ -740,3 +771,15 @@ func ExampleCarbon_Format() {
 	// I\t \i\s Y-m-d H:i:s format: It is 2020-08-31 13:14:15
 	// 上次打卡时间:Y-m-d H:i:s，请每日按时打卡 format: 上次打卡时间:2020-08-31 13:14:15，请每日按时打卡
 }
+
+func ExampleCarbon_FormatWith() {
+	c := carbon.Parse("2020-08-05 13:14:15.999", carbon.PRC)
+	fmt.Println(c.FormatWith(carbon.StrftimeDialect, "%Y-%m-%d %H:%M:%S"))
+	fmt.Println(c.FormatWith(carbon.ICUDialect, "yyyy-MM-dd'T'HH:mm:ss.SSSXXX"))
+	fmt.Println(c.FormatWith(carbon.MomentDialect, "dddd, MMMM D, YYYY h:mm A"))
+
+	// Output:
+	// 2020-08-05 13:14:15
+	// 2020-08-05T13:14:15.999+08:00
+	// Wednesday, August 5, 2020 1:14 PM
+}
@@ -532,6 +532,107 @@ func (s *OutputerSuite) TestCarbon_ToDateString() {
 	})
 }
 
+func (s *OutputerSuite) TestCarbon_ToIso8601WeekString() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		s.Empty(c.ToIso8601WeekString())
+	})
+
+	s.Run("zero carbon", func() {
+		s.Equal("0001-W01-1", NewCarbon().ToIso8601WeekString())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Empty(Parse("").ToIso8601WeekString())
+	})
+
+	s.Run("error carbon", func() {
+		s.Empty(Parse("xxx").ToIso8601WeekString())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("2020-W32-3", Parse("2020-08-05 13:14:15").ToIso8601WeekString())
+		s.Equal("2020-W53-5", Parse("2021-01-01 13:14:15").ToIso8601WeekString())
+		s.Equal("2020-W01-1", Parse("2019-12-30 13:14:15").ToIso8601WeekString())
+		s.Equal("2020-W53-5", Parse("2021-01-01 00:00:00", PRC).ToIso8601WeekString(PRC))
+		s.Equal("2020-W53-4", Parse("2021-01-01 00:00:00", PRC).ToIso8601WeekString(UTC))
+	})
+}
+
+func (s *OutputerSuite) TestCarbon_ToLocaleDateString() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		s.Empty(c.ToLocaleDateString())
+	})
+
+	s.Run("zero carbon", func() {
+		s.Equal("Jan 1, 0001", NewCarbon().ToLocaleDateString())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Empty(Parse("").ToLocaleDateString())
+	})
+
+	s.Run("error carbon", func() {
+		s.Empty(Parse("xxx").ToLocaleDateString())
+	})
+
+	s.Run("nil language", func() {
+		c := Parse("2020-08-05 13:14:15")
+		c.lang = nil
+		s.Equal("2020-08-05", c.ToLocaleDateString())
+	})
+
+	s.Run("without date format", func() {
+		c := Parse("2020-08-05 13:14:15")
+		c.lang.resources = map[string]string{}
+		s.Equal("2020-08-05", c.ToLocaleDateString())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("Aug 5, 2020", Parse("2020-08-05 13:14:15").ToLocaleDateString())
+		s.Equal("2020年8月5日", Parse("2020-08-05 13:14:15").SetLocale("zh-CN").ToLocaleDateString())
+		s.Equal("05.08.2020", Parse("2020-08-05 13:14:15").SetLocale("de").ToLocaleDateString())
+		s.Equal("05.08.2020", Parse("2020-08-05 13:14:15").SetLocale("ru").ToLocaleDateString())
+		s.Equal("2020/08/05", Parse("2020-08-05 13:14:15").SetLocale("ja").ToLocaleDateString())
+		s.Equal("2020. 8. 5.", Parse("2020-08-05 13:14:15").SetLocale("ko").ToLocaleDateString())
+		s.Equal("Aug 6, 2020", Parse("2020-08-05 23:14:15").ToLocaleDateString(PRC))
+	})
+}
+
+func (s *OutputerSuite) TestCarbon_ToLocaleDateTimeString() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		s.Empty(c.ToLocaleDateTimeString())
+	})
+
+	s.Run("zero carbon", func() {
+		s.Equal("Jan 1, 0001, 12:00:00 AM", NewCarbon().ToLocaleDateTimeString())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Empty(Parse("").ToLocaleDateTimeString())
+	})
+
+	s.Run("error carbon", func() {
+		s.Empty(Parse("xxx").ToLocaleDateTimeString())
+	})
+
+	s.Run("nil language", func() {
+		c := Parse("2020-08-05 13:14:15")
+		c.lang = nil
+		s.Equal("2020-08-05 13:14:15", c.ToLocaleDateTimeString())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("Aug 5, 2020, 1:14:15 PM", Parse("2020-08-05 13:14:15").ToLocaleDateTimeString())
+		s.Equal("2020年8月5日 13:14:15", Parse("2020-08-05 13:14:15").SetLocale("zh-CN").ToLocaleDateTimeString())
+		s.Equal("05.08.2020, 13:14:15", Parse("2020-08-05 13:14:15").SetLocale("de").ToLocaleDateTimeString())
+		s.Equal("13:14:15 05/08/2020", Parse("2020-08-05 13:14:15").SetLocale("vi").ToLocaleDateTimeString())
+		s.Equal("Aug 6, 2020, 7:14:15 AM", Parse("2020-08-05 23:14:15").ToLocaleDateTimeString(PRC))
+	})
+}
+
 func (s *OutputerSuite) TestCarbon_ToDateMilliString() {
 	s.Run("nil carbon", func() {
 		var c *Carbon
@@ -1714,6 +1815,45 @@ func (s *OutputerSuite) TestCarbon_Layout() {
 	})
 }
 
+func (s *OutputerSuite) TestCarbon_FormatWith() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		s.Empty(c.FormatWith(StrftimeDialect, "%Y-%m-%d"))
+	})
+
+	s.Run("zero carbon", func() {
+		s.Equal("0001-01-01 00:00:00", NewCarbon().FormatWith(StrftimeDialect, "%Y-%m-%d %H:%M:%S"))
+	})
+
+	s.Run("empty carbon", func() {
+		s.Empty(Parse("").FormatWith(StrftimeDialect, "%Y-%m-%d"))
+	})
+
+	s.Run("error carbon", func() {
+		s.Empty(Parse("xxx").FormatWith(StrftimeDialect, "%Y-%m-%d"))
+	})
+
+	s.Run("nil dialect", func() {
+		s.Empty(Parse("2020-08-05").FormatWith(nil, "%Y-%m-%d"))
+	})
+
+	s.Run("error pattern", func() {
+		s.Empty(Parse("2020-08-05").FormatWith(StrftimeDialect, ""))
+		s.Empty(Parse("2020-08-05").FormatWith(StrftimeDialect, "%Q"))
+		s.Empty(Parse("2020-08-05").FormatWith(ICUDialect, "yyyy-MM-ddTHH"))
+	})
+
+	s.Run("valid carbon", func() {
+		c := Parse("2020-08-05 13:14:15.123456789", PRC)
+		s.Equal("2020-08-05 13:14:15", c.FormatWith(StrftimeDialect, "%Y-%m-%d %H:%M:%S"))
+		s.Equal("Wed, 05 Aug 2020 13:14:15 +0800", c.FormatWith(StrftimeDialect, "%a, %d %b %Y %T %z"))
+		s.Equal("2020-08-05T13:14:15.123+08:00", c.FormatWith(ICUDialect, "yyyy-MM-dd'T'HH:mm:ss.SSSXXX"))
+		s.Equal("2020年8月5日 Wednesday", c.FormatWith(JavaDialect, "yyyy'年'M'月'd'日' EEEE"))
+		s.Equal("August 5, 2020 1:14 PM", c.FormatWith(MomentDialect, "MMMM D, YYYY h:mm A"))
+		s.Equal("2020-08-05 05:14:15", c.FormatWith(MomentDialect, "YYYY-MM-DD HH:mm:ss", UTC))
+	})
+}
+
 func (s *OutputerSuite) TestCarbon_Format() {
 	s.Run("nil carbon", func() {
 		var c *Carbon
@@ -1745,6 +1885,11 @@ func (s *OutputerSuite) TestCarbon_Format() {
 		s.Equal("32", Parse("2020-08-05 13:14:15").Format("W"))
 		s.Equal("August", Parse("2020-08-05 13:14:15").Format("F"))
 		s.Equal("3", Parse("2020-08-05 13:14:15").Format("N"))
+		s.Equal("2020", Parse("2020-08-05 13:14:15").Format("E"))
+		s.Equal("2020", Parse("2021-01-03 13:14:15").Format("E"))
+		s.Equal("32", Parse("2020-08-05 13:14:15").Format("J"))
+		s.Equal("01", Parse("2019-12-30 13:14:15").Format("J"))
+		s.Equal("2020-W53-7", Parse("2021-01-03 13:14:15").Format("E-\\WJ-N"))
 		s.Equal("1", Parse("2020-08-05 13:14:15").Format("L"))
 		s.Equal("0", Parse("2021-08-05 13:14:15").Format("L"))
 		s.Equal("13", Parse("2020-08-05 13:14:15").Format("G"))
@@ -2,6 +2,8 @@ package carbon
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -81,6 +83,17 @@ func ParseByFormat(value, format string, timezone ...string) *Carbon {
 		return &Carbon{Error: err}
 	}
 
+	if hasISOWeekSymbol(format) {
+		tt, err := parseByISOWeekFormat(value, format, loc)
+		if err != nil {
+			return &Carbon{Error: fmt.Errorf("%w: %w", ErrMismatchedFormat(value, format), err)}
+		}
+		c := NewCarbon()
+		c.loc = loc
+		c.time = tt
+		return c
+	}
+
 	layout := format2layout(format)
 	tt, err := time.ParseInLocation(layout, value, loc)
 	if err != nil {
@@ -94,6 +107,35 @@ func ParseByFormat(value, format string, timezone ...string) *Carbon {
 	return c
 }
 
+// ParseWith parses a time string as a Carbon instance by a pattern of the dialect like ParseWith(ICUDialect, "2020-08-05", "yyyy-MM-dd").
+func ParseWith(dialect Dialect, value, pattern string, timezone ...string) *Carbon {
+	if value == "" {
+		return &Carbon{isEmpty: true}
+	}
+	if dialect == nil {
+		return &Carbon{Error: ErrNilDialect()}
+	}
+	layout, err := dialect.Layout(pattern)
+	if err != nil {
+		return &Carbon{Error: err}
+	}
+	loc, err := parseTimezone(timezone...)
+	if err != nil {
+		return &Carbon{Error: err}
+	}
+
+	tt, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return &Carbon{Error: fmt.Errorf("%w: %w", ErrMismatchedFormat(value, pattern), err)}
+	}
+
+	c := NewCarbon()
+	c.loc = loc
+	c.time = tt
+	c.currentLayout = layout
+	return c
+}
+
 // ParseByLayouts parses a time string as a Carbon instance by multiple fuzzy layouts.
 //
 // Note: it doesn't support parsing timestamp string.
@@ -140,6 +182,13 @@ func ParseByFormats(value string, formats []string, timezone ...string) *Carbon
 
 	c := NewCarbon().SetLocation(loc)
 	for _, format := range formats {
+		if hasISOWeekSymbol(format) {
+			if tt, err := parseByISOWeekFormat(value, format, loc); err == nil {
+				c.time = tt
+				return c
+			}
+			continue
+		}
 		layout := format2layout(format)
 		if tt, err := time.ParseInLocation(layout, value, loc); err == nil {
 			c.time = tt
@@ -150,3 +199,101 @@ func ParseByFormats(value string, formats []string, timezone ...string) *Carbon
 	c.Error = ErrFailedParse(value)
 	return c
 }
+
+// reports whether the format contains the ISO 8601 week-date symbols "E" or "J".
+func hasISOWeekSymbol(format string) bool {
+	for i := 0; i < len(format); i++ {
+		switch format[i] {
+		case '\\':
+			i++
+		case 'E', 'J':
+			return true
+		}
+	}
+	return false
+}
+
+// parseByISOWeekFormat parses a time string by a format which contains the ISO 8601 week-date symbols,
+// "E" is the 4-digit week-numbering year, "J" is the 2-digit week and "N" is the 1-digit day of week from Monday,
+// the other symbols are parsed as usual and only their time of day and zone are used.
+func parseByISOWeekFormat(value, format string, loc *Location) (time.Time, error) {
+	type token struct {
+		char     byte
+		isSymbol bool
+	}
+	tokens := make([]token, 0, len(format))
+	for i := 0; i < len(format); i++ {
+		char := format[i]
+		if char == '\\' && i+1 < len(format) {
+			tokens = append(tokens, token{char: format[i+1]})
+			i++
+			continue
+		}
+		_, ok := formatMap[char]
+		tokens = append(tokens, token{char: char, isSymbol: ok || char == 'E' || char == 'J' || char == 'N'})
+	}
+
+	year, week, day, hasYear := 0, 1, 1, false
+	layouts, values := make([]string, 0, len(tokens)), make([]string, 0, len(tokens))
+	j := 0
+	for i, t := range tokens {
+		if !t.isSymbol {
+			if j >= len(value) || value[j] != t.char {
+				return time.Time{}, ErrFailedParse(value)
+			}
+			j++
+			continue
+		}
+		if width, ok := map[byte]int{'E': 4, 'J': 2, 'N': 1}[t.char]; ok {
+			if j+width > len(value) {
+				return time.Time{}, ErrFailedParse(value)
+			}
+			n, err := strconv.Atoi(value[j : j+width])
+			if err != nil || n < 0 {
+				return time.Time{}, ErrFailedParse(value)
+			}
+			switch t.char {
+			case 'E':
+				year, hasYear = n, true
+			case 'J':
+				week = n
+			case 'N':
+				day = n
+			}
+			j += width
+			continue
+		}
+		// a symbol ends at the next literal character, or has the width of its layout if followed by another symbol
+		end := len(value)
+		if i+1 < len(tokens) {
+			if next := tokens[i+1]; next.isSymbol {
+				end = j + len(formatMap[t.char])
+			} else if k := strings.IndexByte(value[j:], next.char); k >= 0 {
+				end = j + k
+			}
+		}
+		if end > len(value) {
+			return time.Time{}, ErrFailedParse(value)
+		}
+		layouts, values = append(layouts, formatMap[t.char]), append(values, value[j:end])
+		j = end
+	}
+	if j != len(value) {
+		return time.Time{}, ErrFailedParse(value)
+	}
+
+	tt := time.Date(0, time.January, 1, 0, 0, 0, 0, loc)
+	if len(layouts) > 0 {
+		var err error
+		if tt, err = time.ParseInLocation(strings.Join(layouts, " "), strings.Join(values, " "), loc); err != nil {
+			return time.Time{}, err
+		}
+	}
+	if !hasYear {
+		year = tt.Year()
+	}
+	if !isValidISOWeekDate(year, week, day) {
+		return time.Time{}, ErrFailedParse(value)
+	}
+	return time.Date(year, time.January, isoWeekDay(year, week, day), tt.Hour(), tt.Minute(), tt.Second(), tt.Nanosecond(), tt.Location()), nil
+}
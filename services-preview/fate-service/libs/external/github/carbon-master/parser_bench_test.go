@@ -173,3 +173,32 @@ func BenchmarkParseByFormats(b *testing.B) {
 		})
 	})
 }
+
+func BenchmarkParseWith(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			ParseWith(ICUDialect, "2020-08-05 13:14:15", "yyyy-MM-dd HH:mm:ss")
+		}
+	})
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ParseWith(ICUDialect, "2020-08-05 13:14:15", "yyyy-MM-dd HH:mm:ss")
+			}()
+		}
+		wg.Wait()
+	})
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				ParseWith(ICUDialect, "2020-08-05 13:14:15", "yyyy-MM-dd HH:mm:ss")
+			}
+		})
+	})
+}
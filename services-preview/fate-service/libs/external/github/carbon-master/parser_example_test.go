@@ -72,6 +72,17 @@ func ExampleParseByFormat() {
 	// 2020-08-05 13:14:15 +0000 UTC
 }
 
+func ExampleParseWith() {
+	fmt.Println(carbon.ParseWith(carbon.StrftimeDialect, "2020-08-05 13:14:15", "%Y-%m-%d %H:%M:%S").ToString())
+	fmt.Println(carbon.ParseWith(carbon.ICUDialect, "2020-08-05T13:14:15", "yyyy-MM-dd'T'HH:mm:ss", carbon.PRC).ToString())
+	fmt.Println(carbon.ParseWith(carbon.MomentDialect, "August 5, 2020 1:14 PM", "MMMM D, YYYY h:mm A").ToString())
+
+	// Output:
+	// 2020-08-05 13:14:15 +0000 UTC
+	// 2020-08-05 13:14:15 +0800 CST
+	// 2020-08-05 13:14:00 +0000 UTC
+}
+
 func ExampleParseByLayouts() {
 	c := carbon.ParseByLayouts("2020|08|05 13|14|15", []string{"2006|01|02 15|04|05", "2006|1|2 3|4|5"})
 	fmt.Println(c.ToString())
@@ -218,6 +218,52 @@ func (s *ParserSuite) TestParseByLayout() {
 	})
 }
 
+func (s *ParserSuite) TestParseWith() {
+	s.Run("empty value", func() {
+		c := ParseWith(StrftimeDialect, "", "%Y-%m-%d")
+		s.False(c.HasError())
+		s.Empty(c.String())
+	})
+
+	s.Run("error value", func() {
+		c := ParseWith(StrftimeDialect, "xxx", "%Y-%m-%d")
+		s.True(c.HasError())
+		s.Empty(c.String())
+	})
+
+	s.Run("nil dialect", func() {
+		s.Equal(ErrNilDialect(), ParseWith(nil, "2020-08-05", "%Y-%m-%d").Error)
+	})
+
+	s.Run("error pattern", func() {
+		s.Equal(ErrEmptyPattern(), ParseWith(ICUDialect, "2020-08-05", "").Error)
+		s.Equal(ErrInvalidPattern("yyyy-MM-ddTHH", "T"), ParseWith(ICUDialect, "2020-08-05T13", "yyyy-MM-ddTHH").Error)
+	})
+
+	s.Run("error timezone", func() {
+		c := ParseWith(MomentDialect, "2020-08-05", "YYYY-MM-DD", "xxx")
+		s.True(c.HasError())
+		s.Empty(c.String())
+	})
+
+	s.Run("without timezone", func() {
+		s.Equal("2020-08-05 13:14:15 +0000 UTC", ParseWith(StrftimeDialect, "2020-08-05 13:14:15", "%Y-%m-%d %H:%M:%S").ToString())
+		s.Equal("2020-08-05 05:14:15.123 +0000 UTC", ParseWith(ICUDialect, "2020-08-05T13:14:15.123+08:00", "yyyy-MM-dd'T'HH:mm:ss.SSSXXX").ToString())
+		s.Equal("2020-08-05 13:14:15 +0000 UTC", ParseWith(MomentDialect, "Wednesday, August 5, 2020 1:14:15 PM", "dddd, MMMM D, YYYY h:mm:ss A").ToString())
+	})
+
+	s.Run("with timezone", func() {
+		s.Equal("2020-08-05 13:14:15 +0800 CST", ParseWith(StrftimeDialect, "2020-08-05 13:14:15", "%Y-%m-%d %H:%M:%S", PRC).ToString())
+		s.Equal("2020-08-05 13:14:15 +0800 CST", ParseWith(ICUDialect, "2020年8月5日 13:14:15", "yyyy'年'M'月'd'日' HH:mm:ss", PRC).ToString())
+		s.Equal("2020-08-05 13:14:15 +0800 CST", ParseWith(MomentDialect, "Today is 2020/08/05 13:14:15", "[Today is] YYYY/MM/DD HH:mm:ss", PRC).ToString())
+	})
+
+	s.Run("mismatched pattern", func() {
+		c := ParseWith(StrftimeDialect, "2020-08-05", "%Y/%m/%d")
+		s.Contains(c.Error.Error(), ErrMismatchedFormat("2020-08-05", "%Y/%m/%d").Error())
+	})
+}
+
 func (s *ParserSuite) TestParseByLayouts() {
 	s.Run("empty value", func() {
 		c := ParseByLayouts("", []string{DateTimeLayout})
@@ -325,6 +371,29 @@ func (s *ParserSuite) TestParseByFormat() {
 		s.Equal("2020-08-05 13:14:15 +0800 CST", ParseByFormat("It is 2020-08-05 13:14:15", "\\I\\t \\i\\s Y-m-d H:i:s", PRC).ToString())
 		s.Equal("2020-08-05 13:14:15 +0800 CST", ParseByFormat("今天是 2020年08月05日13时14分15秒", "今天是 Y年m月d日H时i分s秒", PRC).ToString())
 	})
+	s.Run("iso week date", func() {
+		s.Equal("2020-08-05 00:00:00 +0000 UTC", ParseByFormat("2020-W32-3", "E-\\WJ-N").ToString())
+		s.Equal("2019-12-30 00:00:00 +0000 UTC", ParseByFormat("2020-W01", "E-\\WJ").ToString())
+		s.Equal("2021-01-03 00:00:00 +0000 UTC", ParseByFormat("2020W537", "E\\WJN").ToString())
+		s.Equal("2016-01-03 13:14:15 +0000 UTC", ParseByFormat("2015-W53-7 13:14:15", "E-\\WJ-N H:i:s").ToString())
+		s.Equal("2016-01-03 13:14:15 +0800 CST", ParseByFormat("2015-W53-7 13:14:15", "E-\\WJ-N H:i:s", PRC).ToString())
+		s.Equal("2016-01-03 04:14:15 +0000 UTC", ParseByFormat("2015-W53-7 13:14:15 +09:00", "E-\\WJ-N H:i:s P").ToString())
+		s.Equal("2020-08-05 13:14:15 +0000 UTC", ParseByFormat("13:14:15 2020 week 32 day 3", "H:i:s Y \\w\\e\\e\\k J \\d\\a\\y N").ToString())
+		s.Equal("2020-08-05 00:00:00 +0000 UTC", ParseByFormats("2020-W32-3", []string{DateFormat, "E-\\WJ-N"}).ToString())
+	})
+
+	s.Run("error iso week date", func() {
+		s.Error(ParseByFormat("2021-W53-1", "E-\\WJ-N").Error)
+		s.Error(ParseByFormat("2020-W00-1", "E-\\WJ-N").Error)
+		s.Error(ParseByFormat("2020-W01-8", "E-\\WJ-N").Error)
+		s.Error(ParseByFormat("2020-W1-1", "E-\\WJ-N").Error)
+		s.Error(ParseByFormat("2020-Wxx-1", "E-\\WJ-N").Error)
+		s.Error(ParseByFormat("2020-W01-1x", "E-\\WJ-N").Error)
+		s.Error(ParseByFormat("2020/W01-1", "E-\\WJ-N").Error)
+		s.Error(ParseByFormat("2020-W01-1 25:00:00", "E-\\WJ-N H:i:s").Error)
+		s.Error(ParseByFormat("2020-W01-1 13", "E-\\WJ-N Hi").Error)
+		s.Error(ParseByFormats("2020-W54-1", []string{DateFormat, "E-\\WJ-N"}).Error)
+	})
 }
 
 func (s *ParserSuite) TestParseByFormats() {
@@ -0,0 +1,473 @@
+package carbon
+
+import (
+	"strings"
+	"time"
+)
+
+// period bounds constants
+const (
+	BoundsClosed    = "[]" // includes both the start and end time
+	BoundsOpen      = "()" // excludes both the start and end time
+	BoundsOpenStart = "(]" // excludes the start time, includes the end time
+	BoundsOpenEnd   = "[)" // includes the start time, excludes the end time
+)
+
+// Period defines a Period struct.
+type Period struct {
+	start, end *Carbon
+	bounds     string
+	Error      error
+}
+
+// NewPeriod returns a new Period instance, the bounds default to BoundsClosed.
+func NewPeriod(start, end *Carbon, bounds ...string) *Period {
+	p := &Period{bounds: BoundsClosed}
+	if len(bounds) > 0 {
+		p.bounds = bounds[0]
+	}
+	if !isValidBounds(p.bounds) {
+		p.Error = ErrInvalidBounds(p.bounds)
+		return p
+	}
+	if start.IsNil() || end.IsNil() {
+		p.Error = ErrNilCarbon()
+		return p
+	}
+	if start.HasError() {
+		p.Error = start.Error
+		return p
+	}
+	if end.HasError() {
+		p.Error = end.Error
+		return p
+	}
+	if start.IsEmpty() || end.IsEmpty() {
+		p.Error = ErrEmptyPeriod()
+		return p
+	}
+	if start.Gt(end) {
+		p.Error = ErrInvalidPeriod(start, end)
+		return p
+	}
+	p.start, p.end = start.Copy(), end.Copy()
+	return p
+}
+
+// Copy returns a copy of the Period instance.
+func (p *Period) Copy() *Period {
+	if p == nil {
+		return nil
+	}
+	return &Period{
+		start:  p.start.Copy(),
+		end:    p.end.Copy(),
+		bounds: p.bounds,
+		Error:  p.Error,
+	}
+}
+
+// HasError reports whether it has error.
+func (p *Period) HasError() bool {
+	if p == nil {
+		return false
+	}
+	return p.Error != nil
+}
+
+// IsValid reports whether it is a valid period.
+func (p *Period) IsValid() bool {
+	return p != nil && p.Error == nil && p.start.IsValid() && p.end.IsValid()
+}
+
+// IsInvalid reports whether it is an invalid period.
+func (p *Period) IsInvalid() bool {
+	return !p.IsValid()
+}
+
+// IsEmpty reports whether the period contains no time at all, such as "(2020-08-05,2020-08-05]".
+func (p *Period) IsEmpty() bool {
+	if p.IsInvalid() {
+		return false
+	}
+	return p.start.Eq(p.end) && !(p.IsStartIncluded() && p.IsEndIncluded())
+}
+
+// Start returns a Carbon instance for the start of the period.
+func (p *Period) Start() *Carbon {
+	if p.IsInvalid() {
+		return nil
+	}
+	return p.start.Copy()
+}
+
+// End returns a Carbon instance for the end of the period.
+func (p *Period) End() *Carbon {
+	if p.IsInvalid() {
+		return nil
+	}
+	return p.end.Copy()
+}
+
+// Bounds returns the bounds of the period like "[)".
+func (p *Period) Bounds() string {
+	if p.IsInvalid() {
+		return ""
+	}
+	return p.bounds
+}
+
+// IsStartIncluded reports whether the start time is included.
+func (p *Period) IsStartIncluded() bool {
+	if p.IsInvalid() {
+		return false
+	}
+	return p.bounds[0] == '['
+}
+
+// IsEndIncluded reports whether the end time is included.
+func (p *Period) IsEndIncluded() bool {
+	if p.IsInvalid() {
+		return false
+	}
+	return p.bounds[1] == ']'
+}
+
+// Duration gets the duration between the start and end time.
+func (p *Period) Duration() Duration {
+	if p.IsInvalid() {
+		return 0
+	}
+	return p.start.DiffInDuration(p.end)
+}
+
+// Contains reports whether the period contains the given time, respecting the bounds.
+func (p *Period) Contains(c *Carbon) bool {
+	if p.IsInvalid() || c.IsInvalid() {
+		return false
+	}
+	if c.Lt(p.start) || c.Gt(p.end) {
+		return false
+	}
+	if c.Eq(p.start) && !p.IsStartIncluded() {
+		return false
+	}
+	if c.Eq(p.end) && !p.IsEndIncluded() {
+		return false
+	}
+	return true
+}
+
+// Overlaps reports whether two periods have at least one time in common.
+func (p *Period) Overlaps(q *Period) bool {
+	_, _, _, _, ok := p.intersect(q)
+	return ok
+}
+
+// Intersect returns the period shared by two periods, or nil if they don't overlap.
+func (p *Period) Intersect(q *Period) *Period {
+	start, end, startIncluded, endIncluded, ok := p.intersect(q)
+	if !ok {
+		return nil
+	}
+	return NewPeriod(start, end, getBounds(startIncluded, endIncluded))
+}
+
+// Union returns the period covering two periods, or nil if they are neither overlapping nor adjacent.
+func (p *Period) Union(q *Period) *Period {
+	if p.IsInvalid() || q.IsInvalid() {
+		return nil
+	}
+	a, b := p, q
+	if b.start.Lt(a.start) {
+		a, b = b, a
+	}
+	_, _, _, _, ok := a.intersect(b)
+	if !ok && !(a.end.Eq(b.start) && (a.IsEndIncluded() || b.IsStartIncluded())) {
+		return nil
+	}
+
+	start, startIncluded := a.start, a.IsStartIncluded()
+	if a.start.Eq(b.start) {
+		startIncluded = startIncluded || b.IsStartIncluded()
+	}
+	end, endIncluded := a.end, a.IsEndIncluded()
+	switch {
+	case b.end.Gt(a.end):
+		end, endIncluded = b.end, b.IsEndIncluded()
+	case b.end.Eq(a.end):
+		endIncluded = endIncluded || b.IsEndIncluded()
+	}
+	return NewPeriod(start, end, getBounds(startIncluded, endIncluded))
+}
+
+// Gap returns the period between two periods, or nil if they overlap or are adjacent.
+func (p *Period) Gap(q *Period) *Period {
+	if p.IsInvalid() || q.IsInvalid() {
+		return nil
+	}
+	if p.Overlaps(q) {
+		return nil
+	}
+	a, b := p, q
+	if b.start.Lt(a.start) {
+		a, b = b, a
+	}
+	if a.end.Gt(b.start) {
+		return nil
+	}
+	if a.end.Eq(b.start) && (a.IsEndIncluded() || b.IsStartIncluded()) {
+		return nil
+	}
+	return NewPeriod(a.end, b.start, getBounds(!a.IsEndIncluded(), !b.IsStartIncluded()))
+}
+
+// Split splits the period into n periods of equal duration, inner boundaries are half-open like "[)".
+func (p *Period) Split(n int) []*Period {
+	if p.IsInvalid() || n <= 0 {
+		return nil
+	}
+	d := int64(p.Duration())
+	step, remainder := d/int64(n), d%int64(n)
+	periods := make([]*Period, 0, n)
+	start := p.start
+	for i := 1; i <= n; i++ {
+		end := p.end
+		if i < n {
+			end = p.start.Copy()
+			end.time = p.start.StdTime().Add(Duration(step*int64(i) + remainder*int64(i)/int64(n)))
+		}
+		startIncluded, endIncluded := true, false
+		if i == 1 {
+			startIncluded = p.IsStartIncluded()
+		}
+		if i == n {
+			endIncluded = p.IsEndIncluded()
+		}
+		periods = append(periods, NewPeriod(start, end, getBounds(startIncluded, endIncluded)))
+		start = end
+	}
+	return periods
+}
+
+// EveryYear returns all times in the period with a step of one year.
+func (p *Period) EveryYear() []*Carbon {
+	return p.every(func(c *Carbon, i int) *Carbon {
+		return c.AddYearsNoOverflow(i)
+	})
+}
+
+// EveryQuarter returns all times in the period with a step of one quarter.
+func (p *Period) EveryQuarter() []*Carbon {
+	return p.every(func(c *Carbon, i int) *Carbon {
+		return c.AddQuartersNoOverflow(i)
+	})
+}
+
+// EveryMonth returns all times in the period with a step of one month.
+func (p *Period) EveryMonth() []*Carbon {
+	return p.every(func(c *Carbon, i int) *Carbon {
+		return c.AddMonthsNoOverflow(i)
+	})
+}
+
+// EveryWeek returns all times in the period with a step of one week.
+func (p *Period) EveryWeek() []*Carbon {
+	return p.every(func(c *Carbon, i int) *Carbon {
+		return c.AddWeeks(i)
+	})
+}
+
+// EveryDay returns all times in the period with a step of one day.
+func (p *Period) EveryDay() []*Carbon {
+	return p.every(func(c *Carbon, i int) *Carbon {
+		return c.AddDays(i)
+	})
+}
+
+// EveryHour returns all times in the period with a step of one hour.
+func (p *Period) EveryHour() []*Carbon {
+	return p.everyDuration(time.Hour)
+}
+
+// EveryMinute returns all times in the period with a step of one minute.
+func (p *Period) EveryMinute() []*Carbon {
+	return p.everyDuration(time.Minute)
+}
+
+// EverySecond returns all times in the period with a step of one second.
+func (p *Period) EverySecond() []*Carbon {
+	return p.everyDuration(time.Second)
+}
+
+// Every returns all times in the period with a step of the given duration like "1h30m",
+// the error of an invalid or non-positive duration is returned and the period isn't changed.
+func (p *Period) Every(duration string) ([]*Carbon, error) {
+	if p.IsInvalid() {
+		return nil, nil
+	}
+	td, err := parseDuration(duration)
+	if err != nil {
+		return nil, err
+	}
+	if td <= 0 {
+		return nil, ErrInvalidDuration(duration)
+	}
+	return p.everyDuration(td), nil
+}
+
+// String implements "Stringer" interface for Period struct.
+func (p *Period) String() string {
+	if p.IsInvalid() {
+		return ""
+	}
+	return p.layout(p.start.currentLayout)
+}
+
+// every returns all times in the period, the i-th time is computed from the start time by the given step function.
+func (p *Period) every(step func(c *Carbon, i int) *Carbon) []*Carbon {
+	if p.IsInvalid() {
+		return nil
+	}
+	var times []*Carbon
+	for i := 0; ; i++ {
+		c := step(p.start, i)
+		if c.Gt(p.end) || (c.Eq(p.end) && !p.IsEndIncluded()) {
+			break
+		}
+		if i == 0 && !p.IsStartIncluded() {
+			continue
+		}
+		times = append(times, c)
+	}
+	return times
+}
+
+// everyDuration returns all times in the period with a step of the positive duration.
+func (p *Period) everyDuration(d Duration) []*Carbon {
+	return p.every(func(c *Carbon, i int) *Carbon {
+		result := c.Copy()
+		result.time = c.StdTime().Add(d * Duration(i))
+		return result
+	})
+}
+
+// intersect gets the start and end time shared by two periods.
+func (p *Period) intersect(q *Period) (start, end *Carbon, startIncluded, endIncluded, ok bool) {
+	if p.IsInvalid() || q.IsInvalid() {
+		return
+	}
+	switch {
+	case p.start.Gt(q.start):
+		start, startIncluded = p.start, p.IsStartIncluded()
+	case p.start.Lt(q.start):
+		start, startIncluded = q.start, q.IsStartIncluded()
+	default:
+		start, startIncluded = p.start, p.IsStartIncluded() && q.IsStartIncluded()
+	}
+	switch {
+	case p.end.Lt(q.end):
+		end, endIncluded = p.end, p.IsEndIncluded()
+	case p.end.Gt(q.end):
+		end, endIncluded = q.end, q.IsEndIncluded()
+	default:
+		end, endIncluded = p.end, p.IsEndIncluded() && q.IsEndIncluded()
+	}
+	ok = start.Lt(end) || (start.Eq(end) && startIncluded && endIncluded)
+	return
+}
+
+// ParsePeriod parses a range string like "[2020-08-05 00:00:00,2020-08-06 00:00:00)" as a Period instance,
+// the times can be double-quoted like "[\"Wed, 05 Aug 2020 00:00:00 UTC\",\"Thu, 06 Aug 2020 00:00:00 UTC\")".
+func ParsePeriod(value string, timezone ...string) *Period {
+	return parsePeriod(value, func(v string) *Carbon {
+		return Parse(v, timezone...)
+	})
+}
+
+// parses a range string as a Period instance, the start and end time are parsed by the given function.
+func parsePeriod(value string, parse func(v string) *Carbon) *Period {
+	p := new(Period)
+	value = strings.TrimSpace(value)
+	if len(value) < 2 || !isValidBounds(value[:1]+value[len(value)-1:]) {
+		p.Error = ErrFailedParsePeriod(value)
+		return p
+	}
+	parts, ok := splitPeriod(value[1 : len(value)-1])
+	if !ok {
+		p.Error = ErrFailedParsePeriod(value)
+		return p
+	}
+	return NewPeriod(parse(parts[0]), parse(parts[1]), value[:1]+value[len(value)-1:])
+}
+
+// splits the start and end time of a range string, a double-quoted time may contain commas and doubled quotes.
+func splitPeriod(value string) ([]string, bool) {
+	var parts []string
+	for {
+		value = strings.TrimSpace(value)
+		part := ""
+		if strings.HasPrefix(value, `"`) {
+			var b strings.Builder
+			i := 1
+			for ; i < len(value); i++ {
+				if value[i] == '"' {
+					if i+1 < len(value) && value[i+1] == '"' {
+						b.WriteByte('"')
+						i++
+						continue
+					}
+					break
+				}
+				b.WriteByte(value[i])
+			}
+			if i >= len(value) {
+				return nil, false
+			}
+			part, value = b.String(), strings.TrimSpace(value[i+1:])
+		} else if i := strings.IndexByte(value, ','); i >= 0 {
+			part, value = strings.TrimSpace(value[:i]), value[i:]
+		} else {
+			part, value = value, ""
+		}
+		parts = append(parts, part)
+		if value == "" {
+			break
+		}
+		if value[0] != ',' {
+			return nil, false
+		}
+		value = value[1:]
+	}
+	return parts, len(parts) == 2
+}
+
+// quotes the time of a range string if it contains commas or quotes.
+func quotePeriodTime(value string) string {
+	if !strings.ContainsAny(value, `,"`) {
+		return value
+	}
+	return `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
+}
+
+// isValidBounds reports whether the bounds is valid.
+func isValidBounds(bounds string) bool {
+	switch bounds {
+	case BoundsClosed, BoundsOpen, BoundsOpenStart, BoundsOpenEnd:
+		return true
+	}
+	return false
+}
+
+// getBounds gets the bounds by whether the start and end time are included.
+func getBounds(startIncluded, endIncluded bool) string {
+	switch {
+	case startIncluded && endIncluded:
+		return BoundsClosed
+	case startIncluded:
+		return BoundsOpenEnd
+	case endIncluded:
+		return BoundsOpenStart
+	}
+	return BoundsOpen
+}
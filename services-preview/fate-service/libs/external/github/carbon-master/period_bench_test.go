@@ -0,0 +1,107 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+)
+
+func BenchmarkPeriod_Contains(b *testing.B) {
+	p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"))
+	c := Parse("2020-08-06")
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			p.Contains(c)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.Contains(c)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				p.Contains(c)
+			}
+		})
+	})
+}
+
+func BenchmarkPeriod_Intersect(b *testing.B) {
+	p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"))
+	q := NewPeriod(Parse("2020-08-08"), Parse("2020-08-12"))
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			p.Intersect(q)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.Intersect(q)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				p.Intersect(q)
+			}
+		})
+	})
+}
+
+func BenchmarkPeriod_EveryDay(b *testing.B) {
+	p := NewPeriod(Parse("2020-08-01"), Parse("2020-08-31"))
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			p.EveryDay()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.EveryDay()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				p.EveryDay()
+			}
+		})
+	})
+}
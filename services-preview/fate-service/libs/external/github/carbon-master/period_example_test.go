@@ -0,0 +1,126 @@
+package carbon_test
+
+import (
+	"fmt"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func ExampleNewPeriod() {
+	p := carbon.NewPeriod(carbon.Parse("2020-08-05"), carbon.Parse("2020-08-06"), carbon.BoundsOpenEnd)
+	fmt.Println(p.String())
+	fmt.Println(p.Duration().String())
+
+	// Output:
+	// [2020-08-05,2020-08-06)
+	// 24h0m0s
+}
+
+func ExampleParsePeriod() {
+	p := carbon.ParsePeriod("(2020-08-05 00:00:00,2020-08-06 00:00:00]")
+	fmt.Println(p.Start().ToDateTimeString())
+	fmt.Println(p.End().ToDateTimeString())
+	fmt.Println(p.IsStartIncluded())
+	fmt.Println(p.IsEndIncluded())
+
+	// Output:
+	// 2020-08-05 00:00:00
+	// 2020-08-06 00:00:00
+	// false
+	// true
+}
+
+func ExamplePeriod_Contains() {
+	p := carbon.NewPeriod(carbon.Parse("2020-08-05"), carbon.Parse("2020-08-06"), carbon.BoundsOpenEnd)
+	fmt.Println(p.Contains(carbon.Parse("2020-08-05")))
+	fmt.Println(p.Contains(carbon.Parse("2020-08-05 12:00:00")))
+	fmt.Println(p.Contains(carbon.Parse("2020-08-06")))
+
+	// Output:
+	// true
+	// true
+	// false
+}
+
+func ExamplePeriod_Overlaps() {
+	p := carbon.NewPeriod(carbon.Parse("2020-08-05"), carbon.Parse("2020-08-10"), carbon.BoundsOpenEnd)
+	fmt.Println(p.Overlaps(carbon.NewPeriod(carbon.Parse("2020-08-08"), carbon.Parse("2020-08-12"))))
+	fmt.Println(p.Overlaps(carbon.NewPeriod(carbon.Parse("2020-08-10"), carbon.Parse("2020-08-12"))))
+
+	// Output:
+	// true
+	// false
+}
+
+func ExamplePeriod_Intersect() {
+	p := carbon.NewPeriod(carbon.Parse("2020-08-05"), carbon.Parse("2020-08-10"))
+	fmt.Println(p.Intersect(carbon.NewPeriod(carbon.Parse("2020-08-08"), carbon.Parse("2020-08-12"), carbon.BoundsOpen)).String())
+
+	// Output:
+	// (2020-08-08,2020-08-10]
+}
+
+func ExamplePeriod_Union() {
+	p := carbon.NewPeriod(carbon.Parse("2020-08-05"), carbon.Parse("2020-08-10"), carbon.BoundsOpenEnd)
+	fmt.Println(p.Union(carbon.NewPeriod(carbon.Parse("2020-08-10"), carbon.Parse("2020-08-12"))).String())
+
+	// Output:
+	// [2020-08-05,2020-08-12]
+}
+
+func ExamplePeriod_Gap() {
+	p := carbon.NewPeriod(carbon.Parse("2020-08-05"), carbon.Parse("2020-08-10"))
+	fmt.Println(p.Gap(carbon.NewPeriod(carbon.Parse("2020-08-12"), carbon.Parse("2020-08-15"))).String())
+
+	// Output:
+	// (2020-08-10,2020-08-12)
+}
+
+func ExamplePeriod_Split() {
+	p := carbon.NewPeriod(carbon.Parse("2020-08-05 00:00:00"), carbon.Parse("2020-08-06 00:00:00"))
+	for _, period := range p.Split(3) {
+		fmt.Println(period.String())
+	}
+
+	// Output:
+	// [2020-08-05 00:00:00,2020-08-05 08:00:00)
+	// [2020-08-05 08:00:00,2020-08-05 16:00:00)
+	// [2020-08-05 16:00:00,2020-08-06 00:00:00]
+}
+
+func ExamplePeriod_EveryDay() {
+	p := carbon.NewPeriod(carbon.Parse("2020-08-05"), carbon.Parse("2020-08-07"))
+	for _, c := range p.EveryDay() {
+		fmt.Println(c.ToDateString())
+	}
+
+	// Output:
+	// 2020-08-05
+	// 2020-08-06
+	// 2020-08-07
+}
+
+func ExamplePeriod_EveryMonth() {
+	p := carbon.NewPeriod(carbon.Parse("2020-01-31"), carbon.Parse("2020-04-30"))
+	for _, c := range p.EveryMonth() {
+		fmt.Println(c.ToDateString())
+	}
+
+	// Output:
+	// 2020-01-31
+	// 2020-02-29
+	// 2020-03-31
+	// 2020-04-30
+}
+
+func ExamplePeriod_Every() {
+	p := carbon.NewPeriod(carbon.Parse("2020-08-05 00:00:00"), carbon.Parse("2020-08-05 03:00:00"), carbon.BoundsOpenEnd)
+	times, _ := p.Every("1h30m")
+	for _, c := range times {
+		fmt.Println(c.ToDateTimeString())
+	}
+
+	// Output:
+	// 2020-08-05 00:00:00
+	// 2020-08-05 01:30:00
+}
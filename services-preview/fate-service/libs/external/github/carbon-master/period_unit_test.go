@@ -0,0 +1,351 @@
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PeriodSuite struct {
+	suite.Suite
+}
+
+func TestPeriodSuite(t *testing.T) {
+	suite.Run(t, new(PeriodSuite))
+}
+
+func (s *PeriodSuite) TestNewPeriod() {
+	s.Run("nil carbon", func() {
+		s.Error(NewPeriod(nil, Now()).Error)
+		s.Error(NewPeriod(Now(), nil).Error)
+	})
+
+	s.Run("empty carbon", func() {
+		s.Error(NewPeriod(Parse(""), Now()).Error)
+		s.Error(NewPeriod(Now(), Parse("")).Error)
+	})
+
+	s.Run("error carbon", func() {
+		s.Error(NewPeriod(Parse("xxx"), Now()).Error)
+		s.Error(NewPeriod(Now(), Parse("xxx")).Error)
+	})
+
+	s.Run("invalid bounds", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"), "xx")
+		s.Error(p.Error)
+		s.True(p.IsInvalid())
+	})
+
+	s.Run("start after end", func() {
+		p := NewPeriod(Parse("2020-08-06"), Parse("2020-08-05"))
+		s.Error(p.Error)
+		s.True(p.IsInvalid())
+	})
+
+	s.Run("valid period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"))
+		s.Nil(p.Error)
+		s.Equal(BoundsClosed, p.Bounds())
+		s.Equal("2020-08-05", p.Start().ToDateString())
+		s.Equal("2020-08-06", p.End().ToDateString())
+		s.True(p.IsStartIncluded())
+		s.True(p.IsEndIncluded())
+
+		p = NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"), BoundsOpen)
+		s.Equal(BoundsOpen, p.Bounds())
+		s.False(p.IsStartIncluded())
+		s.False(p.IsEndIncluded())
+	})
+
+	s.Run("immutable period", func() {
+		start := Parse("2020-08-05")
+		p := NewPeriod(start, Parse("2020-08-06"))
+		start.SetDay(1)
+		s.Equal("2020-08-05", p.Start().ToDateString())
+		p.Start().SetDay(1)
+		s.Equal("2020-08-05", p.Start().ToDateString())
+	})
+}
+
+func (s *PeriodSuite) TestPeriod_IsEmpty() {
+	s.Run("invalid period", func() {
+		var p *Period
+		s.False(p.IsEmpty())
+		s.False(new(Period).IsEmpty())
+	})
+
+	s.Run("valid period", func() {
+		c := Parse("2020-08-05")
+		s.False(NewPeriod(c, c).IsEmpty())
+		s.True(NewPeriod(c, c, BoundsOpen).IsEmpty())
+		s.True(NewPeriod(c, c, BoundsOpenEnd).IsEmpty())
+		s.False(NewPeriod(c, c.AddDay(), BoundsOpen).IsEmpty())
+	})
+}
+
+func (s *PeriodSuite) TestPeriod_Duration() {
+	s.Run("invalid period", func() {
+		s.Zero(new(Period).Duration())
+	})
+
+	s.Run("valid period", func() {
+		s.Equal("24h0m0s", NewPeriod(Parse("2020-08-05"), Parse("2020-08-06")).Duration().String())
+	})
+}
+
+func (s *PeriodSuite) TestPeriod_Contains() {
+	start, end := Parse("2020-08-05"), Parse("2020-08-06")
+
+	s.Run("invalid period", func() {
+		s.False(new(Period).Contains(start))
+		s.False(NewPeriod(start, end).Contains(nil))
+		s.False(NewPeriod(start, end).Contains(Parse("xxx")))
+	})
+
+	s.Run("valid period", func() {
+		middle := Parse("2020-08-05 12:00:00")
+
+		s.True(NewPeriod(start, end).Contains(start))
+		s.True(NewPeriod(start, end).Contains(middle))
+		s.True(NewPeriod(start, end).Contains(end))
+
+		s.False(NewPeriod(start, end, BoundsOpen).Contains(start))
+		s.True(NewPeriod(start, end, BoundsOpen).Contains(middle))
+		s.False(NewPeriod(start, end, BoundsOpen).Contains(end))
+
+		s.True(NewPeriod(start, end, BoundsOpenEnd).Contains(start))
+		s.False(NewPeriod(start, end, BoundsOpenEnd).Contains(end))
+
+		s.False(NewPeriod(start, end, BoundsOpenStart).Contains(start))
+		s.True(NewPeriod(start, end, BoundsOpenStart).Contains(end))
+
+		s.False(NewPeriod(start, end).Contains(Parse("2020-08-04")))
+		s.False(NewPeriod(start, end).Contains(Parse("2020-08-07")))
+	})
+}
+
+func (s *PeriodSuite) TestPeriod_Overlaps() {
+	s.Run("invalid period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"))
+		s.False(new(Period).Overlaps(p))
+		s.False(p.Overlaps(nil))
+	})
+
+	s.Run("valid period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"))
+		s.True(p.Overlaps(NewPeriod(Parse("2020-08-08"), Parse("2020-08-12"))))
+		s.True(p.Overlaps(NewPeriod(Parse("2020-08-01"), Parse("2020-08-06"))))
+		s.True(p.Overlaps(NewPeriod(Parse("2020-08-06"), Parse("2020-08-07"))))
+		s.True(p.Overlaps(NewPeriod(Parse("2020-08-10"), Parse("2020-08-12"))))
+		s.False(p.Overlaps(NewPeriod(Parse("2020-08-10"), Parse("2020-08-12"), BoundsOpenStart)))
+		s.False(NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"), BoundsOpenEnd).Overlaps(NewPeriod(Parse("2020-08-10"), Parse("2020-08-12"))))
+		s.False(p.Overlaps(NewPeriod(Parse("2020-08-11"), Parse("2020-08-12"))))
+	})
+}
+
+func (s *PeriodSuite) TestPeriod_Intersect() {
+	s.Run("invalid period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"))
+		s.Nil(new(Period).Intersect(p))
+		s.Nil(p.Intersect(nil))
+	})
+
+	s.Run("disjoint period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"))
+		s.Nil(p.Intersect(NewPeriod(Parse("2020-08-11"), Parse("2020-08-12"))))
+	})
+
+	s.Run("valid period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"), BoundsOpenEnd)
+		s.Equal("[2020-08-08,2020-08-10)", p.Intersect(NewPeriod(Parse("2020-08-08"), Parse("2020-08-12"))).String())
+		s.Equal("(2020-08-05,2020-08-06]", p.Intersect(NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"), BoundsOpenStart)).String())
+		s.Equal("[2020-08-06,2020-08-07]", p.Intersect(NewPeriod(Parse("2020-08-06"), Parse("2020-08-07"))).String())
+		s.Equal("[2020-08-05,2020-08-05]", NewPeriod(Parse("2020-08-01"), Parse("2020-08-05")).Intersect(p).String())
+	})
+}
+
+func (s *PeriodSuite) TestPeriod_Union() {
+	s.Run("invalid period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"))
+		s.Nil(new(Period).Union(p))
+		s.Nil(p.Union(nil))
+	})
+
+	s.Run("disjoint period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"))
+		s.Nil(p.Union(NewPeriod(Parse("2020-08-11"), Parse("2020-08-12"))))
+		s.Nil(NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"), BoundsOpenEnd).Union(NewPeriod(Parse("2020-08-10"), Parse("2020-08-12"), BoundsOpen)))
+	})
+
+	s.Run("adjacent period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"), BoundsOpenEnd)
+		s.Equal("[2020-08-05,2020-08-12)", p.Union(NewPeriod(Parse("2020-08-10"), Parse("2020-08-12"), BoundsOpenEnd)).String())
+	})
+
+	s.Run("overlapping period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"), BoundsOpen)
+		s.Equal("[2020-08-01,2020-08-10)", p.Union(NewPeriod(Parse("2020-08-01"), Parse("2020-08-06"))).String())
+		s.Equal("(2020-08-05,2020-08-10]", p.Union(NewPeriod(Parse("2020-08-06"), Parse("2020-08-10"))).String())
+		s.Equal("[2020-08-05,2020-08-10)", p.Union(NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"))).String())
+	})
+}
+
+func (s *PeriodSuite) TestPeriod_Gap() {
+	s.Run("invalid period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"))
+		s.Nil(new(Period).Gap(p))
+		s.Nil(p.Gap(nil))
+	})
+
+	s.Run("overlapping period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"))
+		s.Nil(p.Gap(NewPeriod(Parse("2020-08-08"), Parse("2020-08-12"))))
+	})
+
+	s.Run("adjacent period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"), BoundsOpenEnd)
+		s.Nil(p.Gap(NewPeriod(Parse("2020-08-10"), Parse("2020-08-12"))))
+		s.Equal("[2020-08-10,2020-08-10]", p.Gap(NewPeriod(Parse("2020-08-10"), Parse("2020-08-12"), BoundsOpen)).String())
+	})
+
+	s.Run("disjoint period", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-10"))
+		q := NewPeriod(Parse("2020-08-12"), Parse("2020-08-15"), BoundsOpen)
+		s.Equal("(2020-08-10,2020-08-12]", p.Gap(q).String())
+		s.Equal("(2020-08-10,2020-08-12]", q.Gap(p).String())
+	})
+}
+
+func (s *PeriodSuite) TestPeriod_Split() {
+	s.Run("invalid period", func() {
+		s.Nil(new(Period).Split(2))
+		s.Nil(NewPeriod(Parse("2020-08-05"), Parse("2020-08-06")).Split(0))
+	})
+
+	s.Run("valid period", func() {
+		periods := NewPeriod(Parse("2020-08-05 00:00:00"), Parse("2020-08-06 00:00:00"), BoundsOpen).Split(3)
+		s.Len(periods, 3)
+		s.Equal("(2020-08-05 00:00:00,2020-08-05 08:00:00)", periods[0].String())
+		s.Equal("[2020-08-05 08:00:00,2020-08-05 16:00:00)", periods[1].String())
+		s.Equal("[2020-08-05 16:00:00,2020-08-06 00:00:00)", periods[2].String())
+
+		periods = NewPeriod(Parse("2020-08-05"), Parse("2020-08-06")).Split(1)
+		s.Len(periods, 1)
+		s.Equal("[2020-08-05,2020-08-06]", periods[0].String())
+	})
+
+	s.Run("uneven period", func() {
+		periods := NewPeriod(Parse("2020-08-05 00:00:00"), Parse("2020-08-05 00:00:10")).Split(3)
+		s.Len(periods, 3)
+		s.Equal(Parse("2020-08-05 00:00:10").ToDateTimeNanoString(), periods[2].End().ToDateTimeNanoString())
+		s.Equal(periods[0].End().ToDateTimeNanoString(), periods[1].Start().ToDateTimeNanoString())
+	})
+}
+
+func (s *PeriodSuite) TestPeriod_Every() {
+	s.Run("invalid period", func() {
+		s.Nil(new(Period).EveryDay())
+		times, err := new(Period).Every("1h")
+		s.Nil(times)
+		s.Nil(err)
+	})
+
+	s.Run("invalid duration", func() {
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"))
+		times, err := p.Every("xxx")
+		s.Nil(times)
+		s.Error(err)
+		s.Nil(p.Error)
+
+		times, err = p.Every("-1h")
+		s.Nil(times)
+		s.Equal(ErrInvalidDuration("-1h"), err)
+		s.Nil(p.Error)
+		s.Len(p.EveryHour(), 25)
+	})
+
+	s.Run("valid period", func() {
+		toStrings := func(cs []*Carbon) (ss []string) {
+			for _, c := range cs {
+				ss = append(ss, c.ToDateTimeString())
+			}
+			return
+		}
+
+		p := NewPeriod(Parse("2020-08-05"), Parse("2020-08-08"))
+		s.Equal([]string{"2020-08-05 00:00:00", "2020-08-06 00:00:00", "2020-08-07 00:00:00", "2020-08-08 00:00:00"}, toStrings(p.EveryDay()))
+
+		p = NewPeriod(Parse("2020-08-05"), Parse("2020-08-08"), BoundsOpen)
+		s.Equal([]string{"2020-08-06 00:00:00", "2020-08-07 00:00:00"}, toStrings(p.EveryDay()))
+
+		p = NewPeriod(Parse("2020-01-31"), Parse("2020-05-31"), BoundsOpenEnd)
+		s.Equal([]string{"2020-01-31 00:00:00", "2020-02-29 00:00:00", "2020-03-31 00:00:00", "2020-04-30 00:00:00"}, toStrings(p.EveryMonth()))
+
+		p = NewPeriod(Parse("2020-08-05 00:00:00"), Parse("2020-08-05 04:00:00"))
+		every, err := p.Every("1h30m")
+		s.Nil(err)
+		s.Equal([]string{"2020-08-05 00:00:00", "2020-08-05 01:30:00", "2020-08-05 03:00:00"}, toStrings(every))
+		s.Len(p.EveryHour(), 5)
+		s.Len(p.EveryMinute(), 241)
+
+		p = NewPeriod(Parse("2020-02-29"), Parse("2024-02-29"))
+		s.Equal([]string{"2020-02-29 00:00:00", "2021-02-28 00:00:00", "2022-02-28 00:00:00", "2023-02-28 00:00:00", "2024-02-29 00:00:00"}, toStrings(p.EveryYear()))
+		s.Len(NewPeriod(Parse("2020-01-01"), Parse("2020-12-31")).EveryQuarter(), 4)
+		s.Len(NewPeriod(Parse("2020-08-01"), Parse("2020-08-31")).EveryWeek(), 5)
+		s.Len(NewPeriod(Parse("2020-08-05 00:00:00"), Parse("2020-08-05 00:00:59")).EverySecond(), 60)
+	})
+}
+
+func (s *PeriodSuite) TestPeriod_String() {
+	s.Run("invalid period", func() {
+		var p *Period
+		s.Empty(p.String())
+		s.Empty(new(Period).String())
+	})
+
+	s.Run("valid period", func() {
+		s.Equal("[2020-08-05,2020-08-06)", NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"), BoundsOpenEnd).String())
+		s.Equal("[2020-08-05 00:00:00,2020-08-06 00:00:00]", NewPeriod(Parse("2020-08-05 00:00:00"), Parse("2020-08-06 00:00:00")).String())
+	})
+}
+
+func (s *PeriodSuite) TestParsePeriod() {
+	s.Run("invalid value", func() {
+		s.Error(ParsePeriod("").Error)
+		s.Error(ParsePeriod("xxx").Error)
+		s.Error(ParsePeriod("{2020-08-05,2020-08-06}").Error)
+		s.Error(ParsePeriod("[2020-08-05]").Error)
+		s.Error(ParsePeriod("[xxx,2020-08-06]").Error)
+		s.Error(ParsePeriod("[2020-08-06,2020-08-05]").Error)
+		s.Error(ParsePeriod("[2020-08-05,2020-08-06,2020-08-07]").Error)
+		s.Error(ParsePeriod(`["2020-08-05,2020-08-06]`).Error)
+		s.Error(ParsePeriod(`["2020-08-05"x,2020-08-06]`).Error)
+	})
+
+	s.Run("invalid timezone", func() {
+		s.Error(ParsePeriod("[2020-08-05,2020-08-06]", "xxx").Error)
+	})
+
+	s.Run("valid value", func() {
+		p := ParsePeriod("[2020-08-05 00:00:00,2020-08-06 00:00:00)")
+		s.Nil(p.Error)
+		s.Equal(BoundsOpenEnd, p.Bounds())
+		s.Equal("2020-08-05 00:00:00", p.Start().ToDateTimeString())
+		s.Equal("2020-08-06 00:00:00", p.End().ToDateTimeString())
+
+		p = ParsePeriod(`("2020-08-05 00:00:00", "2020-08-06 00:00:00"]`, PRC)
+		s.Nil(p.Error)
+		s.Equal(BoundsOpenStart, p.Bounds())
+		s.Equal(PRC, p.Start().Timezone())
+
+		p = ParsePeriod(`["Wed, 05 Aug 2020 00:00:00 UTC","Thu, 06 Aug 2020 00:00:00 UTC")`)
+		s.Nil(p.Error)
+		s.Equal("2020-08-05 00:00:00", p.Start().ToDateTimeString())
+		s.Equal("2020-08-06 00:00:00", p.End().ToDateTimeString())
+		s.Equal(`["Wed, 05 Aug 2020 00:00:00 UTC","Thu, 06 Aug 2020 00:00:00 UTC")`, p.layout(RFC1123Layout))
+
+		parts, ok := splitPeriod(quotePeriodTime(`2020-08-05 "a"`) + ",2020-08-06")
+		s.True(ok)
+		s.Equal([]string{`2020-08-05 "a"`, "2020-08-06"}, parts)
+	})
+}
@@ -0,0 +1,280 @@
+package carbon
+
+import (
+	"embed"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed cldr
+var cldrFS embed.FS
+
+// plural categories defined by CLDR
+const (
+	PluralZero  = "zero"
+	PluralOne   = "one"
+	PluralTwo   = "two"
+	PluralFew   = "few"
+	PluralMany  = "many"
+	PluralOther = "other"
+)
+
+// pluralCategories lists plural categories in the order that their rules are evaluated
+var pluralCategories = []string{PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany}
+
+// pluralAliases maps locales whose CLDR language differs from the locale prefix
+var pluralAliases = map[string]string{
+	"se": "sv",
+}
+
+// pluralRuleSet holds the parsed rules of a language keyed by plural category
+type pluralRuleSet map[string]pluralRule
+
+// pluralRules caches the cardinal and ordinal rules loaded from the cldr directory
+var pluralRules struct {
+	once     sync.Once
+	cardinal map[string]pluralRuleSet
+	ordinal  map[string]pluralRuleSet
+}
+
+// loads CLDR plural rules from a supplemental json file.
+func loadPluralRules(fileName, key string) map[string]pluralRuleSet {
+	bs, err := cldrFS.ReadFile(fileName)
+	if err != nil {
+		return nil
+	}
+	var data struct {
+		Supplemental map[string]json.RawMessage `json:"supplemental"`
+	}
+	if err = json.Unmarshal(bs, &data); err != nil {
+		return nil
+	}
+	var languages map[string]map[string]string
+	if err = json.Unmarshal(data.Supplemental[key], &languages); err != nil {
+		return nil
+	}
+	sets := make(map[string]pluralRuleSet, len(languages))
+	for language, rules := range languages {
+		set := make(pluralRuleSet, len(rules))
+		for name, rule := range rules {
+			category := strings.TrimPrefix(name, "pluralRule-count-")
+			if category == PluralOther || rule == "" {
+				continue
+			}
+			set[category] = parsePluralRule(rule)
+		}
+		sets[language] = set
+	}
+	return sets
+}
+
+// gets the plural rule set of a locale like "zh-CN" or "ms-MY" from cardinal or ordinal rules.
+func pluralRuleSetOf(locale string, ordinal bool) pluralRuleSet {
+	pluralRules.once.Do(func() {
+		pluralRules.cardinal = loadPluralRules("cldr/plurals.json", "plurals-type-cardinal")
+		pluralRules.ordinal = loadPluralRules("cldr/ordinals.json", "plurals-type-ordinal")
+	})
+	language := strings.ToLower(locale)
+	if i := strings.IndexAny(language, "-_"); i > 0 {
+		language = language[:i]
+	}
+	if alias, ok := pluralAliases[language]; ok {
+		language = alias
+	}
+	if ordinal {
+		return pluralRules.ordinal[language]
+	}
+	return pluralRules.cardinal[language]
+}
+
+// gets the plural category of an integer from a rule set, which is "other" if no rule matches.
+func (set pluralRuleSet) category(value int64) string {
+	n := getAbsValue(value)
+	for _, category := range pluralCategories {
+		if rule, ok := set[category]; ok && rule.match(n) {
+			return category
+		}
+	}
+	return PluralOther
+}
+
+// pluralRule defines a CLDR plural rule as conditions joined by "or", each of which is relations joined by "and".
+type pluralRule [][]pluralRelation
+
+// pluralRelation defines a relation like "i % 10 = 2..4" or "n != 1,5".
+type pluralRelation struct {
+	operand byte
+	modulus int64
+	negate  bool
+	ranges  [][2]int64
+}
+
+// parses a CLDR plural rule like "v = 0 and i % 10 = 1 and i % 100 != 11", samples after "@" are ignored.
+func parsePluralRule(rule string) pluralRule {
+	if i := strings.Index(rule, "@"); i >= 0 {
+		rule = rule[:i]
+	}
+	var parsed pluralRule
+	for _, condition := range strings.Split(rule, " or ") {
+		var relations []pluralRelation
+		for _, relation := range strings.Split(condition, " and ") {
+			if r, ok := parsePluralRelation(relation); ok {
+				relations = append(relations, r)
+			}
+		}
+		if len(relations) > 0 {
+			parsed = append(parsed, relations)
+		}
+	}
+	return parsed
+}
+
+// parses a CLDR plural relation like "i % 100 != 12..14".
+func parsePluralRelation(relation string) (r pluralRelation, ok bool) {
+	expr, list, found := strings.Cut(relation, "!=")
+	if found {
+		r.negate = true
+	} else if expr, list, found = strings.Cut(relation, "="); !found {
+		return r, false
+	}
+	expr = strings.Replace(expr, " mod ", " % ", 1)
+	operand, modulus, hasModulus := strings.Cut(expr, "%")
+	operand = strings.TrimSpace(operand)
+	if len(operand) != 1 {
+		return r, false
+	}
+	r.operand = operand[0]
+	if hasModulus {
+		m, err := strconv.ParseInt(strings.TrimSpace(modulus), 10, 64)
+		if err != nil || m == 0 {
+			return r, false
+		}
+		r.modulus = m
+	}
+	for _, item := range strings.Split(list, ",") {
+		from, to, isRange := strings.Cut(strings.TrimSpace(item), "..")
+		start, err := strconv.ParseInt(from, 10, 64)
+		if err != nil {
+			return r, false
+		}
+		end := start
+		if isRange {
+			if end, err = strconv.ParseInt(to, 10, 64); err != nil {
+				return r, false
+			}
+		}
+		r.ranges = append(r.ranges, [2]int64{start, end})
+	}
+	return r, true
+}
+
+// reports whether a non-negative integer matches the rule.
+func (rule pluralRule) match(n int64) bool {
+	for _, relations := range rule {
+		matched := true
+		for _, relation := range relations {
+			if !relation.match(n) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// reports whether a non-negative integer matches the relation,
+// the operands n and i are the integer itself and others like v, w, f, t, c and e are zero for integers.
+func (r pluralRelation) match(n int64) bool {
+	var value int64
+	switch r.operand {
+	case 'n', 'i':
+		value = n
+	}
+	if r.modulus > 0 {
+		value %= r.modulus
+	}
+	in := false
+	for _, rng := range r.ranges {
+		if value >= rng[0] && value <= rng[1] {
+			in = true
+			break
+		}
+	}
+	return in != r.negate
+}
+
+// parses a plural resource like "1 day|%d days" or "one:%d день|few:%d дня|many:%d дней|other:%d дня"
+// as forms, categories are nil if forms are selected by position.
+func parsePluralResource(resource string) (categories, forms []string) {
+	if resource == "" {
+		return nil, nil
+	}
+	forms = strings.Split(resource, "|")
+	categories, texts := make([]string, len(forms)), make([]string, len(forms))
+	for i, form := range forms {
+		category, text, found := strings.Cut(form, ":")
+		if !found || !isPluralCategory(category) {
+			return nil, forms
+		}
+		categories[i], texts[i] = category, text
+	}
+	return categories, texts
+}
+
+// gets forms of a plural resource without category prefixes.
+func pluralForms(resource string) []string {
+	_, forms := parsePluralResource(resource)
+	return forms
+}
+
+// reports whether a string is a CLDR plural category.
+func isPluralCategory(category string) bool {
+	if category == PluralOther {
+		return true
+	}
+	for _, c := range pluralCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// selects the form of a plural resource for a value, categorized forms fall back to "other" and then the last form.
+func selectPluralForm(resource string, value int64, category string) string {
+	categories, forms := parsePluralResource(resource)
+	if len(forms) == 0 {
+		return ""
+	}
+	str := strconv.FormatInt(value, 10)
+	if categories != nil {
+		form := forms[len(forms)-1]
+		for i, c := range categories {
+			if c == category {
+				form = forms[i]
+				break
+			}
+			if c == PluralOther {
+				form = forms[i]
+			}
+		}
+		return strings.Replace(form, "%d", str, 1)
+	}
+	number := getAbsValue(value)
+	if len(forms) == 1 {
+		return strings.Replace(forms[0], "%d", str, 1)
+	}
+	// zero takes the last form like "0 seconds"
+	if number == 0 || int64(len(forms)) <= number {
+		return strings.Replace(forms[len(forms)-1], "%d", str, 1)
+	}
+	if !strings.Contains(forms[number-1], "%d") && value < 0 {
+		return "-" + forms[number-1]
+	}
+	return strings.Replace(forms[number-1], "%d", str, 1)
+}
@@ -0,0 +1,87 @@
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PluralSuite struct {
+	suite.Suite
+}
+
+func TestPluralSuite(t *testing.T) {
+	suite.Run(t, new(PluralSuite))
+}
+
+func (s *PluralSuite) TestParsePluralRule() {
+	s.Run("invalid relation", func() {
+		s.Empty(parsePluralRule("n"))
+		s.Empty(parsePluralRule("n > 1"))
+		s.Empty(parsePluralRule("ab = 1"))
+		s.Empty(parsePluralRule("n % x = 1"))
+		s.Empty(parsePluralRule("n % 0 = 1"))
+		s.Empty(parsePluralRule("n = x"))
+		s.Empty(parsePluralRule("n = 1..x"))
+	})
+
+	s.Run("ignore samples", func() {
+		rule := parsePluralRule("n = 1 @integer 1")
+		s.True(rule.match(1))
+		s.False(rule.match(2))
+	})
+
+	s.Run("mod keyword", func() {
+		rule := parsePluralRule("n mod 10 = 1 and n mod 100 != 11")
+		s.True(rule.match(21))
+		s.False(rule.match(111))
+	})
+
+	s.Run("ranges and lists", func() {
+		rule := parsePluralRule("n % 100 = 3..10,13")
+		s.True(rule.match(103))
+		s.True(rule.match(13))
+		s.False(rule.match(11))
+	})
+
+	s.Run("fraction operands", func() {
+		rule := parsePluralRule("v = 0 and i % 10 = 1 or f != 0")
+		s.True(rule.match(1))
+		s.False(rule.match(2))
+		s.False(parsePluralRule("t != 0").match(1))
+		s.True(parsePluralRule("e = 0").match(1))
+	})
+}
+
+func (s *PluralSuite) TestParsePluralResource() {
+	s.Run("empty resource", func() {
+		categories, forms := parsePluralResource("")
+		s.Nil(categories)
+		s.Nil(forms)
+	})
+
+	s.Run("positional resource", func() {
+		categories, forms := parsePluralResource("1 day|%d days")
+		s.Nil(categories)
+		s.Equal([]string{"1 day", "%d days"}, forms)
+	})
+
+	s.Run("categorized resource", func() {
+		categories, forms := parsePluralResource("one:%d день|few:%d дня|other:%d дней")
+		s.Equal([]string{"one", "few", "other"}, categories)
+		s.Equal([]string{"%d день", "%d дня", "%d дней"}, forms)
+	})
+
+	s.Run("mixed resource", func() {
+		categories, forms := parsePluralResource("one:%d day|%d days")
+		s.Nil(categories)
+		s.Equal([]string{"one:%d day", "%d days"}, forms)
+	})
+}
+
+func (s *PluralSuite) TestPluralRuleSetOf() {
+	s.NotNil(pluralRuleSetOf("en", false))
+	s.NotNil(pluralRuleSetOf("zh_CN", true))
+	s.NotNil(pluralRuleSetOf("se", false))
+	s.Nil(pluralRuleSetOf("xx", false))
+}
@@ -0,0 +1,572 @@
+package carbon
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// relative units
+var relativeUnits = map[string]string{
+	"sec": "second", "secs": "second", "second": "second", "seconds": "second",
+	"min": "minute", "mins": "minute", "minute": "minute", "minutes": "minute",
+	"hour": "hour", "hours": "hour",
+	"day": "day", "days": "day",
+	"weekday": "weekday", "weekdays": "weekday",
+	"week": "week", "weeks": "week",
+	"fortnight": "fortnight", "fortnights": "fortnight",
+	"month": "month", "months": "month",
+	"year": "year", "years": "year",
+}
+
+// relative weekdays
+var relativeWeekdays = map[string]Weekday{
+	"sunday": Sunday, "sun": Sunday,
+	"monday": Monday, "mon": Monday,
+	"tuesday": Tuesday, "tue": Tuesday, "tues": Tuesday,
+	"wednesday": Wednesday, "wed": Wednesday,
+	"thursday": Thursday, "thu": Thursday, "thur": Thursday, "thurs": Thursday,
+	"friday": Friday, "fri": Friday,
+	"saturday": Saturday, "sat": Saturday,
+}
+
+// relative months
+var relativeMonths = map[string]int{
+	"january": 1, "jan": 1, "february": 2, "feb": 2, "march": 3, "mar": 3, "april": 4, "apr": 4,
+	"may": 5, "june": 6, "jun": 6, "july": 7, "jul": 7, "august": 8, "aug": 8,
+	"september": 9, "sep": 9, "sept": 9, "october": 10, "oct": 10, "november": 11, "nov": 11, "december": 12, "dec": 12,
+}
+
+// relative ordinals, -1 means the last one
+var relativeOrdinals = map[string]int{
+	"first": 1, "1st": 1, "second": 2, "2nd": 2, "third": 3, "3rd": 3,
+	"fourth": 4, "4th": 4, "fifth": 5, "5th": 5, "last": -1,
+}
+
+// relative directions
+var relativeDirections = map[string]int{
+	"next": 1, "last": -1, "previous": -1, "this": 0,
+}
+
+// ParseRelative parses a relative time expression like "next monday", "last day of next month", "+3 weeks 2 days",
+// "first friday of March", "tomorrow 9:30am" or "midnight" as a Carbon instance relative to base, which defaults to now.
+//
+// Localized expressions like "下周一", "三天后", "后天" and "下个月最后一天" are supported by the language of base.
+func ParseRelative(expr string, base ...*Carbon) *Carbon {
+	if strings.TrimSpace(expr) == "" {
+		return &Carbon{isEmpty: true}
+	}
+	var c *Carbon
+	if len(base) > 0 {
+		c = base[0]
+	} else {
+		c = Now()
+	}
+	if c.IsNil() {
+		return &Carbon{Error: ErrNilCarbon()}
+	}
+	if c.IsInvalid() {
+		return c
+	}
+	p := &relativeParser{
+		c:      c.Copy(),
+		tokens: tokenizeRelative(c.lang.normalizeRelative(expr)),
+	}
+	if !p.parse() {
+		p.c.Error = ErrFailedParse(expr)
+	}
+	return p.c
+}
+
+// relativeParser defines a relativeParser struct which applies the phrases of a relative expression in order.
+type relativeParser struct {
+	c      *Carbon
+	tokens []string
+	pos    int
+	clock  []int // the time of day set explicitly, which is applied at last
+}
+
+// parses all phrases.
+func (p *relativeParser) parse() bool {
+	for p.pos < len(p.tokens) {
+		if p.peek(0) == "at" || p.peek(0) == "and" {
+			p.pos++
+			continue
+		}
+		if !p.parseOffsets() && !p.parseKeyword() && !p.parseClock() &&
+			!p.parseOrdinal() && !p.parseDirection() && !p.parseWeekday() {
+			return false
+		}
+	}
+	if p.clock != nil {
+		p.c = p.c.SetTime(p.clock[0], p.clock[1], p.clock[2]).SetNanosecond(0)
+	}
+	return p.c.Error == nil
+}
+
+// parses offsets like "+3 weeks 2 days", "3 days ago", "in 2 hours" or "a week from now".
+func (p *relativeParser) parseOffsets() bool {
+	start := p.pos
+	if p.peek(0) == "in" {
+		p.pos++
+	}
+	type offset struct {
+		unit  string
+		value int
+	}
+	var offsets []offset
+	for {
+		value, ok := parseRelativeNumber(p.peek(0))
+		unit, isUnit := relativeUnits[p.peek(1)]
+		if !ok || !isUnit {
+			break
+		}
+		offsets = append(offsets, offset{unit: unit, value: value})
+		p.pos += 2
+	}
+	if len(offsets) == 0 {
+		p.pos = start
+		return false
+	}
+	sign := 1
+	switch {
+	case p.peek(0) == "ago":
+		sign = -1
+		p.pos++
+	case p.peek(0) == "later" || p.peek(0) == "hence":
+		p.pos++
+	case p.peek(0) == "from" && p.peek(1) == "now":
+		p.pos += 2
+	}
+	for _, o := range offsets {
+		p.add(o.unit, o.value*sign)
+	}
+	return true
+}
+
+// parses keywords like "now", "today", "tomorrow", "yesterday", "midnight" and "noon".
+func (p *relativeParser) parseKeyword() bool {
+	switch p.peek(0) {
+	case "now":
+	case "today":
+		p.c = p.c.StartOfDay()
+	case "tomorrow":
+		p.c = p.c.AddDay().StartOfDay()
+	case "yesterday":
+		p.c = p.c.SubDay().StartOfDay()
+	case "midnight":
+		p.clock = []int{0, 0, 0}
+	case "noon":
+		p.clock = []int{12, 0, 0}
+	default:
+		return false
+	}
+	p.pos++
+	return true
+}
+
+// parses the time of day like "9am", "9 pm", "9:30pm", "14:30" or "14:30:45".
+func (p *relativeParser) parseClock() bool {
+	token, meridiem := p.peek(0), ""
+	for _, m := range []string{"am", "pm"} {
+		if strings.HasSuffix(token, m) {
+			token, meridiem = strings.TrimSuffix(token, m), m
+			break
+		}
+	}
+	if meridiem == "" && (p.peek(1) == "am" || p.peek(1) == "pm") {
+		meridiem = p.peek(1)
+	}
+	if meridiem == "" && !strings.Contains(token, ":") {
+		return false
+	}
+	parts := strings.Split(token, ":")
+	if len(parts) > 3 {
+		return false
+	}
+	clock := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || (i > 0 && (len(part) != 2 || n > 59)) {
+			return false
+		}
+		clock[i] = n
+	}
+	switch {
+	case meridiem == "" && clock[0] > 23:
+		return false
+	case meridiem != "" && (clock[0] < 1 || clock[0] > 12):
+		return false
+	case meridiem == "am" && clock[0] == 12:
+		clock[0] = 0
+	case meridiem == "pm" && clock[0] < 12:
+		clock[0] += 12
+	}
+	p.clock = clock
+	p.pos++
+	if meridiem != "" && p.peek(0) == meridiem {
+		p.pos++
+	}
+	return true
+}
+
+// parses ordinal phrases like "first day of next month", "last day of March" or "second friday of next month".
+func (p *relativeParser) parseOrdinal() bool {
+	ordinal, ok := relativeOrdinals[p.peek(0)]
+	if !ok || p.peek(2) != "of" {
+		return false
+	}
+	weekday, isWeekday := relativeWeekdays[p.peek(1)]
+	if p.peek(1) != "day" && !isWeekday {
+		return false
+	}
+	if !isWeekday && ordinal != 1 && ordinal != -1 {
+		return false
+	}
+	p.pos += 3
+
+	// the first day of the target month, or the target year if isYear is true
+	t := p.c.Copy().SetDay(1)
+	isYear := false
+	if direction, ok := relativeDirections[p.peek(0)]; ok && (p.peek(1) == "month" || p.peek(1) == "year") {
+		isYear = p.peek(1) == "year"
+		if isYear {
+			t = t.AddYears(direction)
+		} else {
+			t = t.AddMonths(direction)
+		}
+		p.pos += 2
+	} else if month, ok := relativeMonths[p.peek(0)]; ok {
+		t = t.SetMonth(month)
+		p.pos++
+		if year, err := strconv.Atoi(p.peek(0)); err == nil && len(p.peek(0)) == 4 {
+			t = t.SetYear(year)
+			p.pos++
+		}
+	}
+	if isYear {
+		if ordinal == 1 {
+			t = t.SetMonth(1)
+		} else {
+			t = t.SetMonth(MonthsPerYear)
+		}
+	}
+
+	if !isWeekday {
+		if ordinal == -1 {
+			t = t.SetDay(t.DaysInMonth())
+		}
+		p.c = t
+		return true
+	}
+	t = t.StartOfDay()
+	if ordinal == -1 {
+		t = t.SetDay(t.DaysInMonth())
+		p.c = t.SubDays((int(t.StdTime().Weekday()) - int(weekday) + DaysPerWeek) % DaysPerWeek)
+		return true
+	}
+	days := (int(weekday) - int(t.StdTime().Weekday()) + DaysPerWeek) % DaysPerWeek
+	p.c = t.AddDays(days + (ordinal-1)*DaysPerWeek)
+	return true
+}
+
+// parses direction phrases like "next monday", "last friday", "this sunday", "next week" or "last month".
+func (p *relativeParser) parseDirection() bool {
+	direction, ok := relativeDirections[p.peek(0)]
+	if !ok {
+		return false
+	}
+	if weekday, ok := relativeWeekdays[p.peek(1)]; ok {
+		p.c = p.c.StartOfDay()
+		today := p.c.StdTime().Weekday()
+		switch direction {
+		case 1:
+			p.c = p.c.AddDays((int(weekday)-int(today)+DaysPerWeek-1)%DaysPerWeek + 1)
+		case -1:
+			p.c = p.c.SubDays((int(today)-int(weekday)+DaysPerWeek-1)%DaysPerWeek + 1)
+		default:
+			p.c = p.c.AddDays((int(weekday) - int(today) + DaysPerWeek) % DaysPerWeek)
+		}
+		p.pos += 2
+		return true
+	}
+	if unit, ok := relativeUnits[p.peek(1)]; ok {
+		p.add(unit, direction)
+		p.pos += 2
+		return true
+	}
+	return false
+}
+
+// parses a weekday like "monday", which is today or the next one.
+func (p *relativeParser) parseWeekday() bool {
+	weekday, ok := relativeWeekdays[p.peek(0)]
+	if !ok {
+		return false
+	}
+	p.c = p.c.StartOfDay()
+	p.c = p.c.AddDays((int(weekday) - int(p.c.StdTime().Weekday()) + DaysPerWeek) % DaysPerWeek)
+	p.pos++
+	return true
+}
+
+// adds the value of the unit.
+func (p *relativeParser) add(unit string, value int) {
+	switch unit {
+	case "second":
+		p.c = p.c.AddSeconds(value)
+	case "minute":
+		p.c = p.c.AddMinutes(value)
+	case "hour":
+		p.c = p.c.AddHours(value)
+	case "day":
+		p.c = p.c.AddDays(value)
+	case "weekday":
+		step := 1
+		if value < 0 {
+			value, step = -value, -1
+		}
+		for value > 0 {
+			p.c = p.c.AddDays(step)
+			if p.c.IsWeekday() {
+				value--
+			}
+		}
+	case "week":
+		p.c = p.c.AddWeeks(value)
+	case "fortnight":
+		p.c = p.c.AddWeeks(value * 2)
+	case "month":
+		p.c = p.c.AddMonths(value)
+	case "year":
+		p.c = p.c.AddYears(value)
+	}
+}
+
+// peeks the token at the offset of the current position.
+func (p *relativeParser) peek(offset int) string {
+	if p.pos+offset < len(p.tokens) {
+		return p.tokens[p.pos+offset]
+	}
+	return ""
+}
+
+// tokenizes a relative expression, a number followed by a unit like "+3weeks" is split into two tokens.
+func tokenizeRelative(expr string) []string {
+	fields := strings.Fields(strings.ReplaceAll(strings.ToLower(expr), ",", " "))
+	tokens := make([]string, 0, len(fields))
+	for _, field := range fields {
+		i := strings.IndexFunc(field, func(r rune) bool {
+			return r >= 'a' && r <= 'z'
+		})
+		if i > 0 {
+			if _, ok := relativeUnits[field[i:]]; ok {
+				if _, ok = parseRelativeNumber(field[:i]); ok {
+					tokens = append(tokens, field[:i], field[i:])
+					continue
+				}
+			}
+		}
+		tokens = append(tokens, field)
+	}
+	return tokens
+}
+
+// parses a number like "3", "+3", "-3", "a" or "an".
+func parseRelativeNumber(token string) (int, bool) {
+	if token == "a" || token == "an" {
+		return 1, true
+	}
+	if token == "" || token == "+" || token == "-" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(token)
+	return n, err == nil
+}
+
+// normalizes a localized relative expression into English, like "下周一" into "next monday" and "三天后" into "3 day later".
+func (lang *Language) normalizeRelative(expr string) string {
+	if lang == nil {
+		return expr
+	}
+	lang.rw.RLock()
+	resources := lang.resources
+	lang.rw.RUnlock()
+	if len(resources) == 0 {
+		return expr
+	}
+
+	fields := strings.Fields(expr)
+	segments := make([]string, 0, len(fields))
+	for i := 0; i < len(fields); i++ {
+		// a number spaced from its unit like "2 小时前" is normalized as a whole
+		if i+1 < len(fields) && isRelativeNumber(fields[i]) {
+			joined := fields[i] + " " + fields[i+1]
+			if target := normalizeRelativeSegment(resources, joined); target != joined {
+				segments = append(segments, target)
+				i++
+				continue
+			}
+		}
+		segments = append(segments, normalizeRelativeSegment(resources, fields[i]))
+	}
+	return strings.Join(segments, " ")
+}
+
+// reports whether a segment is an arabic or chinese number like "2" or "三".
+func isRelativeNumber(segment string) bool {
+	if _, ok := parseRelativeNumber(segment); ok {
+		return true
+	}
+	_, ok := parseChineseNumber(segment)
+	return ok
+}
+
+// normalizes a localized segment of a relative expression into English.
+func normalizeRelativeSegment(resources map[string]string, segment string) string {
+	for _, keyword := range []string{"now", "today", "tomorrow", "yesterday"} {
+		for _, word := range splitResource(resources[keyword]) {
+			if segment == word {
+				return keyword
+			}
+		}
+	}
+
+	// keywords like "后天" and "前天"
+	for key, phrase := range map[string]string{"day_after_tomorrow": "tomorrow +1 day", "day_before_yesterday": "yesterday -1 day"} {
+		for _, word := range splitResource(resources[key]) {
+			if segment == word {
+				return phrase
+			}
+		}
+	}
+
+	// ordinal days like "下个月最后一天" and "三月第一天"
+	for key, ordinal := range map[string]string{"first_day_of": "first", "last_day_of": "last"} {
+		for _, tmpl := range splitResource(resources[key]) {
+			inner, ok := matchTemplate(tmpl, "%s", segment)
+			if !ok {
+				continue
+			}
+			if target := normalizeRelativeSegment(resources, inner); target != inner {
+				return ordinal + " day of " + target
+			}
+		}
+	}
+
+	// offsets like "三天后" and "2 小时前"
+	for key, suffix := range map[string]string{"ago": "ago", "before": "ago", "from_now": "later", "after": "later"} {
+		for _, tmpl := range splitResource(resources[key]) {
+			inner, ok := matchTemplate(tmpl, "%s", segment)
+			if !ok {
+				continue
+			}
+			if value, unit, ok := parseLocalizedOffset(resources, inner); ok {
+				return fmt.Sprintf("%d %s %s", value, unit, suffix)
+			}
+		}
+	}
+
+	// directions like "下周一" and "上个月"
+	for _, key := range []string{"next", "last", "this"} {
+		for _, tmpl := range splitResource(resources[key]) {
+			inner, ok := matchTemplate(tmpl, "%s", segment)
+			if !ok {
+				continue
+			}
+			if weekday, ok := localizedWeekday(resources, inner); ok {
+				return key + " " + weekday
+			}
+			if unit, ok := localizedUnit(resources, inner); ok {
+				return key + " " + unit
+			}
+		}
+	}
+
+	if weekday, ok := localizedWeekday(resources, segment); ok {
+		return weekday
+	}
+	for _, key := range []string{"months", "short_months"} {
+		if months := strings.Split(resources[key], "|"); len(months) == MonthsPerYear {
+			for i, month := range months {
+				if segment == month {
+					return strings.ToLower(time.Month(i + 1).String())
+				}
+			}
+		}
+	}
+	return segment
+}
+
+// parses a localized offset like "三天" or "2 小时" by the unit resources.
+func parseLocalizedOffset(resources map[string]string, value string) (int, string, bool) {
+	for _, unit := range []string{"year", "month", "week", "day", "hour", "minute", "second"} {
+		for _, tmpl := range pluralForms(resources[unit]) {
+			if !strings.Contains(tmpl, "%d") {
+				if strings.TrimSpace(tmpl) == value {
+					return 1, unit, true
+				}
+				continue
+			}
+			number, ok := matchTemplate(tmpl, "%d", value)
+			if !ok {
+				continue
+			}
+			if n, err := strconv.Atoi(number); err == nil {
+				return n, unit, true
+			}
+			if n, ok := parseChineseNumber(number); ok {
+				return n, unit, true
+			}
+		}
+	}
+	return 0, "", false
+}
+
+// gets the English weekday of a localized weekday like "周一" or "星期一".
+func localizedWeekday(resources map[string]string, value string) (string, bool) {
+	for _, key := range []string{"weeks", "short_weeks"} {
+		if weeks := strings.Split(resources[key], "|"); len(weeks) == DaysPerWeek {
+			for i, week := range weeks {
+				if value == week {
+					return strings.ToLower(Weekday(i).String()), true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// gets the English unit of a localized unit like "周" or "个月" by the unit resources.
+func localizedUnit(resources map[string]string, value string) (string, bool) {
+	for _, unit := range []string{"year", "month", "week", "day", "hour", "minute", "second"} {
+		for _, tmpl := range pluralForms(resources[unit]) {
+			if strings.Contains(tmpl, "%d") && strings.TrimSpace(strings.Replace(tmpl, "%d", "", 1)) == value {
+				return unit, true
+			}
+		}
+	}
+	return "", false
+}
+
+// matches a value with a template like "%s后", and returns the trimmed part of the placeholder.
+func matchTemplate(tmpl, placeholder, value string) (string, bool) {
+	prefix, suffix, found := strings.Cut(tmpl, placeholder)
+	if !found {
+		return "", false
+	}
+	prefix, suffix = strings.TrimSpace(prefix), strings.TrimSpace(suffix)
+	if !strings.HasPrefix(value, prefix) || !strings.HasSuffix(value, suffix) || len(value) <= len(prefix)+len(suffix) {
+		return "", false
+	}
+	return strings.TrimSpace(value[len(prefix) : len(value)-len(suffix)]), true
+}
+
+// splits a resource into alternatives like "下%s|下个%s".
+func splitResource(resource string) []string {
+	if resource == "" {
+		return nil
+	}
+	return strings.Split(resource, "|")
+}
@@ -0,0 +1,72 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+)
+
+func BenchmarkParseRelative(b *testing.B) {
+	base := Parse("2020-08-05 13:14:15")
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			ParseRelative("last day of next month", base)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ParseRelative("last day of next month", base)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				ParseRelative("last day of next month", base)
+			}
+		})
+	})
+}
+
+func BenchmarkParseRelative_Localized(b *testing.B) {
+	base := Parse("2020-08-05 13:14:15").SetLocale("zh-CN")
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			ParseRelative("三天后", base)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ParseRelative("三天后", base)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				ParseRelative("三天后", base)
+			}
+		})
+	})
+}
@@ -0,0 +1,37 @@
+package carbon_test
+
+import (
+	"fmt"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func ExampleParseRelative() {
+	base := carbon.Parse("2020-08-05 13:14:15")
+	fmt.Println(carbon.ParseRelative("next monday", base).ToString())
+	fmt.Println(carbon.ParseRelative("last day of next month", base).ToString())
+	fmt.Println(carbon.ParseRelative("+3 weeks 2 days", base).ToString())
+	fmt.Println(carbon.ParseRelative("first friday of March", base).ToString())
+	fmt.Println(carbon.ParseRelative("tomorrow 9:30am", base).ToString())
+	fmt.Println(carbon.ParseRelative("midnight", base).ToString())
+
+	// Output:
+	// 2020-08-10 00:00:00 +0000 UTC
+	// 2020-09-30 13:14:15 +0000 UTC
+	// 2020-08-28 13:14:15 +0000 UTC
+	// 2020-03-06 00:00:00 +0000 UTC
+	// 2020-08-06 09:30:00 +0000 UTC
+	// 2020-08-05 00:00:00 +0000 UTC
+}
+
+func ExampleParseRelative_localized() {
+	base := carbon.Parse("2020-08-05 13:14:15").SetLocale("zh-CN")
+	fmt.Println(carbon.ParseRelative("下周一", base).ToString())
+	fmt.Println(carbon.ParseRelative("三天后", base).ToString())
+	fmt.Println(carbon.ParseRelative("上个月", base).ToString())
+
+	// Output:
+	// 2020-08-10 00:00:00 +0000 UTC
+	// 2020-08-08 13:14:15 +0000 UTC
+	// 2020-07-05 13:14:15 +0000 UTC
+}
@@ -0,0 +1,198 @@
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RelativeSuite struct {
+	suite.Suite
+}
+
+func TestRelativeSuite(t *testing.T) {
+	suite.Run(t, new(RelativeSuite))
+}
+
+func (s *RelativeSuite) TestParseRelative() {
+	base := Parse("2020-08-05 13:14:15")
+
+	s.Run("empty expression", func() {
+		s.True(ParseRelative("", base).IsEmpty())
+		s.True(ParseRelative("  ", base).IsEmpty())
+	})
+
+	s.Run("invalid base", func() {
+		s.Error(ParseRelative("today", nil).Error)
+		s.True(ParseRelative("today", Parse("")).IsEmpty())
+		s.Error(ParseRelative("today", Parse("xxx")).Error)
+	})
+
+	s.Run("invalid expression", func() {
+		expressions := []string{
+			"xxx", "3", "+", "3 xxx", "next", "next xxx", "last", "first day",
+			"first hour of next month", "third day of next month", "13pm", "0am", "24:00", "9:60", "9:5",
+			"1:2:3:4", "tomorrow xxx",
+		}
+		for _, expr := range expressions {
+			c := ParseRelative(expr, base)
+			s.Error(c.Error, expr)
+		}
+		s.Equal("2020-08-05 13:14:15", base.ToDateTimeString())
+	})
+
+	s.Run("without base", func() {
+		s.Equal(Now().ToDateString(), ParseRelative("today").ToDateString())
+		s.Equal(Tomorrow().ToDateString(), ParseRelative("tomorrow").ToDateString())
+	})
+
+	s.Run("keyword", func() {
+		s.Equal("2020-08-05 13:14:15", ParseRelative("now", base).ToDateTimeString())
+		s.Equal("2020-08-05 00:00:00", ParseRelative("today", base).ToDateTimeString())
+		s.Equal("2020-08-05 00:00:00", ParseRelative("midnight", base).ToDateTimeString())
+		s.Equal("2020-08-05 12:00:00", ParseRelative("noon", base).ToDateTimeString())
+		s.Equal("2020-08-06 00:00:00", ParseRelative("tomorrow", base).ToDateTimeString())
+		s.Equal("2020-08-04 00:00:00", ParseRelative("Yesterday", base).ToDateTimeString())
+		s.Equal("2020-08-06 12:00:00", ParseRelative("tomorrow noon", base).ToDateTimeString())
+		s.Equal("2020-08-06 12:00:00", ParseRelative("noon tomorrow", base).ToDateTimeString())
+	})
+
+	s.Run("offset", func() {
+		s.Equal("2020-08-28 13:14:15", ParseRelative("+3 weeks 2 days", base).ToDateTimeString())
+		s.Equal("2020-08-24 13:14:15", ParseRelative("+3weeks -2days", base).ToDateTimeString())
+		s.Equal("2020-08-02 13:14:15", ParseRelative("3 days ago", base).ToDateTimeString())
+		s.Equal("2020-08-03 10:14:15", ParseRelative("2 days 3 hours ago", base).ToDateTimeString())
+		s.Equal("2020-08-05 15:14:15", ParseRelative("in 2 hours", base).ToDateTimeString())
+		s.Equal("2020-08-12 13:14:15", ParseRelative("a week from now", base).ToDateTimeString())
+		s.Equal("2020-08-19 13:14:15", ParseRelative("1 fortnight later", base).ToDateTimeString())
+		s.Equal("2020-09-05 13:14:15", ParseRelative("+1 month", base).ToDateTimeString())
+		s.Equal("2019-08-05 13:14:15", ParseRelative("-1 year", base).ToDateTimeString())
+		s.Equal("2020-08-05 13:15:45", ParseRelative("+30 secs 1 min", base).ToDateTimeString())
+		s.Equal("2020-08-10 13:14:15", ParseRelative("+3 weekdays", base).ToDateTimeString())
+		s.Equal("2020-07-31 13:14:15", ParseRelative("-3 weekdays", base).ToDateTimeString())
+	})
+
+	s.Run("weekday", func() {
+		s.Equal("2020-08-10 00:00:00", ParseRelative("next monday", base).ToDateTimeString())
+		s.Equal("2020-08-03 00:00:00", ParseRelative("last monday", base).ToDateTimeString())
+		s.Equal("2020-08-12 00:00:00", ParseRelative("next wednesday", base).ToDateTimeString())
+		s.Equal("2020-07-29 00:00:00", ParseRelative("previous wed", base).ToDateTimeString())
+		s.Equal("2020-08-05 00:00:00", ParseRelative("this wednesday", base).ToDateTimeString())
+		s.Equal("2020-08-07 00:00:00", ParseRelative("this friday", base).ToDateTimeString())
+		s.Equal("2020-08-05 00:00:00", ParseRelative("Wednesday", base).ToDateTimeString())
+		s.Equal("2020-08-09 00:00:00", ParseRelative("sun", base).ToDateTimeString())
+	})
+
+	s.Run("direction", func() {
+		s.Equal("2020-08-12 13:14:15", ParseRelative("next week", base).ToDateTimeString())
+		s.Equal("2020-07-05 13:14:15", ParseRelative("last month", base).ToDateTimeString())
+		s.Equal("2020-08-05 13:14:15", ParseRelative("this year", base).ToDateTimeString())
+		s.Equal("2021-08-05 13:14:15", ParseRelative("next year", base).ToDateTimeString())
+		s.Equal("2020-08-05 14:14:15", ParseRelative("next hour", base).ToDateTimeString())
+	})
+
+	s.Run("ordinal day", func() {
+		s.Equal("2020-09-01 13:14:15", ParseRelative("first day of next month", base).ToDateTimeString())
+		s.Equal("2020-09-30 13:14:15", ParseRelative("last day of next month", base).ToDateTimeString())
+		s.Equal("2020-02-29 13:14:15", ParseRelative("last day of February", base).ToDateTimeString())
+		s.Equal("2020-08-01 13:14:15", ParseRelative("first day of this month", base).ToDateTimeString())
+		s.Equal("2020-08-31 13:14:15", ParseRelative("last day of", base).ToDateTimeString())
+		s.Equal("2019-12-31 13:14:15", ParseRelative("last day of last year", base).ToDateTimeString())
+		s.Equal("2021-01-01 13:14:15", ParseRelative("first day of next year", base).ToDateTimeString())
+		s.Equal("2021-02-28 13:14:15", ParseRelative("last day of feb 2021", base).ToDateTimeString())
+		s.Equal("2020-02-29 13:14:15", ParseRelative("last day of next month", Parse("2020-01-31 13:14:15")).ToDateTimeString())
+	})
+
+	s.Run("ordinal weekday", func() {
+		s.Equal("2020-03-06 00:00:00", ParseRelative("first friday of March", base).ToDateTimeString())
+		s.Equal("2020-09-25 00:00:00", ParseRelative("last friday of next month", base).ToDateTimeString())
+		s.Equal("2026-03-09 00:00:00", ParseRelative("second monday of March 2026", base).ToDateTimeString())
+		s.Equal("2020-08-26 00:00:00", ParseRelative("4th wednesday of this month", base).ToDateTimeString())
+		s.Equal("2021-01-04 00:00:00", ParseRelative("first monday of next year", base).ToDateTimeString())
+		s.Equal("2020-03-06 00:00:00", ParseRelative("fifth friday of february", base).ToDateTimeString())
+	})
+
+	s.Run("clock", func() {
+		s.Equal("2020-08-06 09:30:00", ParseRelative("tomorrow 9:30am", base).ToDateTimeString())
+		s.Equal("2020-08-10 14:30:00", ParseRelative("next monday at 14:30", base).ToDateTimeString())
+		s.Equal("2020-08-05 00:00:00", ParseRelative("12am", base).ToDateTimeString())
+		s.Equal("2020-08-05 12:00:00", ParseRelative("12pm", base).ToDateTimeString())
+		s.Equal("2020-08-05 21:00:00", ParseRelative("9 pm", base).ToDateTimeString())
+		s.Equal("2020-08-05 23:59:59", ParseRelative("23:59:59", base).ToDateTimeString())
+		s.Equal("2020-09-30 00:00:00", ParseRelative("midnight last day of next month", base).ToDateTimeString())
+		s.Zero(ParseRelative("noon", Parse("2020-08-05 13:14:15.999999999")).Nanosecond())
+	})
+
+	s.Run("keep settings of base", func() {
+		c := ParseRelative("tomorrow", Parse("2020-08-05 13:14:15", PRC).SetLocale("zh-CN").SetWeekStartsAt(Sunday))
+		s.Equal("2020-08-06 00:00:00 +0800 CST", c.ToString())
+		s.Equal("zh-CN", c.Locale())
+		s.Equal(Sunday, c.WeekStartsAt())
+	})
+}
+
+func (s *RelativeSuite) TestParseRelative_Localized() {
+	s.Run("simplified chinese", func() {
+		base := Parse("2020-08-05 13:14:15").SetLocale("zh-CN")
+		s.Equal("2020-08-10 00:00:00", ParseRelative("下周一", base).ToDateTimeString())
+		s.Equal("2020-08-10 00:00:00", ParseRelative("下个星期一", base).ToDateTimeString())
+		s.Equal("2020-07-31 00:00:00", ParseRelative("上周五", base).ToDateTimeString())
+		s.Equal("2020-08-07 00:00:00", ParseRelative("这周五", base).ToDateTimeString())
+		s.Equal("2020-08-07 00:00:00", ParseRelative("星期五", base).ToDateTimeString())
+		s.Equal("2020-08-12 13:14:15", ParseRelative("下周", base).ToDateTimeString())
+		s.Equal("2020-07-05 13:14:15", ParseRelative("上个月", base).ToDateTimeString())
+		s.Equal("2020-08-08 13:14:15", ParseRelative("三天后", base).ToDateTimeString())
+		s.Equal("2020-08-02 13:14:15", ParseRelative("3天前", base).ToDateTimeString())
+		s.Equal("2020-08-05 15:14:15", ParseRelative("两小时后", base).ToDateTimeString())
+		s.Equal("2021-08-05 13:14:15", ParseRelative("十二个月后", base).ToDateTimeString())
+		s.Equal("2020-08-05 11:14:15", ParseRelative("2 小时前", base).ToDateTimeString())
+		s.Equal("2020-08-08 13:14:15", ParseRelative("3 天后", base).ToDateTimeString())
+		s.Equal("2020-08-08 13:14:15", ParseRelative("三 天后", base).ToDateTimeString())
+		s.Equal("2020-08-05 23:00:00", ParseRelative("明天 1 小时前", base).ToDateTimeString())
+		s.Equal("2020-08-06 10:30:00", ParseRelative("明天 10:30", base).ToDateTimeString())
+		s.Equal("2020-08-04 00:00:00", ParseRelative("昨天", base).ToDateTimeString())
+		s.Equal("2020-08-07 00:00:00", ParseRelative("后天", base).ToDateTimeString())
+		s.Equal("2020-08-03 00:00:00", ParseRelative("前天", base).ToDateTimeString())
+		s.Equal("2020-08-07 09:00:00", ParseRelative("后天 9:00", base).ToDateTimeString())
+		s.Equal("2020-09-30 13:14:15", ParseRelative("下个月最后一天", base).ToDateTimeString())
+		s.Equal(ParseRelative("last day of next month", base).ToDateTimeString(), ParseRelative("下个月最后一天", base).ToDateTimeString())
+		s.Equal("2020-07-01 13:14:15", ParseRelative("上个月第一天", base).ToDateTimeString())
+		s.Equal("2020-08-31 13:14:15", ParseRelative("这个月最后一天", base).ToDateTimeString())
+		s.Equal("2020-03-31 13:14:15", ParseRelative("三月最后一天", base).ToDateTimeString())
+		s.Error(ParseRelative("下周八最后一天", base).Error)
+		s.Equal("2020-08-10 00:00:00", ParseRelative("next monday", base).ToDateTimeString())
+		s.Error(ParseRelative("下周八", base).Error)
+		s.Error(ParseRelative("三年", base).Error)
+	})
+
+	s.Run("traditional chinese", func() {
+		base := Parse("2020-08-05 13:14:15").SetLocale("zh-TW")
+		s.Equal("2020-08-10 00:00:00", ParseRelative("下週一", base).ToDateTimeString())
+		s.Equal("2020-11-05 13:14:15", ParseRelative("三個月後", base).ToDateTimeString())
+		s.Equal("2020-08-06 00:00:00", ParseRelative("明天", base).ToDateTimeString())
+		s.Equal("2020-08-07 00:00:00", ParseRelative("後天", base).ToDateTimeString())
+		s.Equal("2020-08-03 00:00:00", ParseRelative("前天", base).ToDateTimeString())
+		s.Equal("2020-09-30 13:14:15", ParseRelative("下個月最後一天", base).ToDateTimeString())
+	})
+
+	s.Run("without localized resources", func() {
+		base := Parse("2020-08-05 13:14:15").SetLocale("en")
+		s.Error(ParseRelative("下周一", base).Error)
+
+		base = Parse("2020-08-05 13:14:15")
+		base.lang = nil
+		s.Equal("2020-08-10 00:00:00", ParseRelative("next monday", base).ToDateTimeString())
+	})
+
+	s.Run("customized resources", func() {
+		lang := NewLanguage()
+		lang.SetLocale("en").SetResources(map[string]string{
+			"tomorrow": "demain",
+			"weeks":    "dimanche|lundi|mardi|mercredi|jeudi|vendredi|samedi",
+		})
+		base := Parse("2020-08-05 13:14:15").SetLanguage(lang)
+		s.Equal("2020-08-06 00:00:00", ParseRelative("demain", base).ToDateTimeString())
+		s.Equal("2020-08-10 00:00:00", ParseRelative("lundi", base).ToDateTimeString())
+		s.Equal("2020-08-10 00:00:00", ParseRelative("monday", base).ToDateTimeString())
+	})
+}
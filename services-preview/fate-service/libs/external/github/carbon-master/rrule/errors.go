@@ -0,0 +1,52 @@
+package rrule
+
+import (
+	"fmt"
+)
+
+var (
+	// ErrFailedParse failed to parse error.
+	ErrFailedParse = func(value any) error {
+		return fmt.Errorf("failed to parse %v as recurrence rule", value)
+	}
+
+	// ErrMissingFreq missing freq error.
+	ErrMissingFreq = func() error {
+		return fmt.Errorf("recurrence rule must contain a FREQ rule part")
+	}
+
+	// ErrInvalidPart invalid rule part error.
+	ErrInvalidPart = func(name, value string) error {
+		return fmt.Errorf("invalid rule part %s=%s", name, value)
+	}
+
+	// ErrUnknownPart unknown rule part error.
+	ErrUnknownPart = func(name string) error {
+		return fmt.Errorf("unknown rule part %q", name)
+	}
+
+	// ErrConflictingParts conflicting rule parts error.
+	ErrConflictingParts = func(name1, name2 string) error {
+		return fmt.Errorf("rule part %s cannot be used with %s", name1, name2)
+	}
+
+	// ErrUnsupportedProperty unsupported property error.
+	ErrUnsupportedProperty = func(name string) error {
+		return fmt.Errorf("unsupported property %q", name)
+	}
+
+	// ErrNilRule nil rule error.
+	ErrNilRule = func() error {
+		return fmt.Errorf("rule cannot be nil")
+	}
+
+	// ErrNilDTStart nil dtstart error.
+	ErrNilDTStart = func() error {
+		return fmt.Errorf("dtstart cannot be nil")
+	}
+
+	// ErrInvalidDTStart invalid dtstart error.
+	ErrInvalidDTStart = func() error {
+		return fmt.Errorf("dtstart cannot be empty or zero")
+	}
+)
@@ -0,0 +1,543 @@
+package rrule
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+
+	// maxCachedMonths is the maximum number of months whose selected dates are cached
+	maxCachedMonths = 64
+)
+
+// expander defines an expander struct which expands the occurrences of a rule period by period,
+// all dates and times are wall clock times in UTC until they are resolved in the location of DTSTART.
+type expander struct {
+	rule     *Rule
+	scale    scale
+	loc      *time.Location
+	dtstart  time.Time
+	until    time.Time
+	hasUntil bool
+
+	// year and index locate the current period of the yearly and monthly frequencies,
+	// cursor is the start of the current period of the other frequencies
+	year, index int
+	cursor      time.Time
+
+	byMonth, byLeapMonth, byMonthDay []int
+	byDay                            []Weekday
+	hours, minutes, seconds          []int
+	nanosecond                       int
+
+	cache   map[time.Time][]time.Time
+	buffer  []time.Time
+	last    time.Time
+	hasLast bool
+	count   int
+	done    bool
+}
+
+// newExpander returns a new expander instance of the rule starting at dtstart.
+func newExpander(r *Rule, dtstart time.Time) *expander {
+	e := &expander{
+		rule:    r.Copy(),
+		scale:   newScale(r.RScale),
+		loc:     dtstart.Location(),
+		dtstart: dtstart,
+		cache:   make(map[time.Time][]time.Time),
+	}
+	r = e.rule
+	if r.Interval < 1 {
+		r.Interval = 1
+	}
+	if r.Until != nil {
+		e.until, e.hasUntil = r.Until.StdTime(), true
+		if r.isFloatingUntil {
+			e.until = resolve(wallClock(e.until), e.loc)
+		}
+	}
+
+	wall := wallClock(dtstart)
+	date := truncate(wall, day)
+	m, d, ok := e.locate(date)
+	if !ok {
+		e.done = true
+		return e
+	}
+
+	e.byMonth, e.byLeapMonth, e.byMonthDay, e.byDay = r.ByMonth, r.ByLeapMonth, r.ByMonthDay, r.ByDay
+	if len(r.ByWeekNo) == 0 && len(r.ByYearDay) == 0 && len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		switch r.Freq {
+		case Yearly:
+			if len(r.ByMonth) == 0 && len(r.ByLeapMonth) == 0 {
+				if m.isLeap {
+					e.byLeapMonth = []int{m.month}
+				} else {
+					e.byMonth = []int{m.month}
+				}
+			}
+			e.byMonthDay = []int{d}
+		case Monthly:
+			e.byMonthDay = []int{d}
+		case Weekly:
+			e.byDay = []Weekday{{Weekday: date.Weekday()}}
+		}
+	}
+	e.hours = sortInts(r.ByHour, wall.Hour())
+	e.minutes = sortInts(r.ByMinute, wall.Minute())
+	e.seconds = sortInts(r.BySecond, wall.Second())
+	e.nanosecond = wall.Nanosecond()
+
+	switch r.Freq {
+	case Yearly:
+		e.year = m.year
+	case Monthly:
+		e.year = m.year
+		for i, month := range e.scale.months(m.year) {
+			if month.start.Equal(m.start) {
+				e.index = i
+			}
+		}
+	case Weekly:
+		e.cursor = date.AddDate(0, 0, -(int(date.Weekday())-int(r.WeekStart)+7)%7)
+	case Daily:
+		e.cursor = date
+	case Hourly:
+		e.cursor = truncate(wall, time.Hour)
+	case Minutely:
+		e.cursor = truncate(wall, time.Minute)
+	case Secondly:
+		e.cursor = truncate(wall, time.Second)
+	}
+	return e
+}
+
+// next returns the next occurrence, ok is false once the occurrences are exhausted.
+func (e *expander) next() (t time.Time, ok bool) {
+	for len(e.buffer) == 0 {
+		if e.done {
+			return t, false
+		}
+		e.expand()
+	}
+	t, e.buffer = e.buffer[0], e.buffer[1:]
+	return t, true
+}
+
+// expand expands the occurrences of the current period into the buffer and advances to the next period.
+func (e *expander) expand() {
+	dates, start, ok := e.period()
+	if !ok {
+		e.done = true
+		return
+	}
+	offsets := e.offsets(start)
+	candidates := make([]time.Time, 0, len(dates)*len(offsets))
+	for _, date := range dates {
+		for _, offset := range offsets {
+			candidate := date.Add(offset)
+			if n := len(candidates); n > 0 && !candidate.After(candidates[n-1]) {
+				continue
+			}
+			candidates = append(candidates, candidate)
+		}
+	}
+	if len(e.rule.BySetPos) > 0 {
+		candidates = setPos(candidates, e.rule.BySetPos)
+	}
+	for _, candidate := range candidates {
+		t := resolve(candidate, e.loc)
+		if t.Before(e.dtstart) || (e.hasLast && !t.After(e.last)) {
+			continue
+		}
+		if e.hasUntil && t.After(e.until) {
+			e.done = true
+			return
+		}
+		e.buffer = append(e.buffer, t)
+		e.last, e.hasLast = t, true
+		if e.count++; e.rule.Count > 0 && e.count >= e.rule.Count {
+			e.done = true
+			return
+		}
+	}
+}
+
+// period returns the selected dates and the start of the current period, then advances to the next period.
+func (e *expander) period() (dates []time.Time, start time.Time, ok bool) {
+	r := e.rule
+	switch r.Freq {
+	case Yearly:
+		months := e.scale.months(e.year)
+		if months == nil {
+			return nil, start, false
+		}
+		for _, m := range months {
+			dates = append(dates, e.monthDates(m)...)
+		}
+		e.year += r.Interval
+	case Monthly:
+		months := e.scale.months(e.year)
+		if months == nil {
+			return nil, start, false
+		}
+		dates = append(dates, e.monthDates(months[e.index])...)
+		for e.index += r.Interval; months != nil && e.index >= len(months); months = e.scale.months(e.year) {
+			e.index -= len(months)
+			e.year++
+		}
+	case Weekly:
+		if !e.inRange(e.cursor) {
+			return nil, start, false
+		}
+		for i := 0; i < 7; i++ {
+			if date := e.cursor.AddDate(0, 0, i); e.isSelected(date) {
+				dates = append(dates, date)
+			}
+		}
+		e.cursor = e.cursor.AddDate(0, 0, 7*r.Interval)
+	case Daily:
+		if !e.inRange(e.cursor) {
+			return nil, start, false
+		}
+		if e.isSelected(e.cursor) {
+			dates = append(dates, e.cursor)
+		}
+		e.cursor = e.cursor.AddDate(0, 0, r.Interval)
+	default:
+		date := truncate(e.cursor, day)
+		if !e.inRange(date) {
+			return nil, start, false
+		}
+		step := time.Duration(r.Interval) * e.unit()
+		if !e.isSelected(date) {
+			// skip the remaining periods of the day at once
+			n := (date.Add(day).Sub(e.cursor) + step - 1) / step
+			e.cursor = e.cursor.Add(n * step)
+			return nil, start, true
+		}
+		dates, start = []time.Time{date}, e.cursor
+		e.cursor = e.cursor.Add(step)
+		return dates, start, true
+	}
+	if r.Freq <= Monthly {
+		filtered := dates[:0]
+		for _, date := range dates {
+			if e.matches(date) {
+				filtered = append(filtered, date)
+			}
+		}
+		dates = filtered
+	}
+	return dates, start, true
+}
+
+// offsets returns the time offsets from the midnight of the dates in the period starting at start.
+func (e *expander) offsets(start time.Time) []time.Duration {
+	r := e.rule
+	hours, minutes, seconds := e.hours, e.minutes, e.seconds
+	if r.Freq >= Hourly {
+		if !containsOrEmpty(r.ByHour, start.Hour()) {
+			return nil
+		}
+		hours = []int{start.Hour()}
+	}
+	if r.Freq >= Minutely {
+		if !containsOrEmpty(r.ByMinute, start.Minute()) {
+			return nil
+		}
+		minutes = []int{start.Minute()}
+	}
+	if r.Freq == Secondly {
+		if !containsOrEmpty(r.BySecond, start.Second()) {
+			return nil
+		}
+		seconds = []int{start.Second()}
+	}
+	offsets := make([]time.Duration, 0, len(hours)*len(minutes)*len(seconds))
+	for _, h := range hours {
+		for _, m := range minutes {
+			for _, s := range seconds {
+				offset := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second
+				offsets = append(offsets, offset+time.Duration(e.nanosecond))
+			}
+		}
+	}
+	return offsets
+}
+
+// monthDates returns the dates of the month selected by BYMONTH and BYMONTHDAY,
+// a nonexistent date is moved to the last day of the month or the first day of the next month by SKIP.
+func (e *expander) monthDates(m month) []time.Time {
+	if dates, ok := e.cache[m.start]; ok {
+		return dates
+	}
+	var dates []time.Time
+	if e.isSelectedMonth(m) {
+		if len(e.byMonthDay) == 0 {
+			for i := 0; i < m.days; i++ {
+				dates = append(dates, m.start.AddDate(0, 0, i))
+			}
+		} else {
+			offsets := make([]int, 0, len(e.byMonthDay))
+			for _, md := range e.byMonthDay {
+				switch {
+				case md > 0 && md <= m.days:
+					offsets = append(offsets, md-1)
+				case md < 0 && -md <= m.days:
+					offsets = append(offsets, m.days+md)
+				case md > 0 && e.rule.Skip == Backward:
+					offsets = append(offsets, m.days-1)
+				case md > 0 && e.rule.Skip == Forward:
+					offsets = append(offsets, m.days)
+				}
+			}
+			for _, offset := range sortInts(offsets) {
+				dates = append(dates, m.start.AddDate(0, 0, offset))
+			}
+		}
+	}
+	if len(e.cache) >= maxCachedMonths {
+		e.cache = make(map[time.Time][]time.Time)
+	}
+	e.cache[m.start] = dates
+	return dates
+}
+
+// isSelectedMonth reports whether the month is selected by BYMONTH,
+// a nonexistent leap month is moved to the previous or next month by SKIP.
+func (e *expander) isSelectedMonth(m month) bool {
+	if len(e.byMonth) == 0 && len(e.byLeapMonth) == 0 {
+		return true
+	}
+	if m.isLeap {
+		return contains(e.byLeapMonth, m.month)
+	}
+	if contains(e.byMonth, m.month) {
+		return true
+	}
+	target := m.month
+	switch e.rule.Skip {
+	case Backward:
+	case Forward:
+		target--
+	default:
+		return false
+	}
+	if !contains(e.byLeapMonth, target) {
+		return false
+	}
+	for _, month := range e.scale.months(m.year) {
+		if month.isLeap && month.month == target {
+			return false
+		}
+	}
+	return true
+}
+
+// isSelected reports whether the date is selected by all the BYxxx rule parts which limit dates.
+func (e *expander) isSelected(date time.Time) bool {
+	m, _, ok := e.locate(date)
+	if !ok {
+		return false
+	}
+	selected := false
+	for _, d := range e.monthDates(m) {
+		selected = selected || d.Equal(date)
+	}
+	// the date may be moved forward from the previous month by SKIP
+	if !selected && e.rule.Skip == Forward {
+		if prev, _, ok := e.locate(date.AddDate(0, 0, -1)); ok && !prev.start.Equal(m.start) {
+			for _, d := range e.monthDates(prev) {
+				selected = selected || d.Equal(date)
+			}
+		}
+	}
+	return selected && e.matches(date)
+}
+
+// matches reports whether the date matches BYWEEKNO, BYYEARDAY and BYDAY.
+func (e *expander) matches(date time.Time) bool {
+	r := e.rule
+	if len(r.ByWeekNo) > 0 {
+		week, weeks := weekNo(date, r.WeekStart)
+		if !contains(r.ByWeekNo, week) && !contains(r.ByWeekNo, week-weeks-1) {
+			return false
+		}
+	}
+	if len(r.ByYearDay) > 0 {
+		yearDay, days := e.yearDay(date)
+		if !contains(r.ByYearDay, yearDay) && !contains(r.ByYearDay, yearDay-days-1) {
+			return false
+		}
+	}
+	if len(e.byDay) == 0 {
+		return true
+	}
+	for _, wd := range e.byDay {
+		if wd.Weekday != date.Weekday() {
+			continue
+		}
+		if wd.N == 0 {
+			return true
+		}
+		// the n-th weekday is counted within the month unless a yearly rule has no BYMONTH
+		n, days := e.yearDay(date)
+		if r.Freq == Monthly || len(r.ByMonth) > 0 || len(r.ByLeapMonth) > 0 {
+			var m month
+			m, n, _ = e.locate(date)
+			days = m.days
+		}
+		if wd.N == (n-1)/7+1 || wd.N == -((days-n)/7+1) {
+			return true
+		}
+	}
+	return false
+}
+
+// locate returns the month containing the date and the day of the month.
+func (e *expander) locate(date time.Time) (m month, d int, ok bool) {
+	months := e.scale.months(e.scale.yearOf(date))
+	for i := len(months) - 1; i >= 0; i-- {
+		if !date.Before(months[i].start) {
+			return months[i], int(date.Sub(months[i].start)/day) + 1, true
+		}
+	}
+	return m, 0, false
+}
+
+// yearDay returns the day of the year of the date and the number of days in the year.
+func (e *expander) yearDay(date time.Time) (yearDay, days int) {
+	months := e.scale.months(e.scale.yearOf(date))
+	if len(months) == 0 {
+		return 0, 0
+	}
+	first, last := months[0], months[len(months)-1]
+	return int(date.Sub(first.start)/day) + 1, int(last.start.Sub(first.start)/day) + last.days
+}
+
+// inRange reports whether the date is in the range of the calendar scale.
+func (e *expander) inRange(date time.Time) bool {
+	return e.scale.months(e.scale.yearOf(date)) != nil
+}
+
+// unit returns the duration of a period of the hourly, minutely and secondly frequencies.
+func (e *expander) unit() time.Duration {
+	switch e.rule.Freq {
+	case Hourly:
+		return time.Hour
+	case Minutely:
+		return time.Minute
+	}
+	return time.Second
+}
+
+// weekNo returns the week number of the date and the number of weeks in its week numbering year,
+// week 1 is the first week starting at wkst which contains at least four days of the year.
+func weekNo(date time.Time, wkst time.Weekday) (n, weeks int) {
+	year := date.Year()
+	start := firstWeekStart(year, wkst)
+	if date.Before(start) {
+		year--
+		start = firstWeekStart(year, wkst)
+	} else if next := firstWeekStart(year+1, wkst); !date.Before(next) {
+		year++
+		start = next
+	}
+	return int(date.Sub(start)/week) + 1, int(firstWeekStart(year+1, wkst).Sub(start) / week)
+}
+
+// firstWeekStart returns the first day of week 1 of the year.
+func firstWeekStart(year int, wkst time.Weekday) time.Time {
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	offset := (int(jan1.Weekday()) - int(wkst) + 7) % 7
+	start := jan1.AddDate(0, 0, -offset)
+	if offset > 3 {
+		start = start.AddDate(0, 0, 7)
+	}
+	return start
+}
+
+// setPos returns the candidates at the positions of BYSETPOS.
+func setPos(candidates []time.Time, positions []int) []time.Time {
+	n := len(candidates)
+	indexes := make([]int, 0, len(positions))
+	for _, pos := range positions {
+		i := pos - 1
+		if pos < 0 {
+			i = n + pos
+		}
+		if i >= 0 && i < n {
+			indexes = append(indexes, i)
+		}
+	}
+	selected := make([]time.Time, 0, len(indexes))
+	for _, i := range sortInts(indexes) {
+		selected = append(selected, candidates[i])
+	}
+	return selected
+}
+
+// resolve converts a wall clock time to a time in the location, a nonexistent wall clock time in a
+// DST gap is interpreted with the offset before the gap as RFC 5545 requires, so it is moved forward.
+func resolve(wall time.Time, loc *time.Location) time.Time {
+	year, month, d := wall.Date()
+	hour, minute, second := wall.Clock()
+	t := time.Date(year, month, d, hour, minute, second, wall.Nanosecond(), loc)
+	if t.Day() == d && t.Hour() == hour && t.Minute() == minute && t.Second() == second {
+		return t
+	}
+	_, offset := time.Date(year, month, d-1, hour, minute, second, 0, loc).Zone()
+	return wall.Add(-time.Duration(offset) * time.Second).In(loc)
+}
+
+// wallClock returns the wall clock of the time in UTC.
+func wallClock(t time.Time) time.Time {
+	year, month, d := t.Date()
+	hour, minute, second := t.Clock()
+	return time.Date(year, month, d, hour, minute, second, t.Nanosecond(), time.UTC)
+}
+
+// truncate truncates the wall clock time to a multiple of the duration.
+func truncate(wall time.Time, d time.Duration) time.Time {
+	if d == day {
+		year, month, dd := wall.Date()
+		return time.Date(year, month, dd, 0, 0, 0, 0, time.UTC)
+	}
+	return wall.Truncate(d)
+}
+
+// sortInts returns the sorted and deduplicated integers, or the default if empty.
+func sortInts(values []int, defaults ...int) []int {
+	if len(values) == 0 {
+		return defaults
+	}
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+	unique := sorted[:1]
+	for _, v := range sorted[1:] {
+		if v != unique[len(unique)-1] {
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
+
+// contains reports whether the integers contain the value.
+func contains(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// containsOrEmpty reports whether the integers are empty or contain the value.
+func containsOrEmpty(values []int, value int) bool {
+	return len(values) == 0 || contains(values, value)
+}
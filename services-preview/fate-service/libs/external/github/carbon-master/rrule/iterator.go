@@ -0,0 +1,84 @@
+package rrule
+
+import (
+	"time"
+
+	"github.com/dromara/carbon/v2"
+)
+
+// Iterator defines an Iterator struct which expands occurrences lazily.
+type Iterator struct {
+	dtstart *carbon.Carbon
+	next    func() (time.Time, bool)
+	Error   error
+}
+
+// Next returns the next occurrence as a Carbon instance in the location of DTSTART,
+// ok is false once the occurrences are exhausted.
+func (it *Iterator) Next() (c *carbon.Carbon, ok bool) {
+	if it == nil || it.Error != nil || it.next == nil {
+		return nil, false
+	}
+	t, ok := it.next()
+	if !ok {
+		it.next = nil
+		return nil, false
+	}
+	return toCarbon(it.dtstart, t), true
+}
+
+// Take returns at most the next n occurrences.
+func (it *Iterator) Take(n int) []*carbon.Carbon {
+	occurrences := make([]*carbon.Carbon, 0)
+	for i := 0; i < n; i++ {
+		c, ok := it.Next()
+		if !ok {
+			break
+		}
+		occurrences = append(occurrences, c)
+	}
+	return occurrences
+}
+
+// Between returns the next occurrences between start and end, both are included.
+func (it *Iterator) Between(start, end *carbon.Carbon) []*carbon.Carbon {
+	occurrences := make([]*carbon.Carbon, 0)
+	if start.IsInvalid() || end.IsInvalid() {
+		return occurrences
+	}
+	for {
+		c, ok := it.Next()
+		if !ok || c.Gt(end) {
+			break
+		}
+		if c.Gte(start) {
+			occurrences = append(occurrences, c)
+		}
+	}
+	return occurrences
+}
+
+// toCarbon converts the time to a Carbon instance with the settings of dtstart like layout and locale.
+func toCarbon(dtstart *carbon.Carbon, t time.Time) *carbon.Carbon {
+	t = t.In(dtstart.StdTime().Location())
+	c := dtstart.Copy().SetDateTimeNano(t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+	// the second one of an ambiguous wall clock time needs to be adjusted
+	if d := t.Sub(c.StdTime()); d != 0 {
+		c = c.AddNanoseconds(int(d))
+	}
+	return c
+}
+
+// checkDTStart checks whether dtstart is a valid Carbon instance.
+func checkDTStart(dtstart *carbon.Carbon) error {
+	if dtstart.IsNil() {
+		return ErrNilDTStart()
+	}
+	if dtstart.HasError() {
+		return dtstart.Error
+	}
+	if dtstart.IsEmpty() || dtstart.IsZero() {
+		return ErrInvalidDTStart()
+	}
+	return nil
+}
@@ -0,0 +1,497 @@
+// Package rrule is part of the carbon package, it implements the recurrence rules of RFC 5545
+// and the non-gregorian recurrence rules of RFC 7529.
+package rrule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dromara/carbon/v2"
+)
+
+// Frequency defines a Frequency type.
+type Frequency int
+
+// frequency constants
+const (
+	Yearly Frequency = iota
+	Monthly
+	Weekly
+	Daily
+	Hourly
+	Minutely
+	Secondly
+)
+
+// rscale constants
+const (
+	Gregorian = "GREGORIAN"
+	Chinese   = "CHINESE"
+)
+
+// skip constants
+const (
+	Omit     = "OMIT"
+	Backward = "BACKWARD"
+	Forward  = "FORWARD"
+)
+
+var (
+	frequencies = []string{"YEARLY", "MONTHLY", "WEEKLY", "DAILY", "HOURLY", "MINUTELY", "SECONDLY"}
+	weekdays    = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+)
+
+// String implements "Stringer" interface for Frequency.
+func (f Frequency) String() string {
+	if f < Yearly || f > Secondly {
+		return ""
+	}
+	return frequencies[f]
+}
+
+// Weekday defines a Weekday struct, N is the n-th occurrence within the month or year like -1 in "-1FR".
+type Weekday struct {
+	Weekday carbon.Weekday
+	N       int
+}
+
+// weekday values
+var (
+	MO = Weekday{Weekday: carbon.Monday}
+	TU = Weekday{Weekday: carbon.Tuesday}
+	WE = Weekday{Weekday: carbon.Wednesday}
+	TH = Weekday{Weekday: carbon.Thursday}
+	FR = Weekday{Weekday: carbon.Friday}
+	SA = Weekday{Weekday: carbon.Saturday}
+	SU = Weekday{Weekday: carbon.Sunday}
+)
+
+// Nth returns the n-th occurrence of the weekday like MO.Nth(2) for "2MO".
+func (w Weekday) Nth(n int) Weekday {
+	w.N = n
+	return w
+}
+
+// String implements "Stringer" interface for Weekday.
+func (w Weekday) String() string {
+	if w.Weekday < carbon.Sunday || w.Weekday > carbon.Saturday {
+		return ""
+	}
+	if w.N == 0 {
+		return weekdays[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + weekdays[w.Weekday]
+}
+
+// Rule defines a Rule struct for the "RRULE" property.
+type Rule struct {
+	Freq        Frequency
+	Interval    int
+	Count       int
+	Until       *carbon.Carbon
+	BySecond    []int
+	ByMinute    []int
+	ByHour      []int
+	ByDay       []Weekday
+	ByMonthDay  []int
+	ByYearDay   []int
+	ByWeekNo    []int
+	ByMonth     []int
+	ByLeapMonth []int
+	BySetPos    []int
+	WeekStart   carbon.Weekday
+	RScale      string
+	Skip        string
+	Error       error
+
+	// isFloatingUntil reports whether UNTIL is a local time which follows the location of DTSTART
+	isFloatingUntil bool
+	// isDateUntil reports whether UNTIL is a DATE value like "19971224"
+	isDateUntil bool
+}
+
+// NewRule returns a new Rule instance, the week start defaults to carbon.DefaultWeekStartsAt.
+func NewRule(freq Frequency) *Rule {
+	return &Rule{Freq: freq, Interval: 1, WeekStart: carbon.DefaultWeekStartsAt}
+}
+
+// Parse parses a RRULE value like "FREQ=MONTHLY;BYDAY=2TU" as a Rule instance, the "RRULE:" prefix is optional.
+func Parse(value string) *Rule {
+	r := NewRule(Yearly)
+	s := strings.TrimSpace(value)
+	if len(s) >= 6 && strings.EqualFold(s[:6], "RRULE:") {
+		s = s[6:]
+	}
+	if s == "" {
+		r.Error = ErrFailedParse(value)
+		return r
+	}
+	hasFreq := false
+	for _, part := range strings.Split(s, ";") {
+		name, val, ok := strings.Cut(part, "=")
+		if !ok {
+			r.Error = ErrFailedParse(value)
+			return r
+		}
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if r.Error = r.setPart(name, strings.TrimSpace(val)); r.Error != nil {
+			return r
+		}
+		if name == "FREQ" {
+			hasFreq = true
+		}
+	}
+	if !hasFreq {
+		r.Error = ErrMissingFreq()
+		return r
+	}
+	r.Error = r.validate()
+	return r
+}
+
+// Copy returns a copy of the Rule instance.
+func (r *Rule) Copy() *Rule {
+	if r == nil {
+		return nil
+	}
+	rule := *r
+	rule.Until = r.Until.Copy()
+	rule.BySecond = copyInts(r.BySecond)
+	rule.ByMinute = copyInts(r.ByMinute)
+	rule.ByHour = copyInts(r.ByHour)
+	rule.ByDay = append([]Weekday(nil), r.ByDay...)
+	rule.ByMonthDay = copyInts(r.ByMonthDay)
+	rule.ByYearDay = copyInts(r.ByYearDay)
+	rule.ByWeekNo = copyInts(r.ByWeekNo)
+	rule.ByMonth = copyInts(r.ByMonth)
+	rule.ByLeapMonth = copyInts(r.ByLeapMonth)
+	rule.BySetPos = copyInts(r.BySetPos)
+	return &rule
+}
+
+// Iterator returns an Iterator instance which expands the occurrences of the rule starting at dtstart lazily.
+func (r *Rule) Iterator(dtstart *carbon.Carbon) *Iterator {
+	it := new(Iterator)
+	if r == nil {
+		it.Error = ErrNilRule()
+		return it
+	}
+	if r.Error != nil {
+		it.Error = r.Error
+		return it
+	}
+	if it.Error = checkDTStart(dtstart); it.Error != nil {
+		return it
+	}
+	if it.Error = r.validate(); it.Error != nil {
+		return it
+	}
+	it.dtstart = dtstart.Copy()
+	it.next = newExpander(r, dtstart.StdTime()).next
+	return it
+}
+
+// String implements "Stringer" interface for Rule.
+func (r *Rule) String() string {
+	if r == nil || r.Error != nil {
+		return ""
+	}
+	parts := make([]string, 0, 16)
+	if r.RScale != "" {
+		parts = append(parts, "RSCALE="+r.RScale)
+	}
+	parts = append(parts, "FREQ="+r.Freq.String())
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until.IsValid() {
+		parts = append(parts, "UNTIL="+r.formatUntil())
+	}
+	if len(r.ByMonth) > 0 || len(r.ByLeapMonth) > 0 {
+		months := joinInts(r.ByMonth)
+		for _, m := range r.ByLeapMonth {
+			if months != "" {
+				months += ","
+			}
+			months += strconv.Itoa(m) + "L"
+		}
+		parts = append(parts, "BYMONTH="+months)
+	}
+	if len(r.ByWeekNo) > 0 {
+		parts = append(parts, "BYWEEKNO="+joinInts(r.ByWeekNo))
+	}
+	if len(r.ByYearDay) > 0 {
+		parts = append(parts, "BYYEARDAY="+joinInts(r.ByYearDay))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, wd := range r.ByDay {
+			days[i] = wd.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByHour) > 0 {
+		parts = append(parts, "BYHOUR="+joinInts(r.ByHour))
+	}
+	if len(r.ByMinute) > 0 {
+		parts = append(parts, "BYMINUTE="+joinInts(r.ByMinute))
+	}
+	if len(r.BySecond) > 0 {
+		parts = append(parts, "BYSECOND="+joinInts(r.BySecond))
+	}
+	if len(r.BySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(r.BySetPos))
+	}
+	// the week start of RFC 5545 is always Monday, so it is written out as soon as it may be read differently
+	if r.WeekStart != carbon.Monday || carbon.DefaultWeekStartsAt != carbon.Monday {
+		parts = append(parts, "WKST="+weekdays[r.WeekStart])
+	}
+	if r.Skip != "" {
+		parts = append(parts, "SKIP="+r.Skip)
+	}
+	return strings.Join(parts, ";")
+}
+
+// setPart sets a rule part like "BYDAY=MO,TU".
+func (r *Rule) setPart(name, value string) (err error) {
+	switch name {
+	case "FREQ":
+		for i, freq := range frequencies {
+			if strings.EqualFold(value, freq) {
+				r.Freq = Frequency(i)
+				return nil
+			}
+		}
+		return ErrInvalidPart(name, value)
+	case "INTERVAL":
+		if r.Interval, err = strconv.Atoi(value); err != nil || r.Interval < 1 {
+			return ErrInvalidPart(name, value)
+		}
+	case "COUNT":
+		if r.Count, err = strconv.Atoi(value); err != nil || r.Count < 1 {
+			return ErrInvalidPart(name, value)
+		}
+	case "UNTIL":
+		t, isDate, isUTC, e := parseValue(value, time.UTC)
+		if e != nil {
+			return ErrInvalidPart(name, value)
+		}
+		r.Until = carbon.CreateFromStdTime(t)
+		r.isDateUntil, r.isFloatingUntil = isDate, !isUTC
+	case "BYSECOND":
+		r.BySecond, err = parseInts(name, value)
+	case "BYMINUTE":
+		r.ByMinute, err = parseInts(name, value)
+	case "BYHOUR":
+		r.ByHour, err = parseInts(name, value)
+	case "BYMONTHDAY":
+		r.ByMonthDay, err = parseInts(name, value)
+	case "BYYEARDAY":
+		r.ByYearDay, err = parseInts(name, value)
+	case "BYWEEKNO":
+		r.ByWeekNo, err = parseInts(name, value)
+	case "BYSETPOS":
+		r.BySetPos, err = parseInts(name, value)
+	case "BYMONTH":
+		r.ByMonth, r.ByLeapMonth = nil, nil
+		for _, v := range strings.Split(value, ",") {
+			v = strings.TrimSpace(v)
+			isLeap := strings.HasSuffix(strings.ToUpper(v), "L")
+			m, e := strconv.Atoi(strings.TrimRight(v, "Ll"))
+			if e != nil {
+				return ErrInvalidPart(name, value)
+			}
+			if isLeap {
+				r.ByLeapMonth = append(r.ByLeapMonth, m)
+			} else {
+				r.ByMonth = append(r.ByMonth, m)
+			}
+		}
+	case "BYDAY":
+		r.ByDay = nil
+		for _, v := range strings.Split(value, ",") {
+			wd, ok := parseWeekday(strings.TrimSpace(v))
+			if !ok {
+				return ErrInvalidPart(name, value)
+			}
+			r.ByDay = append(r.ByDay, wd)
+		}
+	case "WKST":
+		wd, ok := parseWeekday(value)
+		if !ok || wd.N != 0 {
+			return ErrInvalidPart(name, value)
+		}
+		r.WeekStart = wd.Weekday
+	case "RSCALE":
+		r.RScale = strings.ToUpper(value)
+	case "SKIP":
+		r.Skip = strings.ToUpper(value)
+	default:
+		return ErrUnknownPart(name)
+	}
+	return err
+}
+
+// validate checks the value ranges and combinations of the rule parts.
+func (r *Rule) validate() error {
+	if r.Freq < Yearly || r.Freq > Secondly {
+		return ErrInvalidPart("FREQ", strconv.Itoa(int(r.Freq)))
+	}
+	if r.Interval < 0 {
+		return ErrInvalidPart("INTERVAL", strconv.Itoa(r.Interval))
+	}
+	if r.Count < 0 {
+		return ErrInvalidPart("COUNT", strconv.Itoa(r.Count))
+	}
+	if r.Count > 0 && r.Until != nil {
+		return ErrConflictingParts("COUNT", "UNTIL")
+	}
+	if r.Until != nil && r.Until.IsInvalid() {
+		return ErrInvalidPart("UNTIL", r.Until.String())
+	}
+	ranges := []struct {
+		name       string
+		values     []int
+		min, max   int
+		isNegative bool
+	}{
+		{"BYSECOND", r.BySecond, 0, 59, false},
+		{"BYMINUTE", r.ByMinute, 0, 59, false},
+		{"BYHOUR", r.ByHour, 0, 23, false},
+		{"BYMONTHDAY", r.ByMonthDay, 1, 31, true},
+		{"BYYEARDAY", r.ByYearDay, 1, 366, true},
+		{"BYWEEKNO", r.ByWeekNo, 1, 53, true},
+		{"BYMONTH", r.ByMonth, 1, 12, false},
+		{"BYMONTH", r.ByLeapMonth, 1, 12, false},
+		{"BYSETPOS", r.BySetPos, 1, 366, true},
+	}
+	for _, rg := range ranges {
+		for _, v := range rg.values {
+			if rg.isNegative && v < 0 {
+				v = -v
+			}
+			if v < rg.min || v > rg.max {
+				return ErrInvalidPart(rg.name, joinInts(rg.values))
+			}
+		}
+	}
+	for _, wd := range r.ByDay {
+		if wd.String() == "" || wd.N > 53 || wd.N < -53 {
+			return ErrInvalidPart("BYDAY", wd.String())
+		}
+		if wd.N != 0 && r.Freq != Monthly && r.Freq != Yearly {
+			return ErrConflictingParts("BYDAY="+wd.String(), "FREQ="+r.Freq.String())
+		}
+		if wd.N != 0 && len(r.ByWeekNo) > 0 {
+			return ErrConflictingParts("BYDAY="+wd.String(), "BYWEEKNO")
+		}
+	}
+	if r.WeekStart < carbon.Sunday || r.WeekStart > carbon.Saturday {
+		return ErrInvalidPart("WKST", strconv.Itoa(int(r.WeekStart)))
+	}
+	if len(r.ByWeekNo) > 0 && r.Freq != Yearly {
+		return ErrConflictingParts("BYWEEKNO", "FREQ="+r.Freq.String())
+	}
+	if len(r.ByYearDay) > 0 && (r.Freq == Monthly || r.Freq == Weekly || r.Freq == Daily) {
+		return ErrConflictingParts("BYYEARDAY", "FREQ="+r.Freq.String())
+	}
+	if len(r.ByMonthDay) > 0 && r.Freq == Weekly {
+		return ErrConflictingParts("BYMONTHDAY", "FREQ="+r.Freq.String())
+	}
+	switch r.RScale {
+	case "":
+		if r.Skip != "" {
+			return ErrConflictingParts("SKIP", "an empty RSCALE")
+		}
+		if len(r.ByLeapMonth) > 0 {
+			return ErrConflictingParts("BYMONTH="+joinInts(r.ByLeapMonth)+"L", "an empty RSCALE")
+		}
+	case Gregorian:
+		if len(r.ByLeapMonth) > 0 {
+			return ErrConflictingParts("BYMONTH="+joinInts(r.ByLeapMonth)+"L", "RSCALE="+r.RScale)
+		}
+	case Chinese:
+		if len(r.ByWeekNo) > 0 {
+			return ErrConflictingParts("BYWEEKNO", "RSCALE="+r.RScale)
+		}
+	default:
+		return ErrInvalidPart("RSCALE", r.RScale)
+	}
+	switch r.Skip {
+	case "", Omit, Backward, Forward:
+	default:
+		return ErrInvalidPart("SKIP", r.Skip)
+	}
+	return nil
+}
+
+// formatUntil formats UNTIL as a DATE or DATE-TIME value like "19971224T000000Z".
+func (r *Rule) formatUntil() string {
+	t := r.Until.StdTime()
+	if r.isDateUntil {
+		return t.Format(dateLayout)
+	}
+	if r.isFloatingUntil {
+		return t.Format(dateTimeLayout)
+	}
+	return t.UTC().Format(utcDateTimeLayout)
+}
+
+// parseWeekday parses a weekday value like "MO" or "-1FR".
+func parseWeekday(value string) (wd Weekday, ok bool) {
+	if len(value) < 2 {
+		return
+	}
+	name := strings.ToUpper(value[len(value)-2:])
+	for i, day := range weekdays {
+		if day == name {
+			wd.Weekday, ok = carbon.Weekday(i), true
+		}
+	}
+	if !ok || len(value) == 2 {
+		return
+	}
+	n, err := strconv.Atoi(value[:len(value)-2])
+	if err != nil || n == 0 {
+		return wd, false
+	}
+	wd.N = n
+	return
+}
+
+// parseInts parses a comma separated list like "1,-1".
+func parseInts(name, value string) ([]int, error) {
+	values := make([]int, 0, strings.Count(value, ",")+1)
+	for _, v := range strings.Split(value, ",") {
+		i, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil, ErrInvalidPart(name, value)
+		}
+		values = append(values, i)
+	}
+	return values, nil
+}
+
+// joinInts joins integers with commas like "1,-1".
+func joinInts(values []int) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = strconv.Itoa(v)
+	}
+	return strings.Join(s, ",")
+}
+
+// copyInts returns a copy of the integers.
+func copyInts(values []int) []int {
+	if values == nil {
+		return nil
+	}
+	return append([]int(nil), values...)
+}
@@ -0,0 +1,49 @@
+package rrule
+
+import (
+	"testing"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func BenchmarkParse(b *testing.B) {
+	rules := []string{
+		"FREQ=DAILY;COUNT=10",
+		"FREQ=WEEKLY;INTERVAL=2;UNTIL=19971224T000000Z;BYDAY=MO,WE,FR;WKST=SU",
+		"FREQ=MONTHLY;INTERVAL=2;COUNT=10;BYDAY=1SU,-1SU",
+		"FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO",
+		"RSCALE=CHINESE;FREQ=YEARLY;BYMONTH=1,5L;BYMONTHDAY=1;SKIP=FORWARD",
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Parse(rules[i%len(rules)])
+	}
+}
+
+func BenchmarkParseSet(b *testing.B) {
+	set := "DTSTART;TZID=America/New_York:19970902T090000\n" +
+		"RRULE:FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13\n" +
+		"EXDATE;TZID=America/New_York:19970902T090000"
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ParseSet(set)
+	}
+}
+
+func BenchmarkIterator(b *testing.B) {
+	dtstart := carbon.Parse("1997-09-02 09:00:00", "America/New_York")
+	rules := []*Rule{
+		Parse("FREQ=DAILY"),
+		Parse("FREQ=WEEKLY;BYDAY=MO,WE,FR"),
+		Parse("FREQ=MONTHLY;BYDAY=-1FR"),
+		Parse("FREQ=YEARLY;BYMONTH=1;BYDAY=SU,MO,TU,WE,TH,FR,SA"),
+		Parse("RSCALE=CHINESE;FREQ=MONTHLY;BYMONTHDAY=1,15"),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rules[i%len(rules)].Iterator(dtstart).Take(10)
+	}
+}
@@ -0,0 +1,408 @@
+package rrule
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dromara/carbon/v2"
+)
+
+// TestRuleWithRFCData validates the expansion using the examples of RFC 5545 section 3.8.5.3
+func TestRuleWithRFCData(t *testing.T) {
+	data, err := os.ReadFile("rrule_test_data.json")
+	if err != nil {
+		t.Skipf("Unable to read test data file: %v", err)
+	}
+
+	var testCases []struct {
+		Description string   `json:"description"`
+		Rule        string   `json:"rule"`
+		Limit       int      `json:"limit"`
+		Occurrences []string `json:"occurrences"`
+	}
+	if err = json.Unmarshal(data, &testCases); err != nil {
+		t.Fatalf("Failed to parse test data: %v", err)
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			s := ParseSet(tc.Rule)
+			assert.Nil(t, s.Error)
+
+			it := s.Iterator()
+			var occurrences []*carbon.Carbon
+			if tc.Limit > 0 {
+				occurrences = it.Take(tc.Limit)
+			} else {
+				occurrences = it.Take(1000)
+			}
+			actual := make([]string, len(occurrences))
+			for i, c := range occurrences {
+				actual[i] = c.ToString()
+			}
+			assert.Equal(t, tc.Occurrences, actual)
+		})
+	}
+}
+
+func TestFrequency_String(t *testing.T) {
+	assert.Equal(t, "YEARLY", Yearly.String())
+	assert.Equal(t, "SECONDLY", Secondly.String())
+	assert.Empty(t, Frequency(-1).String())
+	assert.Empty(t, Frequency(7).String())
+}
+
+func TestWeekday_String(t *testing.T) {
+	assert.Equal(t, "MO", MO.String())
+	assert.Equal(t, "2TU", TU.Nth(2).String())
+	assert.Equal(t, "-1FR", FR.Nth(-1).String())
+	assert.Equal(t, "SU", SU.String())
+	assert.Empty(t, Weekday{Weekday: 7}.String())
+}
+
+func TestNewRule(t *testing.T) {
+	t.Run("default week start", func(t *testing.T) {
+		r := NewRule(Weekly)
+		assert.Equal(t, Weekly, r.Freq)
+		assert.Equal(t, 1, r.Interval)
+		assert.Equal(t, carbon.Monday, r.WeekStart)
+		assert.Equal(t, "FREQ=WEEKLY", r.String())
+	})
+
+	t.Run("customized week start", func(t *testing.T) {
+		carbon.SetDefault(carbon.Default{WeekStartsAt: carbon.Sunday})
+		defer carbon.ResetDefault()
+
+		r := NewRule(Weekly)
+		assert.Equal(t, carbon.Sunday, r.WeekStart)
+		assert.Equal(t, "FREQ=WEEKLY;WKST=SU", r.String())
+		assert.Equal(t, carbon.Sunday, Parse("FREQ=WEEKLY").WeekStart)
+		assert.Equal(t, carbon.Monday, Parse("FREQ=WEEKLY;WKST=MO").WeekStart)
+		assert.Equal(t, "FREQ=WEEKLY;WKST=MO", Parse("FREQ=WEEKLY;WKST=MO").String())
+
+		// the same as the RFC 5545 example with WKST=SU
+		r = Parse("FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU")
+		occurrences := r.Iterator(carbon.Parse("1997-08-05 09:00:00", "America/New_York")).Take(10)
+		assert.Len(t, occurrences, 4)
+		assert.Equal(t, "1997-08-17 09:00:00", occurrences[1].ToDateTimeString())
+		assert.Equal(t, "1997-08-31 09:00:00", occurrences[3].ToDateTimeString())
+	})
+}
+
+func TestParse(t *testing.T) {
+	t.Run("valid rule", func(t *testing.T) {
+		rules := []string{
+			"FREQ=DAILY;COUNT=10",
+			"FREQ=DAILY;UNTIL=19971224T000000Z",
+			"FREQ=DAILY;UNTIL=19971224T000000",
+			"FREQ=DAILY;UNTIL=19971224",
+			"FREQ=WEEKLY;INTERVAL=2;UNTIL=19971224T000000Z;BYDAY=MO,WE,FR;WKST=SU",
+			"FREQ=MONTHLY;INTERVAL=2;COUNT=10;BYDAY=1SU,-1SU",
+			"FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO",
+			"FREQ=YEARLY;INTERVAL=3;COUNT=10;BYYEARDAY=1,100,200",
+			"FREQ=MONTHLY;BYMONTHDAY=7,8,9,10,11,12,13;BYDAY=SA",
+			"FREQ=MONTHLY;COUNT=3;BYDAY=TU,WE,TH;BYSETPOS=3",
+			"FREQ=DAILY;BYHOUR=9,10;BYMINUTE=0,20,40;BYSECOND=0,30",
+			"RSCALE=CHINESE;FREQ=YEARLY;BYMONTH=1,5L;BYMONTHDAY=1;SKIP=FORWARD",
+			"RSCALE=GREGORIAN;FREQ=MONTHLY;SKIP=BACKWARD",
+		}
+		for _, rule := range rules {
+			r := Parse(rule)
+			assert.Nil(t, r.Error, rule)
+			assert.Equal(t, rule, r.String())
+		}
+	})
+
+	t.Run("case and order insensitive", func(t *testing.T) {
+		r := Parse("RRULE:byday=-1fr;freq=monthly;interval=2")
+		assert.Nil(t, r.Error)
+		assert.Equal(t, Monthly, r.Freq)
+		assert.Equal(t, 2, r.Interval)
+		assert.Equal(t, []Weekday{FR.Nth(-1)}, r.ByDay)
+		assert.Equal(t, "FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR", r.String())
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		rules := []string{
+			"",
+			"RRULE:",
+			"FREQ",
+			"COUNT=10",
+			"FREQ=FORTNIGHTLY",
+			"FREQ=DAILY;INTERVAL=0",
+			"FREQ=DAILY;COUNT=x",
+			"FREQ=DAILY;UNTIL=1997",
+			"FREQ=DAILY;BYHOUR=x",
+			"FREQ=WEEKLY;BYDAY=XX",
+			"FREQ=MONTHLY;BYDAY=0MO",
+			"FREQ=WEEKLY;WKST=1MO",
+			"FREQ=DAILY;FOO=BAR",
+			"FREQ=DAILY;BYMONTH=xL",
+			"FREQ=DAILY;COUNT=10;UNTIL=19971224T000000Z",
+			"FREQ=DAILY;BYSECOND=60",
+			"FREQ=DAILY;BYMINUTE=-1",
+			"FREQ=DAILY;BYHOUR=24",
+			"FREQ=MONTHLY;BYMONTHDAY=0",
+			"FREQ=MONTHLY;BYMONTHDAY=-32",
+			"FREQ=YEARLY;BYYEARDAY=367",
+			"FREQ=YEARLY;BYWEEKNO=54",
+			"FREQ=YEARLY;BYMONTH=13",
+			"FREQ=MONTHLY;BYSETPOS=0",
+			"FREQ=YEARLY;BYDAY=54MO",
+			"FREQ=WEEKLY;BYDAY=1MO",
+			"FREQ=YEARLY;BYWEEKNO=1;BYDAY=1MO",
+			"FREQ=MONTHLY;BYWEEKNO=1",
+			"FREQ=MONTHLY;BYYEARDAY=1",
+			"FREQ=WEEKLY;BYMONTHDAY=1",
+			"FREQ=MONTHLY;SKIP=FORWARD",
+			"FREQ=YEARLY;BYMONTH=5L",
+			"RSCALE=GREGORIAN;FREQ=YEARLY;BYMONTH=5L",
+			"RSCALE=CHINESE;FREQ=YEARLY;BYWEEKNO=1",
+			"RSCALE=HEBREW;FREQ=YEARLY",
+			"RSCALE=GREGORIAN;FREQ=MONTHLY;SKIP=SIDEWAYS",
+		}
+		for _, rule := range rules {
+			assert.Error(t, Parse(rule).Error, rule)
+			assert.Empty(t, Parse(rule).String(), rule)
+		}
+	})
+}
+
+func TestRule_Copy(t *testing.T) {
+	assert.Nil(t, (*Rule)(nil).Copy())
+
+	r := Parse("FREQ=MONTHLY;UNTIL=19971224T000000Z;BYDAY=1FR;BYMONTHDAY=1,2")
+	c := r.Copy()
+	c.ByDay[0] = MO
+	c.ByMonthDay[0] = 3
+	c.Until = c.Until.AddDay()
+	assert.Equal(t, "FREQ=MONTHLY;UNTIL=19971224T000000Z;BYMONTHDAY=1,2;BYDAY=1FR", r.String())
+	assert.Equal(t, "FREQ=MONTHLY;UNTIL=19971225T000000Z;BYMONTHDAY=3,2;BYDAY=MO", c.String())
+}
+
+func TestRule_String(t *testing.T) {
+	assert.Empty(t, (*Rule)(nil).String())
+
+	r := NewRule(Daily)
+	r.Interval = 2
+	r.Until = carbon.Parse("1997-12-24 09:00:00", "America/New_York")
+	r.ByHour = []int{9, 12}
+	assert.Equal(t, "FREQ=DAILY;INTERVAL=2;UNTIL=19971224T140000Z;BYHOUR=9,12", r.String())
+}
+
+func TestRule_Iterator(t *testing.T) {
+	dtstart := carbon.Parse("1997-09-02 09:00:00", "America/New_York")
+
+	t.Run("invalid rule", func(t *testing.T) {
+		var r *Rule
+		assert.Error(t, r.Iterator(dtstart).Error)
+		assert.Error(t, Parse("xxx").Iterator(dtstart).Error)
+
+		r = NewRule(Daily)
+		r.ByHour = []int{25}
+		it := r.Iterator(dtstart)
+		assert.Error(t, it.Error)
+		c, ok := it.Next()
+		assert.Nil(t, c)
+		assert.False(t, ok)
+	})
+
+	t.Run("invalid dtstart", func(t *testing.T) {
+		r := NewRule(Daily)
+		assert.Error(t, r.Iterator(nil).Error)
+		assert.Error(t, r.Iterator(carbon.Parse("")).Error)
+		assert.Error(t, r.Iterator(carbon.Parse("xxx")).Error)
+		assert.Error(t, r.Iterator(carbon.NewCarbon()).Error)
+	})
+
+	t.Run("customized rule", func(t *testing.T) {
+		r := NewRule(Monthly)
+		r.Interval = 0
+		r.Count = 3
+		r.ByDay = []Weekday{TU.Nth(2)}
+		occurrences := r.Iterator(dtstart.SetLocale("zh-CN")).Take(10)
+		assert.Len(t, occurrences, 3)
+		assert.Equal(t, "1997-09-09 09:00:00 -0400 EDT", occurrences[0].ToString())
+		assert.Equal(t, "1997-10-14 09:00:00 -0400 EDT", occurrences[1].ToString())
+		assert.Equal(t, "1997-11-11 09:00:00 -0500 EST", occurrences[2].ToString())
+		assert.Equal(t, "zh-CN", occurrences[0].Locale())
+	})
+
+	t.Run("floating until", func(t *testing.T) {
+		occurrences := Parse("FREQ=DAILY;UNTIL=19970905T090000").Iterator(dtstart).Take(10)
+		assert.Len(t, occurrences, 4)
+		assert.Equal(t, "1997-09-05 09:00:00 -0400 EDT", occurrences[3].ToString())
+
+		occurrences = Parse("FREQ=DAILY;UNTIL=19970905").Iterator(dtstart).Take(10)
+		assert.Len(t, occurrences, 3)
+	})
+
+	t.Run("dtstart not synchronized", func(t *testing.T) {
+		occurrences := Parse("FREQ=MONTHLY;COUNT=2;BYMONTHDAY=13").Iterator(dtstart).Take(10)
+		assert.Equal(t, "1997-09-13 09:00:00", occurrences[0].ToDateTimeString())
+		assert.Equal(t, "1997-10-13 09:00:00", occurrences[1].ToDateTimeString())
+	})
+
+	t.Run("impossible rule", func(t *testing.T) {
+		it := Parse("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30").Iterator(dtstart)
+		c, ok := it.Next()
+		assert.Nil(t, c)
+		assert.False(t, ok)
+	})
+
+	t.Run("nanosecond", func(t *testing.T) {
+		occurrences := Parse("FREQ=SECONDLY;INTERVAL=30;COUNT=3").Iterator(carbon.Parse("2020-08-05 13:14:15.999999999")).Take(10)
+		assert.Equal(t, "2020-08-05 13:14:45.999999999", occurrences[1].ToDateTimeNanoString())
+		assert.Equal(t, "2020-08-05 13:15:15.999999999", occurrences[2].ToDateTimeNanoString())
+	})
+}
+
+func TestRule_DST(t *testing.T) {
+	t.Run("nonexistent time", func(t *testing.T) {
+		occurrences := Parse("FREQ=DAILY;COUNT=3").Iterator(carbon.Parse("2024-03-09 02:30:00", "America/New_York")).Take(10)
+		assert.Equal(t, "2024-03-09 02:30:00 -0500 EST", occurrences[0].ToString())
+		assert.Equal(t, "2024-03-10 03:30:00 -0400 EDT", occurrences[1].ToString())
+		assert.Equal(t, "2024-03-11 02:30:00 -0400 EDT", occurrences[2].ToString())
+	})
+
+	t.Run("ambiguous time", func(t *testing.T) {
+		occurrences := Parse("FREQ=DAILY;COUNT=3").Iterator(carbon.Parse("2024-11-02 01:30:00", "America/New_York")).Take(10)
+		assert.Equal(t, "2024-11-02 01:30:00 -0400 EDT", occurrences[0].ToString())
+		assert.Equal(t, "2024-11-03 01:30:00 -0400 EDT", occurrences[1].ToString())
+		assert.Equal(t, "2024-11-04 01:30:00 -0500 EST", occurrences[2].ToString())
+	})
+
+	t.Run("hourly", func(t *testing.T) {
+		occurrences := Parse("FREQ=HOURLY;COUNT=4").Iterator(carbon.Parse("2024-03-10 00:00:00", "America/New_York")).Take(10)
+		assert.Equal(t, "2024-03-10 01:00:00 -0500 EST", occurrences[1].ToString())
+		assert.Equal(t, "2024-03-10 03:00:00 -0400 EDT", occurrences[2].ToString())
+		assert.Equal(t, "2024-03-10 04:00:00 -0400 EDT", occurrences[3].ToString())
+	})
+
+	t.Run("china daylight saving time", func(t *testing.T) {
+		occurrences := Parse("FREQ=DAILY;COUNT=3").Iterator(carbon.Parse("1986-05-03 02:00:00", "Asia/Shanghai")).Take(10)
+		assert.Equal(t, "1986-05-03 02:00:00 +0800 CST", occurrences[0].ToString())
+		assert.Equal(t, "1986-05-04 03:00:00 +0900 CDT", occurrences[1].ToString())
+		assert.Equal(t, "1986-05-05 02:00:00 +0900 CDT", occurrences[2].ToString())
+	})
+}
+
+func TestRule_RScale(t *testing.T) {
+	t.Run("chinese new year", func(t *testing.T) {
+		occurrences := Parse("RSCALE=CHINESE;FREQ=YEARLY;COUNT=4").Iterator(carbon.Parse("2013-02-10")).Take(10)
+		assert.Equal(t, "2013-02-10", occurrences[0].ToDateString())
+		assert.Equal(t, "2014-01-31", occurrences[1].ToDateString())
+		assert.Equal(t, "2015-02-19", occurrences[2].ToDateString())
+		assert.Equal(t, "2016-02-08", occurrences[3].ToDateString())
+	})
+
+	t.Run("first and fifteenth lunar days", func(t *testing.T) {
+		occurrences := Parse("RSCALE=CHINESE;FREQ=MONTHLY;BYMONTHDAY=1,15").Iterator(carbon.Parse("2025-01-29 08:00:00", "Asia/Shanghai")).Take(6)
+		expected := []string{"2025-01-29", "2025-02-12", "2025-02-28", "2025-03-14", "2025-03-29", "2025-04-12"}
+		for i, c := range occurrences {
+			assert.Equal(t, expected[i], c.ToDateString())
+			assert.Equal(t, "08:00:00", c.ToTimeString())
+		}
+	})
+
+	t.Run("lunar leap month", func(t *testing.T) {
+		// the sixth month of 2025 has a leap month
+		occurrences := Parse("RSCALE=CHINESE;FREQ=MONTHLY;COUNT=3;BYMONTHDAY=1").Iterator(carbon.Parse("2025-06-25")).Take(10)
+		assert.Equal(t, "2025-06-25", occurrences[0].ToDateString())
+		assert.Equal(t, "2025-07-25", occurrences[1].ToDateString())
+		assert.True(t, occurrences[1].Lunar().IsLeapMonth())
+		assert.Equal(t, "2025-08-23", occurrences[2].ToDateString())
+
+		occurrences = Parse("RSCALE=CHINESE;FREQ=YEARLY;COUNT=3;BYMONTH=2L").Iterator(carbon.Parse("2023-03-22")).Take(10)
+		assert.Equal(t, "2023-03-22", occurrences[0].ToDateString())
+		assert.Equal(t, "2042-03-22", occurrences[1].ToDateString())
+
+		occurrences = Parse("RSCALE=CHINESE;FREQ=YEARLY;COUNT=3;BYMONTH=2L;SKIP=BACKWARD").Iterator(carbon.Parse("2023-03-22")).Take(10)
+		assert.Equal(t, "2024-03-10", occurrences[1].ToDateString())
+		assert.Equal(t, "2025-02-28", occurrences[2].ToDateString())
+
+		occurrences = Parse("RSCALE=CHINESE;FREQ=YEARLY;COUNT=3;BYMONTH=2L;SKIP=FORWARD").Iterator(carbon.Parse("2023-03-22")).Take(10)
+		assert.Equal(t, "2024-04-09", occurrences[1].ToDateString())
+		assert.Equal(t, "2025-03-29", occurrences[2].ToDateString())
+	})
+
+	t.Run("lunar weekday", func(t *testing.T) {
+		occurrences := Parse("RSCALE=CHINESE;FREQ=MONTHLY;COUNT=2;BYDAY=-1SU").Iterator(carbon.Parse("2025-01-29")).Take(10)
+		assert.Equal(t, "2025-02-23", occurrences[0].ToDateString())
+		assert.Equal(t, "2025-03-23", occurrences[1].ToDateString())
+	})
+
+	t.Run("out of lunar range", func(t *testing.T) {
+		occurrences := Parse("RSCALE=CHINESE;FREQ=YEARLY").Iterator(carbon.Parse("9997-03-01")).Take(10)
+		assert.Len(t, occurrences, 2)
+		assert.Equal(t, "9998-02-19", occurrences[1].ToDateString())
+	})
+
+	t.Run("out of lunar table", func(t *testing.T) {
+		occurrences := Parse("RSCALE=CHINESE;FREQ=YEARLY").Iterator(carbon.Parse("2098-02-01")).Take(5)
+		assert.Len(t, occurrences, 5)
+		assert.Equal(t, "2099-01-21", occurrences[1].ToDateString())
+		assert.Len(t, Parse("RSCALE=CHINESE;FREQ=DAILY").Iterator(carbon.Parse("1800-01-01")).Take(10), 10)
+	})
+
+	t.Run("skip forward", func(t *testing.T) {
+		occurrences := Parse("RSCALE=GREGORIAN;FREQ=MONTHLY;COUNT=4;SKIP=FORWARD").Iterator(carbon.Parse("2015-01-31")).Take(10)
+		assert.Equal(t, "2015-03-01", occurrences[1].ToDateString())
+		assert.Equal(t, "2015-03-31", occurrences[2].ToDateString())
+		assert.Equal(t, "2015-05-01", occurrences[3].ToDateString())
+
+		occurrences = Parse("RSCALE=GREGORIAN;FREQ=YEARLY;COUNT=3;SKIP=FORWARD").Iterator(carbon.Parse("2012-02-29")).Take(10)
+		assert.Equal(t, "2013-03-01", occurrences[1].ToDateString())
+		assert.Equal(t, "2014-03-01", occurrences[2].ToDateString())
+
+		occurrences = Parse("RSCALE=GREGORIAN;FREQ=DAILY;COUNT=2;BYMONTHDAY=31;SKIP=FORWARD").Iterator(carbon.Parse("2015-02-01")).Take(10)
+		assert.Equal(t, "2015-03-01", occurrences[0].ToDateString())
+		assert.Equal(t, "2015-03-31", occurrences[1].ToDateString())
+	})
+
+	t.Run("skip backward", func(t *testing.T) {
+		occurrences := Parse("RSCALE=GREGORIAN;FREQ=MONTHLY;COUNT=4;SKIP=BACKWARD").Iterator(carbon.Parse("2015-01-31")).Take(10)
+		assert.Equal(t, "2015-02-28", occurrences[1].ToDateString())
+		assert.Equal(t, "2015-03-31", occurrences[2].ToDateString())
+		assert.Equal(t, "2015-04-30", occurrences[3].ToDateString())
+
+		occurrences = Parse("RSCALE=GREGORIAN;FREQ=DAILY;COUNT=2;BYMONTH=2;BYMONTHDAY=29;SKIP=BACKWARD").Iterator(carbon.Parse("2013-01-01")).Take(10)
+		assert.Equal(t, "2013-02-28", occurrences[0].ToDateString())
+		assert.Equal(t, "2014-02-28", occurrences[1].ToDateString())
+	})
+}
+
+func TestIterator(t *testing.T) {
+	t.Run("nil iterator", func(t *testing.T) {
+		var it *Iterator
+		c, ok := it.Next()
+		assert.Nil(t, c)
+		assert.False(t, ok)
+		assert.Empty(t, it.Take(1))
+	})
+
+	t.Run("exhausted iterator", func(t *testing.T) {
+		it := Parse("FREQ=DAILY;COUNT=2").Iterator(carbon.Parse("2020-08-05"))
+		assert.Len(t, it.Take(3), 2)
+		c, ok := it.Next()
+		assert.Nil(t, c)
+		assert.False(t, ok)
+	})
+
+	t.Run("between", func(t *testing.T) {
+		it := Parse("FREQ=WEEKLY;BYDAY=MO,FR").Iterator(carbon.Parse("2020-08-05"))
+		occurrences := it.Between(carbon.Parse("2020-08-10"), carbon.Parse("2020-08-21"))
+		assert.Len(t, occurrences, 4)
+		assert.Equal(t, "2020-08-10", occurrences[0].ToDateString())
+		assert.Equal(t, "2020-08-21", occurrences[3].ToDateString())
+
+		c, ok := it.Next()
+		assert.True(t, ok)
+		assert.Equal(t, "2020-08-28", c.ToDateString())
+
+		assert.Empty(t, it.Between(carbon.Parse("xxx"), carbon.Parse("2020-08-21")))
+	})
+}
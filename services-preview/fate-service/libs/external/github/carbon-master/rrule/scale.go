@@ -0,0 +1,104 @@
+package rrule
+
+import (
+	"time"
+
+	"github.com/dromara/carbon/v2/calendar/lunar"
+)
+
+// month defines a month struct of a calendar scale.
+type month struct {
+	year, month int
+	isLeap      bool
+	start       time.Time // the first day of the month as a wall clock date
+	days        int
+}
+
+// scale defines a scale interface for the calendar system in which a rule is expanded, see RSCALE of RFC 7529.
+type scale interface {
+	// months returns the months of the year in order, or nil if the year is out of range
+	months(year int) []month
+	// yearOf returns the year containing the wall clock date
+	yearOf(date time.Time) int
+}
+
+// newScale returns the scale of the rscale.
+func newScale(rscale string) scale {
+	if rscale == Chinese {
+		return &chineseScale{cache: make(map[int][]month)}
+	}
+	return gregorianScale{}
+}
+
+// gregorianScale defines a gregorianScale struct.
+type gregorianScale struct{}
+
+func (gregorianScale) months(year int) []month {
+	if year < 1 || year > 9999 {
+		return nil
+	}
+	months := make([]month, 12)
+	for i := range months {
+		start := time.Date(year, time.Month(i+1), 1, 0, 0, 0, 0, time.UTC)
+		months[i] = month{year: year, month: i + 1, start: start, days: start.AddDate(0, 1, -1).Day()}
+	}
+	return months
+}
+
+func (gregorianScale) yearOf(date time.Time) int {
+	return date.Year()
+}
+
+// chineseScale defines a chineseScale struct, the months of a year are cached as they are expensive to compute.
+type chineseScale struct {
+	cache map[int][]month
+}
+
+func (s *chineseScale) months(year int) []month {
+	if months, ok := s.cache[year]; ok {
+		return months
+	}
+	var months []month
+	start, end := lunarDate(year, 1, false), lunarDate(year+1, 1, false)
+	if !start.IsZero() && !end.IsZero() {
+		leapMonth := lunar.NewLunar(year, 1, 1, false).LeapMonth()
+		for m := 1; m <= 12; m++ {
+			months = append(months, month{year: year, month: m, start: lunarDate(year, m, false)})
+			if m == leapMonth {
+				months = append(months, month{year: year, month: m, isLeap: true, start: lunarDate(year, m, true)})
+			}
+		}
+		for i := range months {
+			next := end
+			if i+1 < len(months) {
+				next = months[i+1].start
+			}
+			months[i].days = int(next.Sub(months[i].start).Hours() / 24)
+		}
+	}
+	s.cache[year] = months
+	return months
+}
+
+func (s *chineseScale) yearOf(date time.Time) int {
+	year := date.Year()
+	if months := s.months(year); len(months) > 0 && date.Before(months[0].start) {
+		return year - 1
+	}
+	return year
+}
+
+// lunarDate returns the first day of the lunar month as a wall clock date, or zero time if out of range.
+func lunarDate(year, month int, isLeapMonth bool) time.Time {
+	l := lunar.NewLunar(year, month, 1, isLeapMonth)
+	if l.Error != nil {
+		return time.Time{}
+	}
+	t := l.ToGregorian().Time
+	if t.IsZero() {
+		return t
+	}
+	// the lunar calendar is reckoned in China Standard Time
+	y, m, d := t.Add(8 * time.Hour).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,374 @@
+package rrule
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dromara/carbon/v2"
+)
+
+// value layout constants
+const (
+	dateLayout        = "20060102"
+	dateTimeLayout    = "20060102T150405"
+	utcDateTimeLayout = "20060102T150405Z"
+)
+
+// Set defines a Set struct for the recurrence set of the DTSTART, RRULE, RDATE and EXDATE properties.
+type Set struct {
+	DTStart *carbon.Carbon
+	RRules  []*Rule
+	RDates  []*carbon.Carbon
+	ExDates []*carbon.Carbon
+	Error   error
+
+	// isDate reports whether the properties are DATE values like "19970902"
+	isDate bool
+}
+
+// NewSet returns a new Set instance starting at dtstart.
+func NewSet(dtstart *carbon.Carbon) *Set {
+	return &Set{DTStart: dtstart, Error: checkDTStart(dtstart)}
+}
+
+// ParseSet parses the lines of the DTSTART, RRULE, RDATE and EXDATE properties as a Set instance like
+//
+//	DTSTART;TZID=America/New_York:19970902T090000
+//	RRULE:FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13
+//	EXDATE;TZID=America/New_York:19970902T090000
+func ParseSet(value string) *Set {
+	s := new(Set)
+	type property struct {
+		name, value string
+		params      map[string]string
+	}
+	var properties []property
+	for _, line := range unfold(value) {
+		name, params, v, ok := parseProperty(line)
+		if !ok {
+			s.Error = ErrFailedParse(line)
+			return s
+		}
+		properties = append(properties, property{name: name, value: v, params: params})
+	}
+
+	// DTSTART is parsed first as the other properties follow its location
+	for _, p := range properties {
+		if p.name != "DTSTART" {
+			continue
+		}
+		s.isDate = p.params["VALUE"] == "DATE"
+		if s.DTStart, s.Error = parseCarbon(p.value, p.params, nil); s.Error != nil {
+			return s
+		}
+	}
+	for _, p := range properties {
+		switch p.name {
+		case "DTSTART":
+		case "RRULE":
+			r := Parse(p.value)
+			if r.Error != nil {
+				s.Error = r.Error
+				return s
+			}
+			s.RRules = append(s.RRules, r)
+		case "RDATE", "EXDATE":
+			if p.params["VALUE"] == "PERIOD" {
+				s.Error = ErrUnsupportedProperty(p.name + ";VALUE=PERIOD")
+				return s
+			}
+			for _, v := range strings.Split(p.value, ",") {
+				c, err := parseCarbon(strings.TrimSpace(v), p.params, s.DTStart)
+				if err != nil {
+					s.Error = err
+					return s
+				}
+				if p.name == "RDATE" {
+					s.RDates = append(s.RDates, c)
+				} else {
+					s.ExDates = append(s.ExDates, c)
+				}
+			}
+		default:
+			s.Error = ErrUnsupportedProperty(p.name)
+			return s
+		}
+	}
+	return s
+}
+
+// AddRRule adds a recurrence rule.
+func (s *Set) AddRRule(r *Rule) *Set {
+	if s == nil || s.Error != nil {
+		return s
+	}
+	if r == nil {
+		s.Error = ErrNilRule()
+		return s
+	}
+	if s.Error = r.Error; s.Error == nil {
+		s.RRules = append(s.RRules, r)
+	}
+	return s
+}
+
+// AddRDate adds recurrence dates.
+func (s *Set) AddRDate(dates ...*carbon.Carbon) *Set {
+	if s == nil || s.Error != nil {
+		return s
+	}
+	for _, c := range dates {
+		if s.Error = checkDTStart(c); s.Error != nil {
+			return s
+		}
+	}
+	s.RDates = append(s.RDates, dates...)
+	return s
+}
+
+// AddExDate adds exception dates.
+func (s *Set) AddExDate(dates ...*carbon.Carbon) *Set {
+	if s == nil || s.Error != nil {
+		return s
+	}
+	for _, c := range dates {
+		if s.Error = checkDTStart(c); s.Error != nil {
+			return s
+		}
+	}
+	s.ExDates = append(s.ExDates, dates...)
+	return s
+}
+
+// Iterator returns an Iterator instance which expands the occurrences of the set lazily,
+// DTSTART is always the first occurrence unless it is excluded by EXDATE.
+func (s *Set) Iterator() *Iterator {
+	it := new(Iterator)
+	if s == nil {
+		it.Error = ErrNilDTStart()
+		return it
+	}
+	if s.Error != nil {
+		it.Error = s.Error
+		return it
+	}
+	if it.Error = checkDTStart(s.DTStart); it.Error != nil {
+		return it
+	}
+	dtstart := s.DTStart.StdTime()
+
+	sources := []func() (time.Time, bool){values([]time.Time{dtstart})}
+	rdates := make([]time.Time, 0, len(s.RDates))
+	for _, c := range s.RDates {
+		if c.IsValid() {
+			rdates = append(rdates, c.StdTime())
+		}
+	}
+	sort.Slice(rdates, func(i, j int) bool {
+		return rdates[i].Before(rdates[j])
+	})
+	sources = append(sources, values(rdates))
+	for _, r := range s.RRules {
+		ri := r.Iterator(s.DTStart)
+		if ri.Error != nil {
+			it.Error = ri.Error
+			return it
+		}
+		sources = append(sources, ri.next)
+	}
+	type instant struct {
+		sec  int64
+		nsec int
+	}
+	exdates := make(map[instant]bool, len(s.ExDates))
+	for _, c := range s.ExDates {
+		if c.IsValid() {
+			exdates[instant{c.Timestamp(), c.Nanosecond()}] = true
+		}
+	}
+
+	heads := make([]time.Time, len(sources))
+	oks := make([]bool, len(sources))
+	for i, source := range sources {
+		heads[i], oks[i] = source()
+	}
+	var (
+		last    time.Time
+		hasLast bool
+	)
+	it.dtstart = s.DTStart.Copy()
+	it.next = func() (time.Time, bool) {
+		for {
+			min := -1
+			for i := range heads {
+				if oks[i] && (min < 0 || heads[i].Before(heads[min])) {
+					min = i
+				}
+			}
+			if min < 0 {
+				return time.Time{}, false
+			}
+			t := heads[min]
+			heads[min], oks[min] = sources[min]()
+			if hasLast && !t.After(last) {
+				continue
+			}
+			last, hasLast = t, true
+			if !exdates[instant{t.Unix(), t.Nanosecond()}] {
+				return t, true
+			}
+		}
+	}
+	return it
+}
+
+// String implements "Stringer" interface for Set.
+func (s *Set) String() string {
+	if s == nil || s.Error != nil {
+		return ""
+	}
+	var lines []string
+	if s.DTStart.IsValid() {
+		lines = append(lines, s.formatProperty("DTSTART", []*carbon.Carbon{s.DTStart})...)
+	}
+	for _, r := range s.RRules {
+		if rule := r.String(); rule != "" {
+			lines = append(lines, "RRULE:"+rule)
+		}
+	}
+	lines = append(lines, s.formatProperty("RDATE", s.RDates)...)
+	lines = append(lines, s.formatProperty("EXDATE", s.ExDates)...)
+	return strings.Join(lines, "\n")
+}
+
+// formatProperty formats the values as property lines, consecutive values with the same parameters share a line.
+func (s *Set) formatProperty(name string, dates []*carbon.Carbon) (lines []string) {
+	prev := ""
+	for _, c := range dates {
+		if c.IsInvalid() {
+			continue
+		}
+		params, value := s.formatValue(c)
+		if n := len(lines); n > 0 && params == prev {
+			lines[n-1] += "," + value
+			continue
+		}
+		lines = append(lines, name+params+":"+value)
+		prev = params
+	}
+	return
+}
+
+// formatValue formats a DATE or DATE-TIME value with its parameters like ";TZID=America/New_York" and "19970902T090000".
+func (s *Set) formatValue(c *carbon.Carbon) (params, value string) {
+	t := c.StdTime()
+	switch {
+	case s.isDate:
+		return ";VALUE=DATE", t.Format(dateLayout)
+	case t.Location() == time.UTC:
+		return "", t.Format(utcDateTimeLayout)
+	case t.Location() == time.Local:
+		return "", t.Format(dateTimeLayout)
+	}
+	return ";TZID=" + t.Location().String(), t.Format(dateTimeLayout)
+}
+
+// parseCarbon parses a DATE or DATE-TIME value as a Carbon instance, a local time follows the TZID parameter,
+// the location of dtstart or carbon.DefaultTimezone in order, and a DATE value follows the time of dtstart.
+func parseCarbon(value string, params map[string]string, dtstart *carbon.Carbon) (*carbon.Carbon, error) {
+	loc := time.UTC
+	var err error
+	switch {
+	case params["TZID"] != "":
+		if loc, err = time.LoadLocation(params["TZID"]); err != nil {
+			return nil, carbon.ErrInvalidTimezone(params["TZID"])
+		}
+	case dtstart.IsValid():
+		loc = dtstart.StdTime().Location()
+	default:
+		if loc, err = time.LoadLocation(carbon.DefaultTimezone); err != nil {
+			return nil, carbon.ErrInvalidTimezone(carbon.DefaultTimezone)
+		}
+	}
+	t, isDate, _, err := parseValue(value, loc)
+	if err != nil {
+		return nil, ErrFailedParse(value)
+	}
+	if isDate && dtstart.IsValid() {
+		hour, minute, second := dtstart.StdTime().Clock()
+		t = resolve(time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, dtstart.Nanosecond(), time.UTC), loc)
+	}
+	return carbon.CreateFromStdTime(t), nil
+}
+
+// parseValue parses a DATE or DATE-TIME value like "19970902", "19970902T090000" or "19970902T090000Z",
+// a local time is resolved in the location.
+func parseValue(value string, loc *time.Location) (t time.Time, isDate, isUTC bool, err error) {
+	switch {
+	case len(value) == len(dateLayout):
+		t, err = time.Parse(dateLayout, value)
+		isDate = true
+	case len(value) == len(utcDateTimeLayout) && (value[len(value)-1] == 'Z' || value[len(value)-1] == 'z'):
+		t, err = time.Parse(utcDateTimeLayout, strings.ToUpper(value))
+		return t, false, true, err
+	case len(value) == len(dateTimeLayout):
+		t, err = time.Parse(dateTimeLayout, strings.ToUpper(value))
+	default:
+		return t, false, false, ErrFailedParse(value)
+	}
+	if err != nil {
+		return
+	}
+	return resolve(t, loc), isDate, false, nil
+}
+
+// parseProperty parses a property line like "RDATE;TZID=America/New_York:19970714T083000",
+// a line without property name like "FREQ=DAILY" is a RRULE.
+func parseProperty(line string) (name string, params map[string]string, value string, ok bool) {
+	params = make(map[string]string)
+	i := strings.Index(line, ":")
+	if i < 0 {
+		return "RRULE", params, line, strings.Contains(strings.ToUpper(line), "FREQ=")
+	}
+	parts := strings.Split(line[:i], ";")
+	name, value = strings.ToUpper(strings.TrimSpace(parts[0])), strings.TrimSpace(line[i+1:])
+	for _, part := range parts[1:] {
+		k, v, found := strings.Cut(part, "=")
+		if !found {
+			return name, params, value, false
+		}
+		k = strings.ToUpper(strings.TrimSpace(k))
+		v = strings.Trim(strings.TrimSpace(v), `"`)
+		if k == "VALUE" {
+			v = strings.ToUpper(v)
+		}
+		params[k] = v
+	}
+	return name, params, value, name != ""
+}
+
+// unfold splits the text into unfolded lines, a line starting with a space or tab continues the previous line.
+func unfold(text string) (lines []string) {
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if n := len(lines); n > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[n-1] += line[1:]
+			continue
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return
+}
+
+// values returns a source of the sorted times.
+func values(times []time.Time) func() (time.Time, bool) {
+	return func() (t time.Time, ok bool) {
+		if len(times) == 0 {
+			return t, false
+		}
+		t, times = times[0], times[1:]
+		return t, true
+	}
+}
@@ -0,0 +1,145 @@
+package rrule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func TestParseSet(t *testing.T) {
+	t.Run("valid set", func(t *testing.T) {
+		sets := []string{
+			"DTSTART;TZID=America/New_York:19970902T090000\nRRULE:FREQ=DAILY;COUNT=10",
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=DAILY;COUNT=10\nRRULE:FREQ=WEEKLY;COUNT=3;BYDAY=SA\nEXDATE:19970903T090000Z,19970904T090000Z",
+			"DTSTART;VALUE=DATE:19970902\nRDATE;VALUE=DATE:19970904,19971231",
+			"DTSTART;TZID=Asia/Shanghai:20250129T080000\nRRULE:RSCALE=CHINESE;FREQ=MONTHLY;BYMONTHDAY=1,15",
+		}
+		for _, set := range sets {
+			s := ParseSet(set)
+			assert.Nil(t, s.Error, set)
+			assert.Equal(t, set, s.String())
+		}
+	})
+
+	t.Run("folded lines", func(t *testing.T) {
+		s := ParseSet("DTSTART;TZID=America/New_York:19970902T090000\r\nRRULE:FREQ=DAILY;\r\n COUNT=10\r\n\r\n")
+		assert.Nil(t, s.Error)
+		assert.Equal(t, 10, s.RRules[0].Count)
+	})
+
+	t.Run("rule without property name", func(t *testing.T) {
+		s := ParseSet("DTSTART:19970902T090000Z\nFREQ=DAILY;COUNT=10")
+		assert.Nil(t, s.Error)
+		assert.Equal(t, "DTSTART:19970902T090000Z\nRRULE:FREQ=DAILY;COUNT=10", s.String())
+	})
+
+	t.Run("location of values", func(t *testing.T) {
+		s := ParseSet("DTSTART;TZID=Asia/Tokyo:20200805T131415\nRDATE:20200806T131415\nEXDATE;TZID=America/New_York:20200805T001415\nRDATE:20200807")
+		assert.Nil(t, s.Error)
+		assert.Equal(t, "2020-08-06 13:14:15 +0900 JST", s.RDates[0].ToString())
+		assert.Equal(t, "2020-08-07 13:14:15 +0900 JST", s.RDates[1].ToString())
+		assert.Equal(t, "2020-08-05 13:14:15 +0900 JST", s.ExDates[0].ToString("Asia/Tokyo"))
+		occurrences := s.Iterator().Take(10)
+		assert.Len(t, occurrences, 2)
+		assert.Equal(t, "2020-08-06 13:14:15 +0900 JST", occurrences[0].ToString())
+
+		s = ParseSet("RDATE:20200806T131415")
+		assert.Nil(t, s.Error)
+		assert.Equal(t, "2020-08-06 13:14:15 +0000 UTC", s.RDates[0].ToString())
+		assert.Error(t, s.Iterator().Error)
+	})
+
+	t.Run("invalid set", func(t *testing.T) {
+		sets := []string{
+			"DTSTART",
+			"DTSTART;TZID:19970902T090000",
+			":19970902T090000",
+			"DTSTART:1997",
+			"DTSTART;TZID=xxx:19970902T090000",
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=XXX",
+			"DTSTART:19970902T090000Z\nRDATE:19970902T090000Z,xxx",
+			"DTSTART:19970902T090000Z\nEXDATE:19970902T250000",
+			"DTSTART:19970902T090000Z\nRDATE;VALUE=PERIOD:19970101T180000Z/19970102T070000Z",
+			"DTSTART:19970902T090000Z\nEXRULE:FREQ=DAILY",
+		}
+		for _, set := range sets {
+			s := ParseSet(set)
+			assert.Error(t, s.Error, set)
+			assert.Empty(t, s.String(), set)
+			assert.Error(t, s.Iterator().Error, set)
+		}
+	})
+}
+
+func TestNewSet(t *testing.T) {
+	dtstart := carbon.Parse("1997-09-02 09:00:00", "America/New_York")
+
+	t.Run("valid set", func(t *testing.T) {
+		s := NewSet(dtstart).
+			AddRRule(Parse("FREQ=DAILY;COUNT=3")).
+			AddRDate(carbon.Parse("1997-09-10 09:00:00", "America/New_York"), carbon.Parse("1997-09-03 09:00:00", "America/New_York")).
+			AddExDate(carbon.Parse("1997-09-04 13:00:00", "UTC"))
+		assert.Nil(t, s.Error)
+		assert.Equal(t, "DTSTART;TZID=America/New_York:19970902T090000\n"+
+			"RRULE:FREQ=DAILY;COUNT=3\n"+
+			"RDATE;TZID=America/New_York:19970910T090000,19970903T090000\n"+
+			"EXDATE:19970904T130000Z", s.String())
+	})
+
+	t.Run("invalid set", func(t *testing.T) {
+		assert.Error(t, NewSet(nil).Error)
+		assert.Error(t, NewSet(carbon.Parse("xxx")).Error)
+		assert.Error(t, NewSet(dtstart).AddRRule(nil).Error)
+		assert.Error(t, NewSet(dtstart).AddRRule(Parse("xxx")).Error)
+		assert.Error(t, NewSet(dtstart).AddRDate(nil).Error)
+		assert.Error(t, NewSet(dtstart).AddExDate(carbon.Parse("")).Error)
+		assert.Error(t, NewSet(dtstart).AddRRule(nil).AddRDate(dtstart).AddExDate(dtstart).Error)
+
+		var s *Set
+		assert.Nil(t, s.AddRRule(NewRule(Daily)))
+		assert.Nil(t, s.AddRDate(dtstart))
+		assert.Nil(t, s.AddExDate(dtstart))
+		assert.Empty(t, s.String())
+		assert.Error(t, s.Iterator().Error)
+	})
+}
+
+func TestSet_Iterator(t *testing.T) {
+	t.Run("merge occurrences", func(t *testing.T) {
+		s := ParseSet("DTSTART;TZID=America/New_York:19970902T090000\n" +
+			"RRULE:FREQ=WEEKLY;COUNT=3\n" +
+			"RRULE:FREQ=MONTHLY;COUNT=2;BYMONTHDAY=9\n" +
+			"RDATE;TZID=America/New_York:19970916T090000,19970901T090000\n" +
+			"EXDATE:19970909T130000Z")
+		occurrences := s.Iterator().Take(10)
+		expected := []string{"1997-09-01", "1997-09-02", "1997-09-16", "1997-10-09"}
+		assert.Len(t, occurrences, len(expected))
+		for i, c := range occurrences {
+			assert.Equal(t, expected[i], c.ToDateString())
+			assert.Equal(t, "09:00:00", c.ToTimeString())
+		}
+	})
+
+	t.Run("date values", func(t *testing.T) {
+		s := ParseSet("DTSTART;VALUE=DATE:20200805\nRRULE:FREQ=YEARLY;COUNT=3\nEXDATE;VALUE=DATE:20210805")
+		occurrences := s.Iterator().Take(10)
+		assert.Len(t, occurrences, 2)
+		assert.Equal(t, "2020-08-05", occurrences[0].ToDateString())
+		assert.Equal(t, "2022-08-05", occurrences[1].ToDateString())
+	})
+
+	t.Run("exclude dtstart", func(t *testing.T) {
+		s := ParseSet("DTSTART:20200805T000000Z\nRRULE:FREQ=DAILY;COUNT=2\nEXDATE:20200805T000000Z")
+		occurrences := s.Iterator().Take(10)
+		assert.Len(t, occurrences, 1)
+		assert.Equal(t, "2020-08-06", occurrences[0].ToDateString())
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		s := NewSet(carbon.Parse("2020-08-05"))
+		s.RRules = append(s.RRules, Parse("xxx"))
+		assert.Error(t, s.Iterator().Error)
+	})
+}
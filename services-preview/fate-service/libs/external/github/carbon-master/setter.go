@@ -118,6 +118,16 @@ func (c *Carbon) SetLocation(loc *Location) *Carbon {
 	return c
 }
 
+// SetClock sets clock, which is consulted by the methods relative to now like IsToday and DiffForHumans,
+// a nil clock means the default clock which follows SetTestNow.
+func (c *Carbon) SetClock(clock Clock) *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	c.clock = clock
+	return c
+}
+
 // SetLocale sets locale.
 func (c *Carbon) SetLocale(locale string) *Carbon {
 	if locale == "" {
@@ -216,6 +226,20 @@ func (c *Carbon) SetDate(year, month, day int) *Carbon {
 	return c
 }
 
+// SetISOWeek sets the ISO 8601 week-numbering year, week and day of week, Monday is 1 and Sunday is 7.
+func (c *Carbon) SetISOWeek(year, week, day int) *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	if !isValidISOWeekDate(year, week, day) {
+		c.Error = ErrInvalidISOWeekDate(year, week, day)
+		return c
+	}
+	hour, minute, second := c.Time()
+	c.time = time.Date(year, time.January, isoWeekDay(year, week, day), hour, minute, second, c.Nanosecond(), c.loc)
+	return c
+}
+
 // SetDateMilli sets year, month, day and millisecond.
 func (c *Carbon) SetDateMilli(year, month, day, millisecond int) *Carbon {
 	if c.IsInvalid() {
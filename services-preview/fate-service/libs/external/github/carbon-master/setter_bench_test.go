@@ -649,6 +649,42 @@ func BenchmarkCarbon_SetDate(b *testing.B) {
 	})
 }
 
+func BenchmarkCarbon_SetISOWeek(b *testing.B) {
+	c := Now()
+	year, week, day := 2020, 32, 3
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.SetISOWeek(year, week, day)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		b.ResetTimer()
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for n := 0; n < b.N/10; n++ {
+					c.SetISOWeek(year, week, day)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.SetISOWeek(year, week, day)
+			}
+		})
+	})
+}
+
 func BenchmarkCarbon_SetDateMilli(b *testing.B) {
 	c := Now()
 	year, month, day, milli := 2020, 8, 5, 999
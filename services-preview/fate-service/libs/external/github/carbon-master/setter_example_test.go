@@ -315,6 +315,15 @@ func ExampleCarbon_SetDate() {
 	// 2020-08-05 00:00:00 +0000 UTC
 }
 
+func ExampleCarbon_SetISOWeek() {
+	fmt.Println(carbon.Parse("2021-01-01 13:14:15").SetISOWeek(2020, 32, 3).ToString())
+	fmt.Println(carbon.Parse("2021-01-01 13:14:15").SetISOWeek(2020, 53, 7).ToString())
+
+	// Output:
+	// 2020-08-05 13:14:15 +0000 UTC
+	// 2021-01-03 13:14:15 +0000 UTC
+}
+
 func ExampleCarbon_SetDateMilli() {
 	fmt.Println(carbon.Parse("2020-08-05").SetDateMilli(2020, 8, 5, 999).ToString())
 
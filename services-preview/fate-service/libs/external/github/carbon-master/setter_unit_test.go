@@ -674,6 +674,37 @@ func (s *SetterSuite) TestCarbon_SetLocation() {
 	})
 }
 
+func (s *SetterSuite) TestCarbon_SetClock() {
+	clock := NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		c = c.SetClock(clock)
+		s.False(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("empty carbon", func() {
+		c := Parse("").SetClock(clock)
+		s.False(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("error carbon", func() {
+		c := Parse("xxx").SetClock(clock)
+		s.True(c.HasError())
+		s.Equal(frozenClock{}, c.Clock())
+	})
+
+	s.Run("valid carbon", func() {
+		c := Parse("2020-08-05").SetClock(clock)
+		s.Equal(clock, c.Clock())
+		s.True(c.IsToday())
+		s.Equal(frozenClock{}, c.SetClock(nil).Clock())
+	})
+}
+
 func (s *SetterSuite) TestCarbon_SetWeekStartsAt() {
 	s.Run("nil carbon", func() {
 		var c *Carbon
@@ -996,6 +1027,51 @@ func (s *SetterSuite) TestCarbon_SetDate() {
 	})
 }
 
+func (s *SetterSuite) TestCarbon_SetISOWeek() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		c = c.SetISOWeek(2020, 32, 3)
+		s.False(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("zero carbon", func() {
+		c := NewCarbon().SetISOWeek(2020, 32, 3)
+		s.False(c.HasError())
+		s.Equal("2020-08-05 00:00:00 +0000 UTC", c.ToString())
+	})
+
+	s.Run("empty carbon", func() {
+		c := Parse("").SetISOWeek(2020, 32, 3)
+		s.False(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("error carbon", func() {
+		s.Empty(Parse("xxx").SetISOWeek(2020, 32, 3).ToString())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("2020-08-05 13:14:15 +0000 UTC", Parse("2021-01-01 13:14:15").SetISOWeek(2020, 32, 3).ToString())
+		s.Equal("2019-12-30 13:14:15 +0000 UTC", Parse("2021-01-01 13:14:15").SetISOWeek(2020, 1, 1).ToString())
+		s.Equal("2021-01-03 13:14:15 +0000 UTC", Parse("2021-01-01 13:14:15").SetISOWeek(2020, 53, 7).ToString())
+		s.Equal("2016-01-03 13:14:15 +0000 UTC", Parse("2021-01-01 13:14:15").SetISOWeek(2015, 53, 7).ToString())
+		s.Equal("2026-12-31 13:14:15 +0800 CST", Parse("2021-01-01 13:14:15", PRC).SetISOWeek(2026, 53, 4).ToString())
+	})
+
+	s.Run("invalid week or day", func() {
+		c := Parse("2021-01-01").SetISOWeek(2021, 53, 1)
+		s.Equal(ErrInvalidISOWeekDate(2021, 53, 1), c.Error)
+		s.Empty(c.ToString())
+		s.True(Parse("2021-01-01").SetISOWeek(2020, 54, 1).HasError())
+		s.True(Parse("2021-01-01").SetISOWeek(2019, 53, 1).HasError())
+		s.True(Parse("2021-01-01").SetISOWeek(2020, 0, 1).HasError())
+		s.True(Parse("2021-01-01").SetISOWeek(2020, 32, 0).HasError())
+		s.True(Parse("2021-01-01").SetISOWeek(2020, 32, 8).HasError())
+	})
+}
+
 func (s *SetterSuite) TestCarbon_SetDateMilli() {
 	s.Run("nil carbon", func() {
 		var c *Carbon
@@ -0,0 +1,118 @@
+package carbon
+
+import (
+	"strings"
+
+	"github.com/dromara/carbon/v2/calendar/solarterm"
+)
+
+// SolarTerm gets the solar term name in effect like "Start of Spring", i18n is supported.
+func (c *Carbon) SolarTerm() string {
+	if c.IsInvalid() {
+		return ""
+	}
+	s := solarterm.FromStdTime(c.StdTime())
+	if s == nil {
+		return ""
+	}
+	return c.lang.solarTermName(s.Index())
+}
+
+// PrevSolarTerm gets the exact moment of the solar term in effect, which is at or before the current instance.
+func (c *Carbon) PrevSolarTerm() *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	s := solarterm.FromStdTime(c.StdTime())
+	if s == nil {
+		return c
+	}
+	return c.solarTermMoment(s)
+}
+
+// NextSolarTerm gets the exact moment of the next solar term, which is after the current instance.
+func (c *Carbon) NextSolarTerm() *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	s := solarterm.FromStdTime(c.StdTime())
+	if s == nil {
+		return c
+	}
+	return c.solarTermMoment(s.Next())
+}
+
+// IsSolarTermDay reports whether a solar term falls on the day in the timezone of the current instance.
+func (c *Carbon) IsSolarTermDay() bool {
+	if c.IsInvalid() {
+		return false
+	}
+	s := solarterm.FromStdTime(c.EndOfDay().StdTime())
+	if s == nil {
+		return false
+	}
+	return !s.ToGregorian().Time.Before(c.StartOfDay().StdTime())
+}
+
+// CreateFromSolarTerm creates a Carbon instance at the exact moment of the solar term in the gregorian year,
+// name can be chinese like "立春", english like "Start of Spring" or localized by the default locale.
+func CreateFromSolarTerm(year int, name string, timezone ...string) *Carbon {
+	s := newSolarTerm(NewLanguage().SetLocale(DefaultLocale), year, name)
+	if s.Error != nil {
+		return &Carbon{Error: s.Error}
+	}
+	if len(timezone) == 0 {
+		timezone = []string{DefaultTimezone}
+	}
+	loc, err := parseTimezone(timezone...)
+	if err != nil {
+		return &Carbon{Error: err}
+	}
+	return NewCarbon(s.ToGregorian().Time.In(loc))
+}
+
+// sets the time of a copy of the current instance to the exact moment of the solar term.
+func (c *Carbon) solarTermMoment(s *solarterm.SolarTerm) *Carbon {
+	g := s.ToGregorian()
+	t := c.Copy()
+	t.lang = c.lang.Copy()
+	t.time = g.Time.In(c.loc)
+	return t
+}
+
+// creates a solar term by chinese, english or localized name.
+func newSolarTerm(lang *Language, year int, name string) *solarterm.SolarTerm {
+	if index := solarterm.IndexOf(name); index >= 0 {
+		return solarterm.NewSolarTerm(year, index)
+	}
+	if lang != nil && lang.resources != nil {
+		lang.rw.RLock()
+		resource := lang.resources["solar_terms"]
+		lang.rw.RUnlock()
+		if terms := strings.Split(resource, "|"); len(terms) == solarterm.TermsPerYear {
+			for i, term := range terms {
+				if strings.EqualFold(term, strings.TrimSpace(name)) {
+					return solarterm.NewSolarTerm(year, i)
+				}
+			}
+		}
+	}
+	return solarterm.FromName(year, name)
+}
+
+// gets the localized name of a solar term by index.
+func (lang *Language) solarTermName(index int) string {
+	if lang == nil || lang.resources == nil {
+		return ""
+	}
+	lang.rw.RLock()
+	defer lang.rw.RUnlock()
+
+	if resources, ok := lang.resources["solar_terms"]; ok {
+		slice := strings.Split(resources, "|")
+		if len(slice) == solarterm.TermsPerYear {
+			return slice[index]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,173 @@
+package carbon
+
+import (
+	"sync"
+	"testing"
+)
+
+func BenchmarkCarbon_SolarTerm(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.SolarTerm()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.SolarTerm()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.SolarTerm()
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_PrevSolarTerm(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.PrevSolarTerm()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.PrevSolarTerm()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.PrevSolarTerm()
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_NextSolarTerm(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.NextSolarTerm()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.NextSolarTerm()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.NextSolarTerm()
+			}
+		})
+	})
+}
+
+func BenchmarkCarbon_IsSolarTermDay(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			c.IsSolarTermDay()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.IsSolarTermDay()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		c := Parse("2020-08-05")
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.IsSolarTermDay()
+			}
+		})
+	})
+}
+
+func BenchmarkCreateFromSolarTerm(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			CreateFromSolarTerm(2024, "立春")
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		b.ResetTimer()
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				CreateFromSolarTerm(2024, "立春")
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				CreateFromSolarTerm(2024, "立春")
+			}
+		})
+	})
+}
@@ -0,0 +1,48 @@
+package carbon_test
+
+import (
+	"fmt"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func ExampleCarbon_SolarTerm() {
+	fmt.Println(carbon.Parse("2020-08-05").SolarTerm())
+	fmt.Println(carbon.Parse("2020-08-10").SetLocale("zh-CN").SolarTerm())
+
+	// Output:
+	// Major Heat
+	// 立秋
+}
+
+func ExampleCarbon_PrevSolarTerm() {
+	fmt.Println(carbon.Parse("2020-08-05", carbon.PRC).PrevSolarTerm().ToString())
+
+	// Output:
+	// 2020-07-22 16:36:52 +0800 CST
+}
+
+func ExampleCarbon_NextSolarTerm() {
+	fmt.Println(carbon.Parse("2020-08-05", carbon.PRC).NextSolarTerm().ToString())
+
+	// Output:
+	// 2020-08-07 09:06:11 +0800 CST
+}
+
+func ExampleCarbon_IsSolarTermDay() {
+	fmt.Println(carbon.Parse("2020-08-07", carbon.PRC).IsSolarTermDay())
+	fmt.Println(carbon.Parse("2020-08-08", carbon.PRC).IsSolarTermDay())
+
+	// Output:
+	// true
+	// false
+}
+
+func ExampleCreateFromSolarTerm() {
+	fmt.Println(carbon.CreateFromSolarTerm(2024, "立春", carbon.PRC).ToString())
+	fmt.Println(carbon.CreateFromSolarTerm(2024, "Winter Solstice", carbon.PRC).ToString())
+
+	// Output:
+	// 2024-02-04 16:27:07 +0800 CST
+	// 2024-12-21 17:20:35 +0800 CST
+}
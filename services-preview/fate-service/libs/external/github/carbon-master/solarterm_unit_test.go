@@ -0,0 +1,178 @@
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SolarTermSuite struct {
+	suite.Suite
+}
+
+func TestSolarTermSuite(t *testing.T) {
+	suite.Run(t, new(SolarTermSuite))
+}
+
+func (s *SolarTermSuite) TestCarbon_SolarTerm() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Empty(c.SolarTerm())
+	})
+
+	s.Run("zero carbon", func() {
+		s.Empty(NewCarbon().SolarTerm())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Empty(Parse("").SolarTerm())
+	})
+
+	s.Run("error carbon", func() {
+		s.Empty(Parse("xxx").SolarTerm())
+	})
+
+	s.Run("nil language", func() {
+		c := Parse("2020-08-05")
+		c.lang = nil
+		s.Empty(c.SolarTerm())
+	})
+
+	s.Run("without resources", func() {
+		s.Empty(Parse("2020-08-05").SetLocale("fr").SolarTerm())
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("Major Heat", Parse("2020-08-05").SolarTerm())
+		s.Equal("Start of Autumn", Parse("2020-08-07 09:06:11", PRC).SolarTerm())
+		s.Equal("Major Heat", Parse("2020-08-07 09:06:10", PRC).SolarTerm())
+		s.Equal("Winter Solstice", Parse("2025-01-01").SolarTerm())
+		s.Equal("立秋", Parse("2020-08-10").SetLocale("zh-CN").SolarTerm())
+		s.Equal("立秋", Parse("2020-08-10").SetLocale("ja").SolarTerm())
+		s.Equal("입추", Parse("2020-08-10").SetLocale("ko").SolarTerm())
+		s.Equal("Lập thu", Parse("2020-08-10").SetLocale("vi").SolarTerm())
+		s.Equal("Spring Equinox", Parse("1500-03-25").SolarTerm())
+		s.Equal("Spring Equinox", Parse("2500-03-25").SolarTerm())
+	})
+}
+
+func (s *SolarTermSuite) TestCarbon_PrevSolarTerm() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Nil(c.PrevSolarTerm())
+	})
+
+	s.Run("zero carbon", func() {
+		s.Equal("0001-01-01 00:00:00 +0000 UTC", NewCarbon().PrevSolarTerm().ToString())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Empty(Parse("").PrevSolarTerm().ToString())
+	})
+
+	s.Run("error carbon", func() {
+		s.Error(Parse("xxx").PrevSolarTerm().Error)
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("2020-07-22 16:36:52 +0800 CST", Parse("2020-08-05", PRC).PrevSolarTerm().ToString())
+		s.Equal("2020-08-07 09:06:11 +0800 CST", Parse("2020-08-07 09:06:11", PRC).PrevSolarTerm().ToString())
+		s.Equal("2024-12-21 09:20:35 +0000 UTC", Parse("2025-01-01").PrevSolarTerm().ToString())
+		s.Equal("zh-CN", Parse("2020-08-05").SetLocale("zh-CN").PrevSolarTerm().Locale())
+		s.Equal("大暑", Parse("2020-08-05").SetLocale("zh-CN").PrevSolarTerm().SolarTerm())
+	})
+}
+
+func (s *SolarTermSuite) TestCarbon_NextSolarTerm() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Nil(c.NextSolarTerm())
+	})
+
+	s.Run("zero carbon", func() {
+		s.Equal("0001-01-01 00:00:00 +0000 UTC", NewCarbon().NextSolarTerm().ToString())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Empty(Parse("").NextSolarTerm().ToString())
+	})
+
+	s.Run("error carbon", func() {
+		s.Error(Parse("xxx").NextSolarTerm().Error)
+	})
+
+	s.Run("valid carbon", func() {
+		s.Equal("2020-08-07 09:06:11 +0800 CST", Parse("2020-08-05", PRC).NextSolarTerm().ToString())
+		s.Equal("2020-08-22 23:44:56 +0800 CST", Parse("2020-08-07 09:06:11", PRC).NextSolarTerm().ToString())
+		s.Equal("2025-01-05 02:32:47 +0000 UTC", Parse("2025-01-01").NextSolarTerm().ToString())
+		s.Equal("立秋", Parse("2020-08-05").SetLocale("zh-CN").NextSolarTerm().SolarTerm())
+	})
+}
+
+func (s *SolarTermSuite) TestCarbon_IsSolarTermDay() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.False(c.IsSolarTermDay())
+	})
+
+	s.Run("zero carbon", func() {
+		s.False(NewCarbon().IsSolarTermDay())
+	})
+
+	s.Run("empty carbon", func() {
+		s.False(Parse("").IsSolarTermDay())
+	})
+
+	s.Run("error carbon", func() {
+		s.False(Parse("xxx").IsSolarTermDay())
+	})
+
+	s.Run("valid carbon", func() {
+		s.True(Parse("2020-08-07", PRC).IsSolarTermDay())
+		s.True(Parse("2020-08-07 23:59:59", PRC).IsSolarTermDay())
+		s.False(Parse("2020-08-06", PRC).IsSolarTermDay())
+		s.False(Parse("2020-08-08", PRC).IsSolarTermDay())
+		s.True(Parse("2020-08-22", PRC).IsSolarTermDay())
+		// the day depends on the timezone
+		s.True(Parse("2020-08-22", UTC).IsSolarTermDay())
+		s.True(Parse("2020-08-22", "America/New_York").IsSolarTermDay())
+		s.False(Parse("2020-08-22", "Asia/Tokyo").IsSolarTermDay())
+		s.True(Parse("2020-08-23", "Asia/Tokyo").IsSolarTermDay())
+	})
+}
+
+func (s *SolarTermSuite) TestCreateFromSolarTerm() {
+	s.Run("error name", func() {
+		s.Error(CreateFromSolarTerm(2020, "").Error)
+		s.Error(CreateFromSolarTerm(2020, "xxx").Error)
+	})
+
+	s.Run("error timezone", func() {
+		s.Error(CreateFromSolarTerm(2020, "立秋", "xxx").Error)
+	})
+
+	s.Run("valid name", func() {
+		s.Equal("2020-08-07 01:06:11 +0000 UTC", CreateFromSolarTerm(2020, "立秋").ToString())
+		s.Equal("2020-08-07 09:06:11 +0800 CST", CreateFromSolarTerm(2020, "立秋", PRC).ToString())
+		s.Equal("2020-08-07 09:06:11 +0800 CST", CreateFromSolarTerm(2020, "start of autumn", PRC).ToString())
+		s.Equal("2024-02-04 16:27:07 +0800 CST", CreateFromSolarTerm(2024, "立春", PRC).ToString())
+		s.Equal("2024-12-21 17:20:35 +0800 CST", CreateFromSolarTerm(2024, "冬至", PRC).ToString())
+		s.Equal("2024-01-06 04:49:22 +0800 CST", CreateFromSolarTerm(2024, "小寒", PRC).ToString())
+	})
+
+	s.Run("localized name", func() {
+		defer SetLocale("en")
+		SetLocale("ko")
+		s.Equal("2020-08-07 09:06:11 +0800 CST", CreateFromSolarTerm(2020, "입추", PRC).ToString())
+	})
+
+	s.Run("out of 1900-2100", func() {
+		s.Equal("1800-03-20", CreateFromSolarTerm(1800, "春分").ToDateString())
+		s.Equal("2200-03-20", CreateFromSolarTerm(2200, "春分").ToDateString())
+		s.Equal(2500, CreateFromSolarTerm(2500, "冬至").Year())
+	})
+}
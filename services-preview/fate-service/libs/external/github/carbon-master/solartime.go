@@ -0,0 +1,110 @@
+package carbon
+
+import (
+	"math"
+	"time"
+
+	"github.com/dromara/carbon/v2/calendar/julian"
+)
+
+// abbreviations of the fixed zones of the local solar times.
+const (
+	meanSolarTimeZone     = "LMT"
+	apparentSolarTimeZone = "LAT"
+)
+
+// ToMeanSolarTime converts to the local mean solar time at the longitude, which is positive to the east in degrees,
+// the instant is unchanged and the wall clock is shown in a fixed zone "LMT" which is 4 minutes ahead per degree east.
+func (c *Carbon) ToMeanSolarTime(longitude float64) *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	if !isValidLongitude(longitude) {
+		return c.withError(ErrInvalidLongitude(longitude))
+	}
+	return c.inSolarTimeZone(meanSolarTimeZone, getMeanSolarOffset(longitude))
+}
+
+// ToApparentSolarTime converts to the local apparent solar time at the longitude, which is positive to the east in degrees,
+// the instant is unchanged and the wall clock is shown in a fixed zone "LAT" which also includes the equation of time,
+// so that the sun crosses the meridian at 12:00.
+func (c *Carbon) ToApparentSolarTime(longitude float64) *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	if !isValidLongitude(longitude) {
+		return c.withError(ErrInvalidLongitude(longitude))
+	}
+	return c.inSolarTimeZone(apparentSolarTimeZone, getMeanSolarOffset(longitude)+getEquationOfTime(c.StdTime()))
+}
+
+// FromMeanSolarTime is the inverse of ToMeanSolarTime, it treats the wall clock as the local mean solar time
+// at the longitude and converts it to the timezone of the current instance.
+func (c *Carbon) FromMeanSolarTime(longitude float64) *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	if !isValidLongitude(longitude) {
+		return c.withError(ErrInvalidLongitude(longitude))
+	}
+	return c.fromSolarTime(getMeanSolarOffset(longitude))
+}
+
+// FromApparentSolarTime is the inverse of ToApparentSolarTime, it treats the wall clock as the local apparent solar time
+// at the longitude and converts it to the timezone of the current instance.
+func (c *Carbon) FromApparentSolarTime(longitude float64) *Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	if !isValidLongitude(longitude) {
+		return c.withError(ErrInvalidLongitude(longitude))
+	}
+	offset := getMeanSolarOffset(longitude)
+	t := c.fromSolarTime(offset)
+	// the equation of time changes less than a minute per day, so it converges quickly
+	for i := 0; i < 2; i++ {
+		t = c.fromSolarTime(offset + getEquationOfTime(t.StdTime()))
+	}
+	return t
+}
+
+// returns a copy of the current instance with the same instant in the fixed zone of the solar time.
+func (c *Carbon) inSolarTimeZone(name string, offset time.Duration) *Carbon {
+	t := c.Copy()
+	t.lang = c.lang.Copy()
+	t.loc = time.FixedZone(name, int(offset.Round(time.Second)/time.Second))
+	t.time = c.time.In(t.loc)
+	return t
+}
+
+// returns a copy of the current instance whose wall clock is treated as a solar time ahead of UTC by the offset.
+func (c *Carbon) fromSolarTime(offset time.Duration) *Carbon {
+	year, month, day, hour, minute, second, nanosecond := c.DateTimeNano()
+	t := c.Copy()
+	t.lang = c.lang.Copy()
+	t.time = time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, time.UTC).Add(-offset.Round(time.Second)).In(c.loc)
+	return t
+}
+
+// returns a copy of the current instance with the error.
+func (c *Carbon) withError(err error) *Carbon {
+	t := c.Copy()
+	t.Error = err
+	return t
+}
+
+// gets the offset of the local mean solar time from UTC at the longitude.
+func getMeanSolarOffset(longitude float64) time.Duration {
+	return time.Duration(longitude * 240 * float64(time.Second))
+}
+
+// gets the equation of time at the instant, which is the apparent solar time minus the mean solar time.
+func getEquationOfTime(t time.Time) time.Duration {
+	_, eot := getSunPosition(julian.FromStdTime(t.UTC()).JD())
+	return time.Duration(eot * float64(time.Minute))
+}
+
+// reports whether the longitude is in [-180, 180].
+func isValidLongitude(longitude float64) bool {
+	return !math.IsNaN(longitude) && math.Abs(longitude) <= 180
+}
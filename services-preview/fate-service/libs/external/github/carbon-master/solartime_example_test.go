@@ -0,0 +1,39 @@
+package carbon_test
+
+import (
+	"fmt"
+
+	"github.com/dromara/carbon/v2"
+)
+
+func ExampleCarbon_ToMeanSolarTime() {
+	// Urumqi
+	fmt.Println(carbon.Parse("2020-08-05 13:14:15", carbon.PRC).ToMeanSolarTime(87.6168).ToString())
+
+	// Output:
+	// 2020-08-05 11:04:43 +0550 LMT
+}
+
+func ExampleCarbon_ToApparentSolarTime() {
+	// Urumqi
+	fmt.Println(carbon.Parse("2020-08-05 13:14:15", carbon.PRC).ToApparentSolarTime(87.6168).ToString())
+
+	// Output:
+	// 2020-08-05 10:58:43 +0544 LAT
+}
+
+func ExampleCarbon_FromMeanSolarTime() {
+	// 11:14:15 mean solar time in Urumqi
+	fmt.Println(carbon.Parse("2020-08-05 11:14:15", carbon.PRC).FromMeanSolarTime(87.6168).ToString())
+
+	// Output:
+	// 2020-08-05 13:23:47 +0800 CST
+}
+
+func ExampleCarbon_FromApparentSolarTime() {
+	// 11:14:15 apparent solar time in Urumqi
+	fmt.Println(carbon.Parse("2020-08-05 11:14:15", carbon.PRC).FromApparentSolarTime(87.6168).ToString())
+
+	// Output:
+	// 2020-08-05 13:29:47 +0800 CST
+}
@@ -0,0 +1,167 @@
+package carbon
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SolarTimeSuite struct {
+	suite.Suite
+}
+
+func TestSolarTimeSuite(t *testing.T) {
+	suite.Run(t, new(SolarTimeSuite))
+}
+
+func (s *SolarTimeSuite) TestCarbon_ToMeanSolarTime() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Nil(c.ToMeanSolarTime(87.6168))
+	})
+
+	s.Run("zero carbon", func() {
+		s.Equal("0001-01-01 05:50:28 +0550 LMT", NewCarbon().ToMeanSolarTime(87.6168).ToString())
+	})
+
+	s.Run("empty carbon", func() {
+		s.Empty(Parse("").ToMeanSolarTime(87.6168).ToString())
+	})
+
+	s.Run("error carbon", func() {
+		s.Error(Parse("xxx").ToMeanSolarTime(87.6168).Error)
+	})
+
+	s.Run("invalid longitude", func() {
+		c := Parse("2020-08-05 13:14:15", PRC)
+		s.Equal(ErrInvalidLongitude(181), c.ToMeanSolarTime(181).Error)
+		s.Error(c.ToMeanSolarTime(-181).Error)
+		s.Error(c.ToMeanSolarTime(math.NaN()).Error)
+		s.Nil(c.Error)
+	})
+
+	s.Run("valid carbon", func() {
+		// Urumqi
+		c := Parse("2020-08-05 13:14:15", PRC)
+		m := c.ToMeanSolarTime(87.6168)
+		s.Equal("2020-08-05 11:04:43 +0550 LMT", m.ToString())
+		s.True(m.Eq(c))
+		s.Equal("2020-08-05 13:14:15 +0800 CST", c.ToString())
+
+		s.Equal("2020-08-05 05:14:15 +0000 LMT", c.ToMeanSolarTime(0).ToString())
+		s.Equal("2020-08-05 17:14:15 +1200 LMT", c.ToMeanSolarTime(180).ToString())
+		s.Equal("2020-08-04 17:14:15 -1200 LMT", c.ToMeanSolarTime(-180).ToString())
+		s.Equal("2020-08-05 00:18:14 -0456 LMT", c.ToMeanSolarTime(-74.0060).ToString())
+	})
+}
+
+func (s *SolarTimeSuite) TestCarbon_ToApparentSolarTime() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Nil(c.ToApparentSolarTime(87.6168))
+	})
+
+	s.Run("empty carbon", func() {
+		s.Empty(Parse("").ToApparentSolarTime(87.6168).ToString())
+	})
+
+	s.Run("error carbon", func() {
+		s.Error(Parse("xxx").ToApparentSolarTime(87.6168).Error)
+	})
+
+	s.Run("invalid longitude", func() {
+		c := Parse("2020-08-05 13:14:15", PRC)
+		s.Equal(ErrInvalidLongitude(-200), c.ToApparentSolarTime(-200).Error)
+		s.Error(c.ToApparentSolarTime(math.NaN()).Error)
+	})
+
+	s.Run("valid carbon", func() {
+		// Urumqi, the equation of time is about -6 minutes in early August
+		c := Parse("2020-08-05 13:14:15", PRC)
+		a := c.ToApparentSolarTime(87.6168)
+		s.Equal("2020-08-05 10:58:43 +0544 LAT", a.ToString())
+		s.True(a.Eq(c))
+
+		// the equation of time is about +16 minutes in early November and -14 minutes in mid February
+		s.Equal("2020-11-03 12:16:29 +0016 LAT", Parse("2020-11-03 12:00:00", UTC).ToApparentSolarTime(0).ToString())
+		s.Equal("2020-02-11 11:45:46 -0014 LAT", Parse("2020-02-11 12:00:00", UTC).ToApparentSolarTime(0).ToString())
+
+		// the solar noon is at 12:00 apparent solar time
+		st := Parse("2020-08-05", PRC).SunTimes(39.9042, 116.4074)
+		s.Equal("2020-08-05 12:00:00", st.SolarNoon.ToApparentSolarTime(116.4074).ToDateTimeString())
+		st = Parse("2020-06-21", "Europe/London").SunTimes(51.5074, -0.1278)
+		s.Equal("2020-06-21 12:00:00", st.SolarNoon.ToApparentSolarTime(-0.1278).ToDateTimeString())
+	})
+}
+
+func (s *SolarTimeSuite) TestCarbon_FromMeanSolarTime() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Nil(c.FromMeanSolarTime(87.6168))
+	})
+
+	s.Run("empty carbon", func() {
+		s.Empty(Parse("").FromMeanSolarTime(87.6168).ToString())
+	})
+
+	s.Run("error carbon", func() {
+		s.Error(Parse("xxx").FromMeanSolarTime(87.6168).Error)
+	})
+
+	s.Run("invalid longitude", func() {
+		s.Equal(ErrInvalidLongitude(180.5), Parse("2020-08-05 11:14:15", PRC).FromMeanSolarTime(180.5).Error)
+	})
+
+	s.Run("valid carbon", func() {
+		// 11:14:15 mean solar time in Urumqi
+		c := Parse("2020-08-05 11:14:15", PRC)
+		s.Equal("2020-08-05 13:23:47 +0800 CST", c.FromMeanSolarTime(87.6168).ToString())
+		s.Equal("2020-08-05 11:14:15 +0800 CST", c.ToString())
+		s.Equal(PRC, c.FromMeanSolarTime(87.6168).Timezone())
+
+		// round trip
+		c = Parse("2020-08-05 13:14:15.123456789", PRC)
+		s.True(c.ToMeanSolarTime(87.6168).FromMeanSolarTime(87.6168).Eq(c))
+		s.True(c.ToMeanSolarTime(-122.4194).FromMeanSolarTime(-122.4194).Eq(c))
+	})
+}
+
+func (s *SolarTimeSuite) TestCarbon_FromApparentSolarTime() {
+	s.Run("nil carbon", func() {
+		var c *Carbon
+		c = nil
+		s.Nil(c.FromApparentSolarTime(87.6168))
+	})
+
+	s.Run("empty carbon", func() {
+		s.Empty(Parse("").FromApparentSolarTime(87.6168).ToString())
+	})
+
+	s.Run("error carbon", func() {
+		s.Error(Parse("xxx").FromApparentSolarTime(87.6168).Error)
+	})
+
+	s.Run("invalid longitude", func() {
+		s.Equal(ErrInvalidLongitude(-180.5), Parse("2020-08-05 11:14:15", PRC).FromApparentSolarTime(-180.5).Error)
+	})
+
+	s.Run("valid carbon", func() {
+		// 11:14:15 apparent solar time in Urumqi
+		c := Parse("2020-08-05 11:14:15", PRC)
+		s.Equal("2020-08-05 13:29:47 +0800 CST", c.FromApparentSolarTime(87.6168).ToString())
+
+		// the apparent noon in Beijing is the solar noon
+		s.Equal("2020-08-05 12:20:22 +0800 CST", Parse("2020-08-05 12:00:00", PRC).FromApparentSolarTime(116.4074).ToString())
+
+		// round trip
+		for _, date := range []string{"2020-02-11 13:14:15", "2020-08-05 13:14:15", "2020-11-03 23:59:59"} {
+			c = Parse(date, PRC)
+			s.True(c.ToApparentSolarTime(87.6168).FromApparentSolarTime(87.6168).Eq(c), date)
+			s.True(c.ToApparentSolarTime(-74.0060).FromApparentSolarTime(-74.0060).Eq(c), date)
+		}
+	})
+}
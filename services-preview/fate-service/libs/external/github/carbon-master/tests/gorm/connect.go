@@ -16,8 +16,12 @@ const (
 	driverMySQL  = "mysql"
 	driverPgSQL  = "postgres"
 	driverSQLite = "sqlite"
+	driverMemory = "memory"
 )
 
+// dsn of the in-memory sqlite database standing in for MySQL, no `.env` file is required
+const memoryDSN = "file::memory:?cache=shared"
+
 var (
 	dsn string
 	dia gorm.Dialector
@@ -26,8 +30,10 @@ var (
 )
 
 func connect(driver string) *gorm.DB {
-	if err = godotenv.Load("../.env"); err != nil {
-		panic("`.env` file does not exist, please copy `.env.example` file to `.env` file")
+	if driver != driverMemory {
+		if err = godotenv.Load("../.env"); err != nil {
+			panic("`.env` file does not exist, please copy `.env.example` file to `.env` file")
+		}
 	}
 
 	switch driver {
@@ -39,6 +45,8 @@ func connect(driver string) *gorm.DB {
 		dia = postgres.Open(dsn)
 	case driverSQLite:
 		dia = sqlite.Open(os.Getenv("SQLite_DB_DATABASE"))
+	case driverMemory:
+		dia = sqlite.Open(memoryDSN)
 	}
 	db, err = gorm.Open(dia, &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
@@ -208,3 +208,23 @@ type SQLiteModel2 struct {
 func (SQLiteModel2) TableName() string {
 	return "gorm_sqlite2"
 }
+
+type MySQLNullModel struct {
+	ID uint64 `json:"-" gorm:"column:id;primaryKey"`
+
+	Date carbon.Null[carbon.Date] `gorm:"column:date;type:date;" json:"date"`
+
+	DateTime1 carbon.NullType[carbon.DateTime, carbon.AsNull]  `gorm:"column:date_time1;type:datetime;" json:"date_time1"`
+	DateTime2 carbon.NullType[carbon.DateTime, carbon.AsEmpty] `gorm:"column:date_time2;type:varchar(50);" json:"date_time2"`
+
+	RFC3339Layout carbon.NullType[carbon.LayoutType[RFC3339Layout], carbon.AsEmpty] `gorm:"column:rfc3339_layout;type:varchar(50);" json:"rfc3339_layout"`
+	ISO8601Format carbon.NullType[carbon.FormatType[ISO8601Format], carbon.AsNull]  `gorm:"column:iso8601_format;type:timestamp;" json:"iso8601_format"`
+
+	Timestamp carbon.NullType[carbon.Timestamp, carbon.AsZero] `gorm:"column:timestamp;type:varchar(50);" json:"timestamp"`
+
+	ExpiredAt carbon.Null[carbon.DateTime] `gorm:"column:expired_at;type:datetime;" json:"expired_at"`
+}
+
+func (MySQLNullModel) TableName() string {
+	return "gorm_mysql_null"
+}
@@ -16,8 +16,12 @@ const (
 	driverMySQL  = "mysql"
 	driverPgSQL  = "postgres"
 	driverSQLite = "sqlite"
+	driverMemory = "memory"
 )
 
+// dsn of the in-memory sqlite database standing in for MySQL, no `.env` file is required
+const memoryDSN = "file::memory:?cache=shared"
+
 var (
 	dsn string
 	db  *xorm.Engine
@@ -25,8 +29,10 @@ var (
 )
 
 func connect(driver string) *xorm.Engine {
-	if err = godotenv.Load("../.env"); err != nil {
-		panic("`.env` file does not exist, please copy `.env.example` file to `.env` file")
+	if driver != driverMemory {
+		if err = godotenv.Load("../.env"); err != nil {
+			panic("`.env` file does not exist, please copy `.env.example` file to `.env` file")
+		}
 	}
 
 	switch driver {
@@ -38,6 +44,8 @@ func connect(driver string) *xorm.Engine {
 		db, err = xorm.NewEngine("postgres", dsn)
 	case driverSQLite:
 		db, err = xorm.NewEngine("sqlite3", os.Getenv("SQLite_DB_DATABASE"))
+	case driverMemory:
+		db, err = xorm.NewEngine("sqlite3", memoryDSN)
 	}
 	if err != nil {
 		panic(fmt.Sprintf("failed to connect database, dsn: %q", dsn))
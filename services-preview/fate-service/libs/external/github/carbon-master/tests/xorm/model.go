@@ -207,3 +207,23 @@ type SQLiteModel2 struct {
 func (SQLiteModel2) TableName() string {
 	return "xorm_sqlite2"
 }
+
+type MySQLNullModel struct {
+	Id uint64 `json:"-" xorm:"pk autoincr"`
+
+	Date carbon.Null[carbon.Date] `xorm:"DATE date" json:"date"`
+
+	DateTime1 carbon.NullType[carbon.DateTime, carbon.AsNull]  `xorm:"DATETIME date_time1" json:"date_time1"`
+	DateTime2 carbon.NullType[carbon.DateTime, carbon.AsEmpty] `xorm:"VARCHAR(50) date_time2" json:"date_time2"`
+
+	RFC3339Layout carbon.NullType[carbon.LayoutType[RFC3339Layout], carbon.AsEmpty] `xorm:"VARCHAR(50) rfc3339_layout" json:"rfc3339_layout"`
+	ISO8601Format carbon.NullType[carbon.FormatType[ISO8601Format], carbon.AsNull]  `xorm:"TIMESTAMP iso8601_format" json:"iso8601_format"`
+
+	Timestamp carbon.NullType[carbon.Timestamp, carbon.AsZero] `xorm:"VARCHAR(50) 'timestamp'" json:"timestamp"`
+
+	ExpiredAt carbon.Null[carbon.DateTime] `xorm:"DATETIME expired_at" json:"expired_at"`
+}
+
+func (MySQLNullModel) TableName() string {
+	return "xorm_mysql_null"
+}
@@ -0,0 +1,205 @@
+package xorm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dromara/carbon/v2"
+	"github.com/stretchr/testify/suite"
+)
+
+// MySQLNullSuite runs the MySQL null model against an in-memory sqlite database, so it runs without a MySQL server.
+type MySQLNullSuite struct {
+	suite.Suite
+}
+
+func TestMySQLNullSuite(t *testing.T) {
+	suite.Run(t, new(MySQLNullSuite))
+}
+
+func (s *MySQLNullSuite) SetupSuite() {
+	carbon.SetTimezone(carbon.PRC)
+	carbon.SetTestNow(carbon.Parse("2020-08-05 13:14:15.111111111"))
+	db = connect(driverMemory)
+	if err = db.Sync(&MySQLNullModel{}); err != nil {
+		panic(err)
+	}
+}
+
+func (s *MySQLNullSuite) TearDownSuite() {
+	carbon.ClearTestNow()
+	if _, err = db.Where("1 = 1").Delete(MySQLNullModel{}); err != nil {
+		panic(err)
+	}
+}
+
+func (s *MySQLNullSuite) TestCurd() {
+	s.Run("unset carbon", func() {
+		var model1 MySQLNullModel
+
+		// create
+		_, err = db.Insert(&model1)
+		s.Nil(err)
+
+		// raw
+		results, err1 := db.QueryString("SELECT date_time2, timestamp FROM xorm_mysql_null WHERE id = ?", model1.Id)
+		s.Nil(err1)
+		s.Equal("", results[0]["date_time2"])
+		s.Equal("0", results[0]["timestamp"])
+
+		// read
+		var model2 MySQLNullModel
+		_, err = db.NoAutoCondition().Desc("id").Get(&model2)
+		s.Nil(err)
+
+		data1, err2 := json.Marshal(&model2)
+		s.Nil(err2)
+		s.Equal(`{"date":null,"date_time1":null,"date_time2":"","rfc3339_layout":"","iso8601_format":null,"timestamp":0,"expired_at":null}`, string(data1))
+
+		// delete
+		_, err = db.ID(model2.Id).Delete(MySQLNullModel{})
+		s.Nil(err)
+	})
+
+	s.Run("zero carbon", func() {
+		var model1 MySQLNullModel
+
+		c := carbon.NewCarbon()
+
+		model1.Date = *carbon.NewNull(carbon.NewDate(c))
+		model1.DateTime1 = *carbon.NewNullType[carbon.DateTime, carbon.AsNull](carbon.NewDateTime(c))
+		model1.DateTime2 = *carbon.NewNullType[carbon.DateTime, carbon.AsEmpty](carbon.NewDateTime(c))
+		model1.RFC3339Layout = *carbon.NewNullType[carbon.LayoutType[RFC3339Layout], carbon.AsEmpty](carbon.NewLayoutType[RFC3339Layout](c))
+		model1.ISO8601Format = *carbon.NewNullType[carbon.FormatType[ISO8601Format], carbon.AsNull](carbon.NewFormatType[ISO8601Format](c))
+		model1.Timestamp = *carbon.NewNullType[carbon.Timestamp, carbon.AsZero](carbon.NewTimestamp(c))
+		model1.ExpiredAt = *carbon.NewNull(carbon.NewDateTime(c))
+
+		// create
+		_, err = db.Insert(&model1)
+		s.Nil(err)
+
+		// read
+		var model2 MySQLNullModel
+		_, err = db.NoAutoCondition().Desc("id").Get(&model2)
+		s.Nil(err)
+
+		data1, err1 := json.Marshal(&model2)
+		s.Nil(err1)
+		s.Equal(`{"date":null,"date_time1":null,"date_time2":"","rfc3339_layout":"","iso8601_format":null,"timestamp":0,"expired_at":null}`, string(data1))
+
+		// delete
+		_, err = db.ID(model2.Id).Delete(MySQLNullModel{})
+		s.Nil(err)
+	})
+
+	s.Run("empty carbon", func() {
+		var model1 MySQLNullModel
+
+		c := carbon.Parse("")
+
+		model1.Date = *carbon.NewNull(carbon.NewDate(c))
+		model1.DateTime2 = *carbon.NewNullType[carbon.DateTime, carbon.AsEmpty](carbon.NewDateTime(c))
+		model1.Timestamp = *carbon.NewNullType[carbon.Timestamp, carbon.AsZero](carbon.NewTimestamp(c))
+
+		// create
+		_, err = db.Insert(&model1)
+		s.Nil(err)
+
+		// read
+		var model2 MySQLNullModel
+		_, err = db.NoAutoCondition().Desc("id").Get(&model2)
+		s.Nil(err)
+
+		data1, err1 := json.Marshal(&model2)
+		s.Nil(err1)
+		s.Equal(`{"date":null,"date_time1":null,"date_time2":"","rfc3339_layout":"","iso8601_format":null,"timestamp":0,"expired_at":null}`, string(data1))
+
+		// delete
+		_, err = db.ID(model2.Id).Delete(MySQLNullModel{})
+		s.Nil(err)
+	})
+
+	s.Run("error carbon", func() {
+		var model1 MySQLNullModel
+
+		model1.DateTime2 = *carbon.NewNullType[carbon.DateTime, carbon.AsEmpty](carbon.NewDateTime(carbon.Parse("xxx")))
+
+		// create
+		_, err = db.Insert(&model1)
+		s.Error(err)
+	})
+
+	s.Run("default policy", func() {
+		carbon.SetDefault(carbon.Default{
+			NullPolicy: carbon.NullAsEmpty,
+		})
+		defer carbon.SetDefault(carbon.Default{
+			NullPolicy: carbon.NullAsNull,
+		})
+
+		var model1 MySQLNullModel
+
+		// create
+		_, err = db.Insert(&model1)
+		s.Nil(err)
+
+		// read
+		var model2 MySQLNullModel
+		_, err = db.NoAutoCondition().Desc("id").Get(&model2)
+		s.Nil(err)
+
+		data1, err1 := json.Marshal(&model2)
+		s.Nil(err1)
+		s.Equal(`{"date":"","date_time1":null,"date_time2":"","rfc3339_layout":"","iso8601_format":null,"timestamp":0,"expired_at":""}`, string(data1))
+
+		// delete
+		_, err = db.ID(model2.Id).Delete(MySQLNullModel{})
+		s.Nil(err)
+	})
+
+	s.Run("valid carbon", func() {
+		var model1 MySQLNullModel
+
+		c := carbon.Now()
+
+		model1.Date = *carbon.NewNull(carbon.NewDate(c))
+		model1.DateTime1 = *carbon.NewNullType[carbon.DateTime, carbon.AsNull](carbon.NewDateTime(c))
+		model1.DateTime2 = *carbon.NewNullType[carbon.DateTime, carbon.AsEmpty](carbon.NewDateTime(c))
+		model1.RFC3339Layout = *carbon.NewNullType[carbon.LayoutType[RFC3339Layout], carbon.AsEmpty](carbon.NewLayoutType[RFC3339Layout](c))
+		model1.ISO8601Format = *carbon.NewNullType[carbon.FormatType[ISO8601Format], carbon.AsNull](carbon.NewFormatType[ISO8601Format](c))
+		model1.Timestamp = *carbon.NewNullType[carbon.Timestamp, carbon.AsZero](carbon.NewTimestamp(c))
+		model1.ExpiredAt = *carbon.NewNull(carbon.NewDateTime(c))
+
+		// create
+		_, err = db.Insert(&model1)
+		s.Nil(err)
+
+		// read
+		var model2 MySQLNullModel
+		_, err = db.NoAutoCondition().Desc("id").Get(&model2)
+		s.Nil(err)
+
+		data1, err1 := json.Marshal(&model2)
+		s.Nil(err1)
+		s.Equal(`{"date":"2020-08-05","date_time1":"2020-08-05 13:14:15","date_time2":"2020-08-05 13:14:15","rfc3339_layout":"2020-08-05T13:14:15+08:00","iso8601_format":"2020-08-05T13:14:15+08:00","timestamp":1596604455,"expired_at":"2020-08-05 13:14:15"}`, string(data1))
+
+		model2.DateTime2 = *carbon.NewNullType[carbon.DateTime, carbon.AsEmpty](nil)
+		model2.Timestamp = *carbon.NewNullType[carbon.Timestamp, carbon.AsZero](nil)
+
+		// update
+		_, err = db.ID(model2.Id).AllCols().Update(&model2)
+		s.Nil(err)
+
+		var model3 MySQLNullModel
+		_, err = db.NoAutoCondition().ID(model2.Id).Get(&model3)
+		s.Nil(err)
+
+		data2, err2 := json.Marshal(&model3)
+		s.Nil(err2)
+		s.Equal(`{"date":"2020-08-05","date_time1":"2020-08-05 13:14:15","date_time2":"","rfc3339_layout":"2020-08-05T13:14:15+08:00","iso8601_format":"2020-08-05T13:14:15+08:00","timestamp":0,"expired_at":"2020-08-05 13:14:15"}`, string(data2))
+
+		// delete
+		_, err = db.ID(model3.Id).Delete(MySQLNullModel{})
+		s.Nil(err)
+	})
+}
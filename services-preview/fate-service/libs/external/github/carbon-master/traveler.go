@@ -21,6 +21,42 @@ func Now(timezone ...string) *Carbon {
 	return CreateFromStdTime(time.Now().In(loc))
 }
 
+// NowWithClock returns a Carbon instance for now by the clock, which is attached to the Carbon instance,
+// a nil clock means the default clock which follows SetTestNow.
+func NowWithClock(clock Clock, timezone ...string) *Carbon {
+	if clock == nil {
+		return Now(timezone...)
+	}
+	var (
+		loc *Location
+		err error
+	)
+	if loc, err = parseTimezone(timezone...); err != nil {
+		return &Carbon{Error: err}
+	}
+	c := CreateFromStdTime(clock.Now().In(loc))
+	c.clock = clock
+	return c
+}
+
+// TomorrowWithClock returns a Carbon instance for tomorrow by the clock.
+func TomorrowWithClock(clock Clock, timezone ...string) *Carbon {
+	now := NowWithClock(clock, timezone...)
+	if now.IsInvalid() {
+		return now
+	}
+	return now.AddDay()
+}
+
+// YesterdayWithClock returns a Carbon instance for yesterday by the clock.
+func YesterdayWithClock(clock Clock, timezone ...string) *Carbon {
+	now := NowWithClock(clock, timezone...)
+	if now.IsInvalid() {
+		return now
+	}
+	return now.SubDay()
+}
+
 // Tomorrow returns a Carbon instance for tomorrow.
 func Tomorrow(timezone ...string) *Carbon {
 	now := Now(timezone...)
@@ -39,11 +75,14 @@ func Yesterday(timezone ...string) *Carbon {
 	return now.SubDay()
 }
 
-// AddDuration adds duration.
+// AddDuration adds duration like "10h30m" or an ISO 8601 duration like "P1Y2M10DT2H30M".
 func (c *Carbon) AddDuration(duration string) *Carbon {
 	if c.IsInvalid() {
 		return c
 	}
+	if isCalendarDuration(duration) {
+		return c.AddCalendarDuration(ParseCalendarDuration(duration))
+	}
 	var (
 		td  Duration
 		err error
@@ -57,11 +96,14 @@ func (c *Carbon) AddDuration(duration string) *Carbon {
 	return result
 }
 
-// SubDuration subtracts duration.
+// SubDuration subtracts duration like "10h30m" or an ISO 8601 duration like "P1Y2M10DT2H30M".
 func (c *Carbon) SubDuration(duration string) *Carbon {
 	if c.IsInvalid() {
 		return c
 	}
+	if isCalendarDuration(duration) {
+		return c.SubCalendarDuration(ParseCalendarDuration(duration))
+	}
 	var (
 		td  Duration
 		err error
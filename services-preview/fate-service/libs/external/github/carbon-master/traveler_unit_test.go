@@ -51,6 +51,35 @@ func (s *TravelerSuite) TestNow() {
 	})
 }
 
+func (s *TravelerSuite) TestNowWithClock() {
+	clock := NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+
+	s.Run("nil clock", func() {
+		SetTestNow(Parse("2020-08-05"))
+		s.Equal(Now().ToString(), NowWithClock(nil).ToString())
+		s.Equal(Now(PRC).ToString(), NowWithClock(nil, PRC).ToString())
+	})
+
+	s.Run("empty timezone", func() {
+		c := NowWithClock(clock, "")
+		s.True(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("error timezone", func() {
+		c := NowWithClock(clock, "xxx")
+		s.True(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("valid timezone", func() {
+		SetTestNow(Parse("2000-01-01"))
+		s.Equal("2020-08-05 13:14:15 +0000 UTC", NowWithClock(clock).ToString())
+		s.Equal("2020-08-05 21:14:15 +0800 CST", NowWithClock(clock, PRC).ToString())
+		s.Equal(clock, NowWithClock(clock).Clock())
+	})
+}
+
 func (s *TravelerSuite) TestTomorrow() {
 	s.Run("without timezone", func() {
 		c := Tomorrow()
@@ -83,6 +112,35 @@ func (s *TravelerSuite) TestTomorrow() {
 	})
 }
 
+func (s *TravelerSuite) TestTomorrowWithClock() {
+	clock := NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+
+	s.Run("nil clock", func() {
+		SetTestNow(Parse("2020-08-05"))
+		s.Equal(Tomorrow().ToString(), TomorrowWithClock(nil).ToString())
+		s.Equal(Tomorrow(PRC).ToString(), TomorrowWithClock(nil, PRC).ToString())
+	})
+
+	s.Run("empty timezone", func() {
+		c := TomorrowWithClock(clock, "")
+		s.True(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("error timezone", func() {
+		c := TomorrowWithClock(clock, "xxx")
+		s.True(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("valid timezone", func() {
+		SetTestNow(Parse("2000-01-01"))
+		s.Equal("2020-08-06 13:14:15 +0000 UTC", TomorrowWithClock(clock).ToString())
+		s.Equal("2020-08-06 21:14:15 +0800 CST", TomorrowWithClock(clock, PRC).ToString())
+		s.Equal(clock, TomorrowWithClock(clock).Clock())
+	})
+}
+
 func (s *TravelerSuite) TestYesterday() {
 	s.Run("without timezone", func() {
 		c := Yesterday()
@@ -115,6 +173,35 @@ func (s *TravelerSuite) TestYesterday() {
 	})
 }
 
+func (s *TravelerSuite) TestYesterdayWithClock() {
+	clock := NewFixedClock(time.Date(2020, 8, 5, 13, 14, 15, 0, time.UTC))
+
+	s.Run("nil clock", func() {
+		SetTestNow(Parse("2020-08-05"))
+		s.Equal(Yesterday().ToString(), YesterdayWithClock(nil).ToString())
+		s.Equal(Yesterday(PRC).ToString(), YesterdayWithClock(nil, PRC).ToString())
+	})
+
+	s.Run("empty timezone", func() {
+		c := YesterdayWithClock(clock, "")
+		s.True(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("error timezone", func() {
+		c := YesterdayWithClock(clock, "xxx")
+		s.True(c.HasError())
+		s.Empty(c.ToString())
+	})
+
+	s.Run("valid timezone", func() {
+		SetTestNow(Parse("2000-01-01"))
+		s.Equal("2020-08-04 13:14:15 +0000 UTC", YesterdayWithClock(clock).ToString())
+		s.Equal("2020-08-04 21:14:15 +0800 CST", YesterdayWithClock(clock, PRC).ToString())
+		s.Equal(clock, YesterdayWithClock(clock).Clock())
+	})
+}
+
 func (s *TravelerSuite) TestCarbon_AddDuration() {
 	s.Run("nil carbon", func() {
 		var c *Carbon
@@ -161,6 +248,14 @@ func (s *TravelerSuite) TestCarbon_AddDuration() {
 		s.Equal("2020-01-01 13:24:15 +0000 UTC", c.Copy().AddDuration("10m").ToString())
 		s.Equal("2020-01-01 13:24:45 +0000 UTC", c.Copy().AddDuration("10.5m").ToString())
 	})
+
+	s.Run("iso 8601 duration", func() {
+		c := Parse("2020-01-31 13:14:15")
+		s.Equal("2021-04-10 15:44:15 +0000 UTC", c.AddDuration("P1Y2M10DT2H30M").ToString())
+		s.Equal("2020-01-30 13:14:15 +0000 UTC", c.AddDuration("-P1D").ToString())
+		s.Equal("2020-02-14 13:14:15 +0000 UTC", c.AddDuration("p2w").ToString())
+		s.True(c.AddDuration("P1X").HasError())
+	})
 }
 
 func (s *TravelerSuite) TestCarbon_SubDuration() {
@@ -202,6 +297,14 @@ func (s *TravelerSuite) TestCarbon_SubDuration() {
 		s.Equal("2020-01-01 13:04:15 +0000 UTC", Parse("2020-01-01 13:14:15").SubDuration("10m").ToString())
 		s.Equal("2020-01-01 13:03:45 +0000 UTC", Parse("2020-01-01 13:14:15").SubDuration("10.5m").ToString())
 	})
+
+	s.Run("iso 8601 duration", func() {
+		c := Parse("2020-03-31 13:14:15")
+		s.Equal("2020-03-02 13:14:15 +0000 UTC", c.SubDuration("P1M").ToString())
+		s.Equal("2019-01-21 10:44:15 +0000 UTC", c.SubDuration("P1Y2M10DT2H30M").ToString())
+		s.Equal("2020-04-01 13:14:15 +0000 UTC", c.SubDuration("-P1D").ToString())
+		s.True(c.SubDuration("PT").HasError())
+	})
 }
 
 func (s *TravelerSuite) TestCarbon_AddCenturies() {
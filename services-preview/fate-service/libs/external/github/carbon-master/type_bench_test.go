@@ -509,3 +509,377 @@ func BenchmarkCustomerType_String(b *testing.B) {
 		})
 	})
 }
+
+func BenchmarkCarbonType_MarshalText(b *testing.B) {
+	c := Now()
+	b.ResetTimer()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N/10; i++ {
+			_, _ = c.MarshalText()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _ = c.MarshalText()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, _ = c.MarshalText()
+			}
+		})
+	})
+}
+
+func BenchmarkCarbonType_UnmarshalText(b *testing.B) {
+	c := Now()
+	value := []byte("2020-08-05 13:14:15")
+	b.ResetTimer()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N/10; i++ {
+			_ = c.Copy().UnmarshalText(value)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = c.Copy().UnmarshalText(value)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = c.Copy().UnmarshalText(value)
+			}
+		})
+	})
+}
+
+func BenchmarkCarbonType_MarshalBinary(b *testing.B) {
+	c := Now()
+	b.ResetTimer()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N/10; i++ {
+			_, _ = c.MarshalBinary()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _ = c.MarshalBinary()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, _ = c.MarshalBinary()
+			}
+		})
+	})
+}
+
+func BenchmarkCarbonType_UnmarshalBinary(b *testing.B) {
+	c := Now()
+	value, _ := c.MarshalBinary()
+	b.ResetTimer()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N/10; i++ {
+			_ = c.Copy().UnmarshalBinary(value)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = c.Copy().UnmarshalBinary(value)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = c.Copy().UnmarshalBinary(value)
+			}
+		})
+	})
+}
+
+func BenchmarkCarbonType_MarshalBSONValue(b *testing.B) {
+	c := Now()
+	b.ResetTimer()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N/10; i++ {
+			_, _, _ = c.MarshalBSONValue()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _, _ = c.MarshalBSONValue()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, _, _ = c.MarshalBSONValue()
+			}
+		})
+	})
+}
+
+func BenchmarkCarbonType_MarshalYAML(b *testing.B) {
+	c := Now()
+	b.ResetTimer()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N/10; i++ {
+			_, _ = c.MarshalYAML()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _ = c.MarshalYAML()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, _ = c.MarshalYAML()
+			}
+		})
+	})
+}
+
+func BenchmarkCreateFromProtoTimestamp(b *testing.B) {
+	ts := &protoMessage{Seconds: 1596604455, Nanos: 999999999}
+	b.ResetTimer()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N/10; i++ {
+			CreateFromProtoTimestamp(ts)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				CreateFromProtoTimestamp(ts)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				CreateFromProtoTimestamp(ts)
+			}
+		})
+	})
+}
+
+func BenchmarkCarbonType_ToProtoTimestamp(b *testing.B) {
+	c := Now()
+	b.ResetTimer()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N/10; i++ {
+			c.ToProtoTimestamp()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.ToProtoTimestamp()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				c.ToProtoTimestamp()
+			}
+		})
+	})
+}
+
+func BenchmarkNullType_Scan(b *testing.B) {
+	value := "2020-08-05 13:14:15"
+	b.ResetTimer()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N/10; i++ {
+			_ = NewNull[DateTime](nil).Scan(value)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = NewNull[DateTime](nil).Scan(value)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = NewNull[DateTime](nil).Scan(value)
+			}
+		})
+	})
+}
+
+func BenchmarkNullType_Value(b *testing.B) {
+	n := NewNullType[DateTime, AsEmpty](NewDateTime(Now()))
+	b.ResetTimer()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N/10; i++ {
+			_, _ = n.Value()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _ = n.Value()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, _ = n.Value()
+			}
+		})
+	})
+}
+
+func BenchmarkNullType_MarshalJSON(b *testing.B) {
+	n := NewNullType[DateTime, AsEmpty](NewDateTime(Now()))
+	b.ResetTimer()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N/10; i++ {
+			_, _ = n.MarshalJSON()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _ = n.MarshalJSON()
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, _ = n.MarshalJSON()
+			}
+		})
+	})
+}
+
+func BenchmarkNullType_UnmarshalJSON(b *testing.B) {
+	value := []byte(`"2020-08-05 13:14:15"`)
+	b.ResetTimer()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N/10; i++ {
+			_ = NewNull[DateTime](nil).UnmarshalJSON(value)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		var wg sync.WaitGroup
+		for i := 0; i < b.N/10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = NewNull[DateTime](nil).UnmarshalJSON(value)
+			}()
+		}
+		wg.Wait()
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = NewNull[DateTime](nil).UnmarshalJSON(value)
+			}
+		})
+	})
+}
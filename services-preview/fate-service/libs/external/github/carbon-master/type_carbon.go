@@ -62,6 +62,85 @@ func (c *Carbon) UnmarshalJSON(src []byte) error {
 	return c.Error
 }
 
+// MarshalText implements "encoding.TextMarshaler" interface for Carbon struct,
+// which is also used by TOML encoders.
+func (c Carbon) MarshalText() ([]byte, error) {
+	if c.IsNil() || c.IsZero() || c.IsEmpty() {
+		return []byte{}, nil
+	}
+	if c.HasError() {
+		return nil, c.Error
+	}
+	return []byte(c.Layout(DefaultLayout)), nil
+}
+
+// UnmarshalText implements "encoding.TextUnmarshaler" interface for Carbon struct.
+func (c *Carbon) UnmarshalText(src []byte) error {
+	v := string(src)
+	if v == "" {
+		c.isEmpty = true
+		return nil
+	}
+	*c = *ParseByLayout(v, DefaultLayout)
+	return c.Error
+}
+
+// MarshalBinary implements "encoding.BinaryMarshaler" interface for Carbon struct,
+// which is also used by "encoding/gob" and keeps the nanosecond and timezone.
+func (c Carbon) MarshalBinary() ([]byte, error) {
+	return marshalBinary(&c)
+}
+
+// UnmarshalBinary implements "encoding.BinaryUnmarshaler" interface for Carbon struct.
+func (c *Carbon) UnmarshalBinary(src []byte) error {
+	*c = *unmarshalBinary(src)
+	return c.Error
+}
+
+// MarshalBSONValue implements "bson.ValueMarshaler" interface of mongo-driver v2 for Carbon struct,
+// it's marshaled as a bson UTC datetime with millisecond precision.
+func (c Carbon) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONDateTime(&c)
+}
+
+// UnmarshalBSONValue implements "bson.ValueUnmarshaler" interface of mongo-driver v2 for Carbon struct,
+// a bson UTC datetime, string or null is supported.
+func (c *Carbon) UnmarshalBSONValue(typ byte, src []byte) error {
+	switch typ {
+	case bsonTypeNull:
+		c.isEmpty = true
+		return nil
+	case bsonTypeDateTime:
+		*c = *parseBSONDateTime(src)
+		return c.Error
+	case bsonTypeString:
+		if v, ok := parseBSONString(src); ok {
+			return c.UnmarshalText([]byte(v))
+		}
+	}
+	return ErrFailedUnmarshalBSON(typ, src)
+}
+
+// MarshalYAML implements "yaml.Marshaler" interface for Carbon struct.
+func (c Carbon) MarshalYAML() (any, error) {
+	if c.IsNil() || c.IsZero() || c.IsEmpty() {
+		return nil, nil
+	}
+	if c.HasError() {
+		return nil, c.Error
+	}
+	return c.Layout(DefaultLayout), nil
+}
+
+// UnmarshalYAML implements "yaml.Unmarshaler" interface of yaml.v2 for Carbon struct, which is also supported by yaml.v3.
+func (c *Carbon) UnmarshalYAML(unmarshal func(any) error) error {
+	var v string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(v))
+}
+
 // String implements "Stringer" interface for Carbon struct.
 func (c *Carbon) String() string {
 	if c.IsInvalid() {
@@ -0,0 +1,91 @@
+package carbon
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// bson element types supported by MarshalBSONValue and UnmarshalBSONValue
+const (
+	bsonTypeString   byte = 0x02
+	bsonTypeDateTime byte = 0x09
+	bsonTypeNull     byte = 0x0A
+)
+
+// marshals a Carbon instance as binary, which is the binary of the standard time prefixed by its length
+// and followed by the timezone name, so the timezone survives the round trip unlike the standard time.
+func marshalBinary(c *Carbon) ([]byte, error) {
+	if c.IsNil() || c.IsZero() || c.IsEmpty() {
+		return []byte{}, nil
+	}
+	if c.HasError() {
+		return nil, c.Error
+	}
+	tb, err := c.StdTime().MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	tz := c.Timezone()
+	b := make([]byte, 0, len(tb)+len(tz)+1)
+	b = append(b, byte(len(tb)))
+	b = append(b, tb...)
+	b = append(b, tz...)
+	return b, nil
+}
+
+// unmarshals a binary marshaled by marshalBinary as a Carbon instance, the fixed zone offset of the binary
+// is kept if the timezone name is unknown on this machine.
+func unmarshalBinary(data []byte) *Carbon {
+	if len(data) == 0 {
+		return &Carbon{isEmpty: true}
+	}
+	n := int(data[0])
+	if len(data) < n+1 {
+		return &Carbon{Error: ErrFailedUnmarshalBinary(data)}
+	}
+	var tt StdTime
+	if err := tt.UnmarshalBinary(data[1 : n+1]); err != nil {
+		return &Carbon{Error: ErrFailedUnmarshalBinary(data)}
+	}
+	if tz := string(data[n+1:]); tz != "" {
+		if loc, err := parseTimezone(tz); err == nil {
+			tt = tt.In(loc)
+		}
+	}
+	return NewCarbon(tt)
+}
+
+// marshals a Carbon instance as a bson UTC datetime which is the little-endian milliseconds since the unix epoch,
+// the nil, zero and empty Carbon instances are marshaled as a bson null.
+func marshalBSONDateTime(c *Carbon) (byte, []byte, error) {
+	if c.IsNil() || c.IsZero() || c.IsEmpty() {
+		return bsonTypeNull, nil, nil
+	}
+	if c.HasError() {
+		return 0, nil, c.Error
+	}
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(c.TimestampMilli()))
+	return bsonTypeDateTime, b, nil
+}
+
+// parses a bson UTC datetime as a Carbon instance in the default timezone.
+func parseBSONDateTime(data []byte) *Carbon {
+	if len(data) != 8 {
+		return &Carbon{Error: ErrFailedUnmarshalBSON(bsonTypeDateTime, data)}
+	}
+	ms := int64(binary.LittleEndian.Uint64(data))
+	return CreateFromStdTime(time.UnixMilli(ms), DefaultTimezone)
+}
+
+// parses a bson string which is an int32 length including the trailing NUL, the bytes and a NUL.
+func parseBSONString(data []byte) (string, bool) {
+	if len(data) < 5 {
+		return "", false
+	}
+	n := int(int32(binary.LittleEndian.Uint32(data)))
+	if n < 1 || len(data) != n+4 || data[len(data)-1] != 0 {
+		return "", false
+	}
+	return string(data[4 : len(data)-1]), true
+}
@@ -0,0 +1,52 @@
+package carbon
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// Scan implements "driver.Scanner" interface for CalendarDuration struct.
+func (d *CalendarDuration) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		*d = *ParseCalendarDuration(string(v))
+	case string:
+		*d = *ParseCalendarDuration(v)
+	default:
+		return ErrFailedScan(v)
+	}
+	return d.Error
+}
+
+// Value implements "driver.Valuer" interface for CalendarDuration struct.
+func (d CalendarDuration) Value() (driver.Value, error) {
+	if d.Error != nil {
+		return nil, d.Error
+	}
+	return d.String(), nil
+}
+
+// MarshalJSON implements "json.Marshaler" interface for CalendarDuration struct.
+func (d CalendarDuration) MarshalJSON() ([]byte, error) {
+	if d.Error != nil {
+		return []byte(`null`), d.Error
+	}
+	v := d.String()
+	b := make([]byte, 0, len(v)+2)
+	b = append(b, '"')
+	b = append(b, v...)
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSON implements "json.Unmarshaler" interface for CalendarDuration struct.
+func (d *CalendarDuration) UnmarshalJSON(src []byte) error {
+	v := string(bytes.Trim(src, `"`))
+	if v == "" || v == "null" {
+		return nil
+	}
+	*d = *ParseCalendarDuration(v)
+	return d.Error
+}
@@ -1,8 +1,14 @@
 package carbon_test
 
 import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
 	"encoding/json"
 	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
 
 	"github.com/dromara/carbon/v2"
 )
@@ -448,3 +454,238 @@ func ExampleTimestampNano_Int64() {
 	// Output:
 	// 1596633255999999999
 }
+
+func ExampleCarbon_MarshalText() {
+	data, _ := carbon.Parse("2020-08-05 13:14:15.999999999").MarshalText()
+	fmt.Printf("%s", data)
+
+	// Output:
+	// 2020-08-05 13:14:15
+}
+
+func ExampleCarbon_UnmarshalText() {
+	c := new(carbon.Carbon)
+	_ = c.UnmarshalText([]byte("2020-08-05 13:14:15"))
+	fmt.Println(c.String())
+
+	// Output:
+	// 2020-08-05 13:14:15
+}
+
+func ExampleCarbon_MarshalBinary() {
+	type User struct {
+		Birthday *carbon.Carbon
+	}
+
+	user := User{
+		Birthday: carbon.Parse("2020-08-05 13:14:15.999999999", carbon.NewYork),
+	}
+
+	var buf bytes.Buffer
+	_ = gob.NewEncoder(&buf).Encode(&user)
+
+	var decoded User
+	_ = gob.NewDecoder(&buf).Decode(&decoded)
+	fmt.Println(decoded.Birthday.ToString())
+	fmt.Println(decoded.Birthday.Timezone())
+
+	// Output:
+	// 2020-08-05 13:14:15.999999999 -0400 EDT
+	// America/New_York
+}
+
+func ExampleCarbon_MarshalBSONValue() {
+	typ, data, _ := carbon.Parse("2020-08-05 13:14:15.999999999").MarshalBSONValue()
+	fmt.Printf("type: 0x%02X\n", typ)
+	fmt.Printf("data: %d\n", binary.LittleEndian.Uint64(data))
+
+	// Output:
+	// type: 0x09
+	// data: 1596633255999
+}
+
+func ExampleCarbon_UnmarshalBSONValue() {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, 1596633255999)
+
+	c := new(carbon.Carbon)
+	_ = c.UnmarshalBSONValue(0x09, data)
+	fmt.Println(c.ToString())
+
+	// Output:
+	// 2020-08-05 13:14:15.999 +0000 UTC
+}
+
+func ExampleCarbon_MarshalYAML() {
+	type User struct {
+		Date      carbon.Date       `yaml:"date"`
+		Timestamp *carbon.Timestamp `yaml:"timestamp"`
+		DeletedAt *carbon.DateTime  `yaml:"deleted_at"`
+	}
+
+	c := carbon.Parse("2020-08-05 13:14:15.999999999")
+	user := User{
+		Date:      *carbon.NewDate(c),
+		Timestamp: carbon.NewTimestamp(c),
+	}
+
+	data, _ := yaml.Marshal(&user)
+	fmt.Printf("%s", data)
+
+	// Output:
+	// date: "2020-08-05"
+	// timestamp: 1596633255
+	// deleted_at: null
+}
+
+func ExampleCarbon_UnmarshalYAML() {
+	type User struct {
+		Date      carbon.Date       `yaml:"date"`
+		Timestamp *carbon.Timestamp `yaml:"timestamp"`
+	}
+
+	var user User
+	_ = yaml.Unmarshal([]byte("date: 2020-08-05\ntimestamp: 1596633255"), &user)
+	fmt.Println(user.Date.String())
+	fmt.Println(user.Timestamp.ToDateTimeString())
+
+	// Output:
+	// 2020-08-05
+	// 2020-08-05 13:14:15
+}
+
+// protoTimestamp mocks *timestamppb.Timestamp and *durationpb.Duration.
+type protoTimestamp struct {
+	Seconds int64
+	Nanos   int32
+}
+
+func (t *protoTimestamp) GetSeconds() int64 {
+	return t.Seconds
+}
+
+func (t *protoTimestamp) GetNanos() int32 {
+	return t.Nanos
+}
+
+func ExampleCreateFromProtoTimestamp() {
+	fmt.Println(carbon.CreateFromProtoTimestamp(&protoTimestamp{Seconds: 1596633255, Nanos: 999999999}).ToString())
+	fmt.Println(carbon.CreateFromProtoTimestamp(&protoTimestamp{Seconds: 1596633255, Nanos: 999999999}, carbon.PRC).ToString())
+
+	// Output:
+	// 2020-08-05 13:14:15.999999999 +0000 UTC
+	// 2020-08-05 21:14:15.999999999 +0800 CST
+}
+
+func ExampleCarbon_ToProtoTimestamp() {
+	seconds, nanos := carbon.Parse("2020-08-05 13:14:15.999999999").ToProtoTimestamp()
+	fmt.Println(seconds, nanos)
+
+	// Output:
+	// 1596633255 999999999
+}
+
+func ExampleCreateDurationFromProto() {
+	d, _ := carbon.CreateDurationFromProto(&protoTimestamp{Seconds: 90, Nanos: 500000000})
+	fmt.Println(d)
+
+	// Output:
+	// 1m30.5s
+}
+
+func ExampleToProtoDuration() {
+	seconds, nanos := carbon.ToProtoDuration(-90*time.Second - 500*time.Millisecond)
+	fmt.Println(seconds, nanos)
+
+	// Output:
+	// -90 -500000000
+}
+
+func ExampleNull_MarshalJSON() {
+	type User struct {
+		Birthday  carbon.Null[carbon.Date]      `json:"birthday"`
+		CreatedAt *carbon.Null[carbon.DateTime] `json:"created_at"`
+	}
+
+	user := User{
+		CreatedAt: carbon.NewNull(carbon.NewDateTime(carbon.Parse("2020-08-05 13:14:15"))),
+	}
+
+	data, _ := json.Marshal(&user)
+	fmt.Printf("%s\n", data)
+
+	carbon.SetDefault(carbon.Default{
+		NullPolicy: carbon.NullAsEmpty,
+	})
+	defer carbon.ResetDefault()
+
+	data, _ = json.Marshal(&user)
+	fmt.Printf("%s\n", data)
+
+	// Output:
+	// {"birthday":null,"created_at":"2020-08-05 13:14:15"}
+	// {"birthday":"","created_at":"2020-08-05 13:14:15"}
+}
+
+func ExampleNullType_MarshalJSON() {
+	type User struct {
+		Birthday  carbon.NullType[carbon.Date, carbon.AsEmpty]     `json:"birthday"`
+		LoginAt   carbon.NullType[carbon.Timestamp, carbon.AsZero] `json:"login_at"`
+		DeletedAt carbon.NullType[carbon.DateTime, carbon.AsNull]  `json:"deleted_at"`
+	}
+
+	var user User
+
+	data, _ := json.Marshal(&user)
+	fmt.Printf("%s", data)
+
+	// Output:
+	// {"birthday":"","login_at":0,"deleted_at":null}
+}
+
+func ExampleNullType_UnmarshalJSON() {
+	type User struct {
+		Birthday carbon.NullType[carbon.Date, carbon.AsEmpty]     `json:"birthday"`
+		LoginAt  carbon.NullType[carbon.Timestamp, carbon.AsZero] `json:"login_at"`
+	}
+
+	var user User
+
+	value := `{"birthday":"0000-00-00","login_at":1596633255}`
+	_ = json.Unmarshal([]byte(value), &user)
+
+	fmt.Println("user.Birthday:", user.Birthday.IsNull())
+	fmt.Println("user.LoginAt:", user.LoginAt.String())
+
+	// Output:
+	// user.Birthday: true
+	// user.LoginAt: 1596633255
+}
+
+func ExampleNullType_Value() {
+	v1, _ := carbon.NewNullType[carbon.Date, carbon.AsNull](nil).Value()
+	v2, _ := carbon.NewNullType[carbon.Date, carbon.AsEmpty](nil).Value()
+	v3, _ := carbon.NewNullType[carbon.Date, carbon.AsZero](nil).Value()
+
+	fmt.Printf("%#v\n", v1)
+	fmt.Printf("%#v\n", v2)
+	fmt.Printf("%#v\n", v3)
+
+	// Output:
+	// <nil>
+	// ""
+	// 0
+}
+
+func ExampleNullType_IsZero() {
+	type User struct {
+		Name      string                                          `yaml:"name"`
+		DeletedAt carbon.NullType[carbon.DateTime, carbon.AsOmit] `yaml:"deleted_at,omitempty"`
+	}
+
+	data, _ := yaml.Marshal(&User{Name: "carbon"})
+	fmt.Printf("%s", data)
+
+	// Output:
+	// name: carbon
+}
@@ -75,6 +75,86 @@ func (t *FormatType[T]) UnmarshalJSON(src []byte) error {
 	return t.Error
 }
 
+// MarshalText implements "encoding.TextMarshaler" interface for FormatType generic struct,
+// which is also used by TOML encoders.
+func (t FormatType[T]) MarshalText() ([]byte, error) {
+	if t.IsNil() || t.IsZero() || t.IsEmpty() {
+		return []byte{}, nil
+	}
+	if t.HasError() {
+		return nil, t.Error
+	}
+	return []byte(t.Format(t.getFormat())), nil
+}
+
+// UnmarshalText implements "encoding.TextUnmarshaler" interface for FormatType generic struct.
+func (t *FormatType[T]) UnmarshalText(src []byte) error {
+	v := string(src)
+	if v == "" {
+		return nil
+	}
+	*t = *NewFormatType[T](ParseByFormat(v, t.getFormat()))
+	return t.Error
+}
+
+// MarshalBinary implements "encoding.BinaryMarshaler" interface for FormatType generic struct,
+// which is also used by "encoding/gob" and keeps the nanosecond and timezone.
+func (t FormatType[T]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(t.Carbon)
+}
+
+// UnmarshalBinary implements "encoding.BinaryUnmarshaler" interface for FormatType generic struct.
+func (t *FormatType[T]) UnmarshalBinary(src []byte) error {
+	if len(src) == 0 {
+		return nil
+	}
+	*t = *NewFormatType[T](unmarshalBinary(src))
+	return t.Error
+}
+
+// MarshalBSONValue implements "bson.ValueMarshaler" interface of mongo-driver v2 for FormatType generic struct,
+// it's marshaled as a bson UTC datetime with millisecond precision.
+func (t FormatType[T]) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONDateTime(t.Carbon)
+}
+
+// UnmarshalBSONValue implements "bson.ValueUnmarshaler" interface of mongo-driver v2 for FormatType generic struct,
+// a bson UTC datetime, string or null is supported.
+func (t *FormatType[T]) UnmarshalBSONValue(typ byte, src []byte) error {
+	switch typ {
+	case bsonTypeNull:
+		return nil
+	case bsonTypeDateTime:
+		*t = *NewFormatType[T](parseBSONDateTime(src))
+		return t.Error
+	case bsonTypeString:
+		if v, ok := parseBSONString(src); ok {
+			return t.UnmarshalText([]byte(v))
+		}
+	}
+	return ErrFailedUnmarshalBSON(typ, src)
+}
+
+// MarshalYAML implements "yaml.Marshaler" interface for FormatType generic struct.
+func (t FormatType[T]) MarshalYAML() (any, error) {
+	if t.IsNil() || t.IsZero() || t.IsEmpty() {
+		return nil, nil
+	}
+	if t.HasError() {
+		return nil, t.Error
+	}
+	return t.Format(t.getFormat()), nil
+}
+
+// UnmarshalYAML implements "yaml.Unmarshaler" interface of yaml.v2 for FormatType generic struct, which is also supported by yaml.v3.
+func (t *FormatType[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var v string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(v))
+}
+
 // String implements "Stringer" interface for FormatType generic struct.
 func (t *FormatType[T]) String() string {
 	if t == nil || t.IsInvalid() {
@@ -1,5 +1,9 @@
 package carbon
 
+import (
+	"database/sql/driver"
+)
+
 // LayoutTyper defines a LayoutTyper interface
 type LayoutTyper interface {
 	~string
@@ -17,3 +21,18 @@ type TimestampTyper interface {
 	~int64
 	Precision() string
 }
+
+// NullPolicyTyper defines a NullPolicyTyper interface.
+type NullPolicyTyper interface {
+	~string
+	NullPolicy() NullPolicy
+}
+
+// Nullable defines a Nullable interface which is implemented by LayoutType, FormatType and TimestampType generic structs.
+type Nullable interface {
+	IsValid() bool
+	IsZero() bool
+	HasError() bool
+	Value() (driver.Value, error)
+	MarshalJSON() ([]byte, error)
+}
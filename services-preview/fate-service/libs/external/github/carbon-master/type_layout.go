@@ -75,6 +75,86 @@ func (t *LayoutType[T]) UnmarshalJSON(src []byte) error {
 	return t.Error
 }
 
+// MarshalText implements "encoding.TextMarshaler" interface for LayoutType generic struct,
+// which is also used by TOML encoders.
+func (t LayoutType[T]) MarshalText() ([]byte, error) {
+	if t.IsNil() || t.IsZero() || t.IsEmpty() {
+		return []byte{}, nil
+	}
+	if t.HasError() {
+		return nil, t.Error
+	}
+	return []byte(t.Layout(t.getLayout())), nil
+}
+
+// UnmarshalText implements "encoding.TextUnmarshaler" interface for LayoutType generic struct.
+func (t *LayoutType[T]) UnmarshalText(src []byte) error {
+	v := string(src)
+	if v == "" {
+		return nil
+	}
+	*t = *NewLayoutType[T](ParseByLayout(v, t.getLayout()))
+	return t.Error
+}
+
+// MarshalBinary implements "encoding.BinaryMarshaler" interface for LayoutType generic struct,
+// which is also used by "encoding/gob" and keeps the nanosecond and timezone.
+func (t LayoutType[T]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(t.Carbon)
+}
+
+// UnmarshalBinary implements "encoding.BinaryUnmarshaler" interface for LayoutType generic struct.
+func (t *LayoutType[T]) UnmarshalBinary(src []byte) error {
+	if len(src) == 0 {
+		return nil
+	}
+	*t = *NewLayoutType[T](unmarshalBinary(src))
+	return t.Error
+}
+
+// MarshalBSONValue implements "bson.ValueMarshaler" interface of mongo-driver v2 for LayoutType generic struct,
+// it's marshaled as a bson UTC datetime with millisecond precision.
+func (t LayoutType[T]) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONDateTime(t.Carbon)
+}
+
+// UnmarshalBSONValue implements "bson.ValueUnmarshaler" interface of mongo-driver v2 for LayoutType generic struct,
+// a bson UTC datetime, string or null is supported.
+func (t *LayoutType[T]) UnmarshalBSONValue(typ byte, src []byte) error {
+	switch typ {
+	case bsonTypeNull:
+		return nil
+	case bsonTypeDateTime:
+		*t = *NewLayoutType[T](parseBSONDateTime(src))
+		return t.Error
+	case bsonTypeString:
+		if v, ok := parseBSONString(src); ok {
+			return t.UnmarshalText([]byte(v))
+		}
+	}
+	return ErrFailedUnmarshalBSON(typ, src)
+}
+
+// MarshalYAML implements "yaml.Marshaler" interface for LayoutType generic struct.
+func (t LayoutType[T]) MarshalYAML() (any, error) {
+	if t.IsNil() || t.IsZero() || t.IsEmpty() {
+		return nil, nil
+	}
+	if t.HasError() {
+		return nil, t.Error
+	}
+	return t.Layout(t.getLayout()), nil
+}
+
+// UnmarshalYAML implements "yaml.Unmarshaler" interface of yaml.v2 for LayoutType generic struct, which is also supported by yaml.v3.
+func (t *LayoutType[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var v string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(v))
+}
+
 // String implements "Stringer" interface for LayoutType generic struct.
 func (t *LayoutType[T]) String() string {
 	if t == nil || t.IsInvalid() {
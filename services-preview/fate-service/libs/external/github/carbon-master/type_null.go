@@ -0,0 +1,207 @@
+package carbon
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NullPolicy defines a NullPolicy type which decides how nil, zero and empty values of Null generic struct are emitted.
+type NullPolicy string
+
+// null policy constants
+const (
+	NullAsNull  NullPolicy = "null"  // emits JSON null and SQL NULL
+	NullAsEmpty NullPolicy = "empty" // emits JSON "" and SQL ""
+	NullAsZero  NullPolicy = "zero"  // emits JSON 0 and SQL 0
+	NullAsOmit  NullPolicy = "omit"  // omits the field with the "omitempty" yaml option, emits JSON null and SQL NULL
+)
+
+// built-in null policy typers, like NullType[DateTime, AsEmpty]
+type (
+	AsNull  string
+	AsEmpty string
+	AsZero  string
+	AsOmit  string
+
+	defaultNullPolicy string
+)
+
+func (AsNull) NullPolicy() NullPolicy {
+	return NullAsNull
+}
+func (AsEmpty) NullPolicy() NullPolicy {
+	return NullAsEmpty
+}
+func (AsZero) NullPolicy() NullPolicy {
+	return NullAsZero
+}
+func (AsOmit) NullPolicy() NullPolicy {
+	return NullAsOmit
+}
+func (defaultNullPolicy) NullPolicy() NullPolicy {
+	return DefaultNullPolicy
+}
+
+// NullType defines a NullType generic struct which wraps a LayoutType, FormatType or TimestampType generic struct,
+// its nil, zero and empty values are emitted by the null policy of P instead of null,
+// note that xorm uses the "" and 0 emitted by a zero bean as auto conditions, so use NoAutoCondition to get into it.
+type NullType[T Nullable, P NullPolicyTyper] struct {
+	V T
+}
+
+// NewNullType returns a new NullType generic instance, a nil value gives a null instance.
+func NewNullType[T Nullable, P NullPolicyTyper](v *T) *NullType[T, P] {
+	n := new(NullType[T, P])
+	if v != nil {
+		n.V = *v
+	}
+	return n
+}
+
+// Null defines a Null generic struct whose nil, zero and empty values are emitted by DefaultNullPolicy.
+type Null[T Nullable] struct {
+	NullType[T, defaultNullPolicy]
+}
+
+// NewNull returns a new Null generic instance, a nil value gives a null instance.
+func NewNull[T Nullable](v *T) *Null[T] {
+	return &Null[T]{
+		NullType: *NewNullType[T, defaultNullPolicy](v),
+	}
+}
+
+// IsNull reports whether the value is nil, zero or empty.
+func (n NullType[T, P]) IsNull() bool {
+	if n.V.HasError() {
+		return false
+	}
+	return !n.V.IsValid() || n.V.IsZero()
+}
+
+// IsZero reports whether the field should be omitted, it's used by the "omitempty" yaml option
+// and only true for null values with NullAsOmit policy, the "omitzero" json option uses it too since go 1.24.
+func (n NullType[T, P]) IsZero() bool {
+	return n.IsNull() && n.getPolicy() == NullAsOmit
+}
+
+// Scan implements "driver.Scanner" interface for NullType generic struct,
+// NULL, "", "0", 0 and zero dates like "0000-00-00 00:00:00" are scanned as null,
+// and integers are scanned as timestamps if it wraps a TimestampType generic struct.
+func (n *NullType[T, P]) Scan(src any) error {
+	var (
+		v  string
+		ok bool
+	)
+	switch s := src.(type) {
+	case nil:
+		n.reset()
+		return nil
+	case []byte:
+		v, ok = string(s), true
+	case string:
+		v, ok = s, true
+	case int64:
+		v, ok = strconv.FormatInt(s, 10), true
+	}
+	if ok && isNullValue(v) {
+		n.reset()
+		return nil
+	}
+	if t, isTimestamp := any(&n.V).(timestampScanner); ok && isTimestamp {
+		if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return t.scanTimestamp(v)
+		}
+	}
+	if s, isScanner := any(&n.V).(sql.Scanner); isScanner {
+		return s.Scan(src)
+	}
+	return ErrFailedScan(src)
+}
+
+// Value implements "driver.Valuer" interface for NullType generic struct.
+func (n NullType[T, P]) Value() (driver.Value, error) {
+	if !n.IsNull() {
+		return n.V.Value()
+	}
+	switch n.getPolicy() {
+	case NullAsEmpty:
+		return "", nil
+	case NullAsZero:
+		return int64(0), nil
+	}
+	return nil, nil
+}
+
+// MarshalJSON implements "json.Marshaler" interface for NullType generic struct.
+func (n NullType[T, P]) MarshalJSON() ([]byte, error) {
+	if !n.IsNull() {
+		return n.V.MarshalJSON()
+	}
+	switch n.getPolicy() {
+	case NullAsEmpty:
+		return []byte(`""`), nil
+	case NullAsZero:
+		return []byte(`0`), nil
+	}
+	return []byte(`null`), nil
+}
+
+// UnmarshalJSON implements "json.Unmarshaler" interface for NullType generic struct,
+// null, "", "0", 0 and zero dates like "0000-00-00 00:00:00" are unmarshaled as null.
+func (n *NullType[T, P]) UnmarshalJSON(src []byte) error {
+	v := string(bytes.Trim(src, `"`))
+	if isNullValue(v) || v == "null" {
+		n.reset()
+		return nil
+	}
+	if u, ok := any(&n.V).(json.Unmarshaler); ok {
+		return u.UnmarshalJSON(src)
+	}
+	return ErrFailedParse(v)
+}
+
+// String implements "Stringer" interface for NullType generic struct, it's empty for null values.
+func (n *NullType[T, P]) String() string {
+	if n == nil || n.IsNull() {
+		return ""
+	}
+	if s, ok := any(&n.V).(fmt.Stringer); ok {
+		return s.String()
+	}
+	return ""
+}
+
+// String implements "Stringer" interface for Null generic struct, it's empty for null values.
+func (n *Null[T]) String() string {
+	if n == nil {
+		return ""
+	}
+	return n.NullType.String()
+}
+
+// getPolicy returns the null policy of NullType generic struct.
+func (n NullType[T, P]) getPolicy() NullPolicy {
+	var typer P
+	return typer.NullPolicy()
+}
+
+// reset resets NullType generic struct to null.
+func (n *NullType[T, P]) reset() {
+	var v T
+	n.V = v
+}
+
+// timestampScanner is implemented by TimestampType generic struct to scan integers as timestamps.
+type timestampScanner interface {
+	scanTimestamp(v string) error
+}
+
+// reports whether a scanned or unmarshaled value denotes null.
+func isNullValue(v string) bool {
+	return v == "" || v == "0" || strings.HasPrefix(v, "0000-00-00")
+}
@@ -0,0 +1,75 @@
+package carbon
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// Scan implements "driver.Scanner" interface for Period struct.
+func (p *Period) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		*p = *parsePeriodByDefaultLayout(string(v))
+	case string:
+		*p = *parsePeriodByDefaultLayout(v)
+	default:
+		return ErrFailedScan(v)
+	}
+	return p.Error
+}
+
+// Value implements "driver.Valuer" interface for Period struct.
+func (p Period) Value() (driver.Value, error) {
+	if p.HasError() {
+		return nil, p.Error
+	}
+	if p.IsInvalid() {
+		return nil, nil
+	}
+	return p.layout(DefaultLayout), nil
+}
+
+// MarshalJSON implements "json.Marshaler" interface for Period struct.
+func (p Period) MarshalJSON() ([]byte, error) {
+	if p.HasError() {
+		return []byte(`null`), p.Error
+	}
+	if p.IsInvalid() {
+		return []byte(`null`), nil
+	}
+	return json.Marshal(p.layout(DefaultLayout))
+}
+
+// UnmarshalJSON implements "json.Unmarshaler" interface for Period struct.
+func (p *Period) UnmarshalJSON(src []byte) error {
+	var v string
+	if string(src) != "null" {
+		if err := json.Unmarshal(src, &v); err != nil {
+			return err
+		}
+	}
+	if v == "" || v == "null" {
+		return nil
+	}
+	*p = *parsePeriodByDefaultLayout(v)
+	return p.Error
+}
+
+// layout outputs the period as a range string by layout like "[2020-08-05 00:00:00,2020-08-06 00:00:00)",
+// the times containing commas are double-quoted.
+func (p *Period) layout(layout string) string {
+	return string(p.bounds[0]) + quotePeriodTime(p.start.Layout(layout)) + "," + quotePeriodTime(p.end.Layout(layout)) + string(p.bounds[1])
+}
+
+// parses a range string written by Value or MarshalJSON, whose times are in DefaultLayout,
+// the times in other layouts are parsed by Parse.
+func parsePeriodByDefaultLayout(value string) *Period {
+	return parsePeriod(value, func(v string) *Carbon {
+		if c := ParseByLayout(v, DefaultLayout); !c.HasError() {
+			return c
+		}
+		return Parse(v)
+	})
+}
@@ -0,0 +1,83 @@
+package carbon
+
+import (
+	"reflect"
+	"time"
+)
+
+// valid seconds range of google.protobuf.Timestamp, from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z
+const (
+	minProtoTimestampSeconds int64 = -62135596800
+	maxProtoTimestampSeconds int64 = 253402300799
+)
+
+// ProtoTimestamp defines a ProtoTimestamp interface which is implemented by *timestamppb.Timestamp,
+// so google.protobuf.Timestamp is supported without depending on the protobuf module.
+type ProtoTimestamp interface {
+	GetSeconds() int64
+	GetNanos() int32
+}
+
+// ProtoDuration defines a ProtoDuration interface which is implemented by *durationpb.Duration,
+// so google.protobuf.Duration is supported without depending on the protobuf module.
+type ProtoDuration interface {
+	GetSeconds() int64
+	GetNanos() int32
+}
+
+// CreateFromProtoTimestamp creates a Carbon instance from a google.protobuf.Timestamp like *timestamppb.Timestamp,
+// a nil timestamp gives an empty Carbon instance.
+func CreateFromProtoTimestamp(ts ProtoTimestamp, timezone ...string) *Carbon {
+	if isNilProto(ts) {
+		return &Carbon{isEmpty: true}
+	}
+	seconds, nanos := ts.GetSeconds(), ts.GetNanos()
+	if seconds < minProtoTimestampSeconds || seconds > maxProtoTimestampSeconds || nanos < 0 || nanos > MaxNanosecond {
+		return &Carbon{Error: ErrInvalidProtoTimestamp(seconds, nanos)}
+	}
+	return CreateFromStdTime(time.Unix(seconds, int64(nanos)), timezone...)
+}
+
+// ToProtoTimestamp outputs the seconds and nanos of google.protobuf.Timestamp,
+// like &timestamppb.Timestamp{Seconds: seconds, Nanos: nanos}.
+func (c *Carbon) ToProtoTimestamp() (seconds int64, nanos int32) {
+	if c.IsInvalid() {
+		return
+	}
+	t := c.StdTime()
+	return t.Unix(), int32(t.Nanosecond())
+}
+
+// CreateDurationFromProto creates a duration from a google.protobuf.Duration like *durationpb.Duration,
+// a nil duration gives zero.
+func CreateDurationFromProto(d ProtoDuration) (Duration, error) {
+	if isNilProto(d) {
+		return 0, nil
+	}
+	seconds, nanos := d.GetSeconds(), d.GetNanos()
+	if nanos <= -1e9 || nanos >= 1e9 || (seconds > 0 && nanos < 0) || (seconds < 0 && nanos > 0) {
+		return 0, ErrInvalidProtoDuration(seconds, nanos)
+	}
+	// the duration overflows if its seconds are out of range or changed after adding the nanos
+	maxSeconds := int64(1<<63-1) / int64(time.Second)
+	duration := Duration(seconds)*time.Second + Duration(nanos)
+	if seconds > maxSeconds || seconds < -maxSeconds || int64(duration/time.Second) != seconds {
+		return 0, ErrInvalidProtoDuration(seconds, nanos)
+	}
+	return duration, nil
+}
+
+// ToProtoDuration outputs the seconds and nanos of google.protobuf.Duration,
+// like &durationpb.Duration{Seconds: seconds, Nanos: nanos}.
+func ToProtoDuration(d Duration) (seconds int64, nanos int32) {
+	return int64(d / time.Second), int32(d % time.Second)
+}
+
+// reports whether a protobuf message is nil or a nil pointer.
+func isNilProto(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
@@ -86,28 +86,98 @@ func (t *TimestampType[T]) UnmarshalJSON(src []byte) error {
 	if v == "" || v == "null" {
 		return nil
 	}
-	var (
-		ts  int64
-		err error
-	)
-	if ts, err = strconv.ParseInt(v, 10, 64); err != nil {
-		return ErrInvalidTimestamp(v)
+	c := t.parseTimestamp(v)
+	if c.HasError() {
+		return c.Error
 	}
-	var c *Carbon
-	switch t.getPrecision() {
-	case PrecisionSecond:
-		c = CreateFromTimestamp(ts, DefaultTimezone)
-	case PrecisionMillisecond:
-		c = CreateFromTimestampMilli(ts, DefaultTimezone)
-	case PrecisionMicrosecond:
-		c = CreateFromTimestampMicro(ts, DefaultTimezone)
-	case PrecisionNanosecond:
-		c = CreateFromTimestampNano(ts, DefaultTimezone)
+	*t = *NewTimestampType[T](c)
+	return t.Error
+}
+
+// MarshalText implements "encoding.TextMarshaler" interface for TimestampType generic struct,
+// which is also used by TOML encoders.
+func (t TimestampType[T]) MarshalText() ([]byte, error) {
+	if t.IsNil() || t.IsZero() || t.IsEmpty() {
+		return []byte{}, nil
+	}
+	if t.HasError() {
+		return nil, t.Error
+	}
+	return []byte(strconv.FormatInt(t.Int64(), 10)), nil
+}
+
+// UnmarshalText implements "encoding.TextUnmarshaler" interface for TimestampType generic struct.
+func (t *TimestampType[T]) UnmarshalText(src []byte) error {
+	v := string(src)
+	if v == "" {
+		return nil
+	}
+	c := t.parseTimestamp(v)
+	if c.HasError() {
+		return c.Error
 	}
 	*t = *NewTimestampType[T](c)
 	return t.Error
 }
 
+// MarshalBinary implements "encoding.BinaryMarshaler" interface for TimestampType generic struct,
+// which is also used by "encoding/gob" and keeps the nanosecond and timezone.
+func (t TimestampType[T]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(t.Carbon)
+}
+
+// UnmarshalBinary implements "encoding.BinaryUnmarshaler" interface for TimestampType generic struct.
+func (t *TimestampType[T]) UnmarshalBinary(src []byte) error {
+	if len(src) == 0 {
+		return nil
+	}
+	*t = *NewTimestampType[T](unmarshalBinary(src))
+	return t.Error
+}
+
+// MarshalBSONValue implements "bson.ValueMarshaler" interface of mongo-driver v2 for TimestampType generic struct,
+// it's marshaled as a bson UTC datetime with millisecond precision.
+func (t TimestampType[T]) MarshalBSONValue() (byte, []byte, error) {
+	return marshalBSONDateTime(t.Carbon)
+}
+
+// UnmarshalBSONValue implements "bson.ValueUnmarshaler" interface of mongo-driver v2 for TimestampType generic struct,
+// a bson UTC datetime, string or null is supported.
+func (t *TimestampType[T]) UnmarshalBSONValue(typ byte, src []byte) error {
+	switch typ {
+	case bsonTypeNull:
+		return nil
+	case bsonTypeDateTime:
+		*t = *NewTimestampType[T](parseBSONDateTime(src))
+		return t.Error
+	case bsonTypeString:
+		if v, ok := parseBSONString(src); ok {
+			return t.UnmarshalText([]byte(v))
+		}
+	}
+	return ErrFailedUnmarshalBSON(typ, src)
+}
+
+// MarshalYAML implements "yaml.Marshaler" interface for TimestampType generic struct.
+func (t TimestampType[T]) MarshalYAML() (any, error) {
+	if t.IsNil() || t.IsZero() || t.IsEmpty() {
+		return nil, nil
+	}
+	if t.HasError() {
+		return nil, t.Error
+	}
+	return t.Int64(), nil
+}
+
+// UnmarshalYAML implements "yaml.Unmarshaler" interface of yaml.v2 for TimestampType generic struct, which is also supported by yaml.v3.
+func (t *TimestampType[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var v string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(v))
+}
+
 // String implements "Stringer" interface for TimestampType generic struct.
 func (t *TimestampType[T]) String() string {
 	if t == nil || t.IsInvalid() {
@@ -134,6 +204,32 @@ func (t *TimestampType[T]) Int64() (ts int64) {
 	return
 }
 
+// parseTimestamp parses a timestamp string by the precision of TimestampType generic struct.
+func (t *TimestampType[T]) parseTimestamp(v string) *Carbon {
+	ts, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return &Carbon{Error: ErrInvalidTimestamp(v)}
+	}
+	var c *Carbon
+	switch t.getPrecision() {
+	case PrecisionSecond:
+		c = CreateFromTimestamp(ts, DefaultTimezone)
+	case PrecisionMillisecond:
+		c = CreateFromTimestampMilli(ts, DefaultTimezone)
+	case PrecisionMicrosecond:
+		c = CreateFromTimestampMicro(ts, DefaultTimezone)
+	case PrecisionNanosecond:
+		c = CreateFromTimestampNano(ts, DefaultTimezone)
+	}
+	return c
+}
+
+// scanTimestamp scans a timestamp string like "1596604455" by the precision of TimestampType generic struct.
+func (t *TimestampType[T]) scanTimestamp(v string) error {
+	*t = *NewTimestampType[T](t.parseTimestamp(v))
+	return t.Error
+}
+
 // getPrecision returns precision of TimestampType generic struct.
 func (t *TimestampType[T]) getPrecision() string {
 	var typer T
@@ -1,11 +1,15 @@
 package carbon
 
 import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
 	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 )
 
 type carbonTypeModel struct {
@@ -1112,3 +1116,985 @@ func (s *CustomerTypeSuite) TestCustomerType_UnmarshalJSON() {
 		s.Equal("2020-08-05T13:14:15Z", model.UpdatedAt.String())
 	})
 }
+
+type periodTypeModel struct {
+	Period1 Period  `json:"period1"`
+	Period2 *Period `json:"period2"`
+}
+
+type PeriodTypeSuite struct {
+	suite.Suite
+}
+
+func TestPeriodTypeSuite(t *testing.T) {
+	suite.Run(t, new(PeriodTypeSuite))
+}
+
+func (s *PeriodTypeSuite) TestPeriodType_Scan() {
+	p := new(Period)
+
+	s.Run("[]byte type", func() {
+		s.Nil(p.Scan([]byte("[2020-08-05 00:00:00,2020-08-06 00:00:00)")))
+		s.Equal(BoundsOpenEnd, p.Bounds())
+	})
+
+	s.Run("string type", func() {
+		s.Nil(p.Scan("(2020-08-05 00:00:00,2020-08-06 00:00:00]"))
+		s.Equal(BoundsOpenStart, p.Bounds())
+	})
+
+	s.Run("nil type", func() {
+		s.Nil(p.Scan(nil))
+	})
+
+	s.Run("invalid value", func() {
+		s.Error(p.Scan("xxx"))
+	})
+
+	s.Run("unsupported type", func() {
+		s.Error(p.Scan(true))
+		s.Error(p.Scan(int64(0)))
+		s.Error(p.Scan(map[string]string{}))
+	})
+}
+
+func (s *PeriodTypeSuite) TestPeriodType_Value() {
+	s.Run("zero period", func() {
+		v, e := Period{}.Value()
+		s.Nil(v)
+		s.Nil(e)
+	})
+
+	s.Run("error period", func() {
+		v, e := NewPeriod(Parse("xxx"), Now()).Value()
+		s.Nil(v)
+		s.Error(e)
+	})
+
+	s.Run("valid period", func() {
+		v, e := NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"), BoundsOpenEnd).Value()
+		s.Equal("[2020-08-05 00:00:00,2020-08-06 00:00:00)", v)
+		s.Nil(e)
+	})
+}
+
+func (s *PeriodTypeSuite) TestPeriodType_MarshalJSON() {
+	var model periodTypeModel
+
+	s.Run("zero period", func() {
+		data, err := json.Marshal(&model)
+		s.Nil(err)
+		s.Equal(`{"period1":null,"period2":null}`, string(data))
+	})
+
+	s.Run("error period", func() {
+		model.Period1 = *NewPeriod(Parse("xxx"), Now())
+		data, err := json.Marshal(&model)
+		s.Error(err)
+		s.Empty(string(data))
+	})
+
+	s.Run("valid period", func() {
+		model.Period1 = *NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"))
+		model.Period2 = NewPeriod(Parse("2020-08-05"), Parse("2020-08-06"), BoundsOpen)
+		data, err := json.Marshal(&model)
+		s.Nil(err)
+		s.Equal(`{"period1":"[2020-08-05 00:00:00,2020-08-06 00:00:00]","period2":"(2020-08-05 00:00:00,2020-08-06 00:00:00)"}`, string(data))
+	})
+}
+
+func (s *PeriodTypeSuite) TestPeriodType_UnmarshalJSON() {
+	s.Run("null value", func() {
+		var model periodTypeModel
+		s.Nil(json.Unmarshal([]byte(`{"period1":null,"period2":""}`), &model))
+		s.True(model.Period1.IsInvalid())
+	})
+
+	s.Run("invalid value", func() {
+		var model periodTypeModel
+		s.Error(json.Unmarshal([]byte(`{"period1":"xxx"}`), &model))
+	})
+
+	s.Run("valid value", func() {
+		var model periodTypeModel
+		s.Nil(json.Unmarshal([]byte(`{"period1":"[2020-08-05 00:00:00,2020-08-06 00:00:00)","period2":"(2020-08-05,2020-08-06]"}`), &model))
+		s.Equal("[2020-08-05 00:00:00,2020-08-06 00:00:00)", model.Period1.String())
+		s.Equal("(2020-08-05,2020-08-06]", model.Period2.String())
+	})
+}
+
+func (s *PeriodTypeSuite) TestPeriodType_CommaLayout() {
+	defer ResetDefault()
+
+	for _, layout := range []string{RFC1123Layout, CookieLayout} {
+		SetDefault(Default{Layout: layout})
+		p := NewPeriod(Parse("2020-08-05 13:14:15"), Parse("2020-08-06 13:14:15"), BoundsOpenEnd)
+
+		data, err := json.Marshal(&periodTypeModel{Period1: *p})
+		s.Nil(err, layout)
+		var model periodTypeModel
+		s.Nil(json.Unmarshal(data, &model), layout)
+		s.Equal(p.Start().ToString(), model.Period1.Start().ToString(), layout)
+		s.Equal(p.End().ToString(), model.Period1.End().ToString(), layout)
+		s.Equal(BoundsOpenEnd, model.Period1.Bounds(), layout)
+
+		v, err := p.Value()
+		s.Nil(err, layout)
+		q := new(Period)
+		s.Nil(q.Scan(v), layout)
+		s.Equal(p.Start().ToString(), q.Start().ToString(), layout)
+		s.Equal(p.End().ToString(), q.End().ToString(), layout)
+	}
+
+	SetDefault(Default{Layout: RFC1123Layout})
+	v, _ := NewPeriod(Parse("2020-08-05"), Parse("2020-08-06")).Value()
+	s.Equal(`["Wed, 05 Aug 2020 00:00:00 UTC","Thu, 06 Aug 2020 00:00:00 UTC"]`, v)
+}
+
+type calendarDurationTypeModel struct {
+	Duration1 CalendarDuration  `json:"duration1"`
+	Duration2 *CalendarDuration `json:"duration2"`
+}
+
+type CalendarDurationTypeSuite struct {
+	suite.Suite
+}
+
+func TestCalendarDurationTypeSuite(t *testing.T) {
+	suite.Run(t, new(CalendarDurationTypeSuite))
+}
+
+func (s *CalendarDurationTypeSuite) TestCalendarDurationType_Scan() {
+	d := new(CalendarDuration)
+
+	s.Run("[]byte type", func() {
+		s.Nil(d.Scan([]byte("P1Y2M")))
+		s.Equal("P1Y2M", d.String())
+	})
+
+	s.Run("string type", func() {
+		s.Nil(d.Scan("PT1H"))
+		s.Equal("PT1H", d.String())
+	})
+
+	s.Run("nil type", func() {
+		s.Nil(d.Scan(nil))
+	})
+
+	s.Run("invalid value", func() {
+		s.Error(d.Scan("xxx"))
+	})
+
+	s.Run("unsupported type", func() {
+		s.Error(d.Scan(true))
+		s.Error(d.Scan(int64(0)))
+		s.Error(d.Scan(map[string]string{}))
+	})
+}
+
+func (s *CalendarDurationTypeSuite) TestCalendarDurationType_Value() {
+	s.Run("zero duration", func() {
+		v, e := CalendarDuration{}.Value()
+		s.Equal("PT0S", v)
+		s.Nil(e)
+	})
+
+	s.Run("error duration", func() {
+		v, e := ParseCalendarDuration("xxx").Value()
+		s.Nil(v)
+		s.Error(e)
+	})
+
+	s.Run("valid duration", func() {
+		v, e := ParseCalendarDuration("P1Y2M10DT2H30M").Value()
+		s.Equal("P1Y2M10DT2H30M", v)
+		s.Nil(e)
+	})
+}
+
+func (s *CalendarDurationTypeSuite) TestCalendarDurationType_MarshalJSON() {
+	var model calendarDurationTypeModel
+
+	s.Run("zero duration", func() {
+		data, err := json.Marshal(&model)
+		s.Nil(err)
+		s.Equal(`{"duration1":"PT0S","duration2":null}`, string(data))
+	})
+
+	s.Run("error duration", func() {
+		model.Duration1 = *ParseCalendarDuration("xxx")
+		data, err := json.Marshal(&model)
+		s.Error(err)
+		s.Empty(string(data))
+	})
+
+	s.Run("valid duration", func() {
+		model.Duration1 = *ParseCalendarDuration("P1M")
+		model.Duration2 = ParseCalendarDuration("-PT1.5S")
+		data, err := json.Marshal(&model)
+		s.Nil(err)
+		s.Equal(`{"duration1":"P1M","duration2":"-PT1.5S"}`, string(data))
+	})
+}
+
+func (s *CalendarDurationTypeSuite) TestCalendarDurationType_UnmarshalJSON() {
+	s.Run("null value", func() {
+		var model calendarDurationTypeModel
+		s.Nil(json.Unmarshal([]byte(`{"duration1":null,"duration2":""}`), &model))
+		s.True(model.Duration1.IsZero())
+	})
+
+	s.Run("invalid value", func() {
+		var model calendarDurationTypeModel
+		s.Error(json.Unmarshal([]byte(`{"duration1":"xxx"}`), &model))
+	})
+
+	s.Run("valid value", func() {
+		var model calendarDurationTypeModel
+		s.Nil(json.Unmarshal([]byte(`{"duration1":"P1Y2M10DT2H30M","duration2":"P2W"}`), &model))
+		s.Equal("P1Y2M10DT2H30M", model.Duration1.String())
+		s.Equal("P14D", model.Duration2.String())
+	})
+}
+
+type codecTypeModel struct {
+	Carbon1   Carbon                   `yaml:"carbon1"`
+	Carbon2   *Carbon                  `yaml:"carbon2"`
+	DateTime  DateTime                 `yaml:"date_time"`
+	Date      *Date                    `yaml:"date"`
+	Customer  FormatType[iso8601Type]  `yaml:"customer"`
+	Timestamp TimestampMilli           `yaml:"timestamp"`
+	DeletedAt *Timestamp               `yaml:"deleted_at"`
+	UpdatedAt *LayoutType[rfc3339Type] `yaml:"updated_at"`
+}
+
+// bsonDateTime encodes milliseconds as a bson UTC datetime value.
+func bsonDateTime(ms int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(ms))
+	return b
+}
+
+// bsonString encodes a string as a bson string value.
+func bsonString(v string) []byte {
+	b := make([]byte, 4, len(v)+5)
+	binary.LittleEndian.PutUint32(b, uint32(len(v)+1))
+	b = append(b, v...)
+	return append(b, 0)
+}
+
+type CodecTypeSuite struct {
+	suite.Suite
+}
+
+func TestCodecTypeSuite(t *testing.T) {
+	suite.Run(t, new(CodecTypeSuite))
+}
+
+func (s *CodecTypeSuite) TestCodecType_Text() {
+	s.Run("invalid carbon", func() {
+		v, e := NewCarbon().MarshalText()
+		s.Nil(e)
+		s.Empty(v)
+
+		v, e = Parse("").MarshalText()
+		s.Nil(e)
+		s.Empty(v)
+
+		_, e = Parse("xxx").MarshalText()
+		s.Error(e)
+
+		v, e = DateTime{}.MarshalText()
+		s.Nil(e)
+		s.Empty(v)
+
+		v, e = FormatType[iso8601Type]{}.MarshalText()
+		s.Nil(e)
+		s.Empty(v)
+
+		v, e = NewTimestamp(NewCarbon()).MarshalText()
+		s.Nil(e)
+		s.Empty(v)
+
+		_, e = NewDate(Parse("xxx")).MarshalText()
+		s.Error(e)
+		_, e = NewFormatType[iso8601Type](Parse("xxx")).MarshalText()
+		s.Error(e)
+		_, e = NewTimestamp(Parse("xxx")).MarshalText()
+		s.Error(e)
+	})
+
+	s.Run("valid carbon", func() {
+		c := Parse("2020-08-05 13:14:15.999999999", PRC)
+
+		v, e := c.MarshalText()
+		s.Nil(e)
+		s.Equal("2020-08-05 13:14:15", string(v))
+
+		v, e = NewDate(c).MarshalText()
+		s.Nil(e)
+		s.Equal("2020-08-05", string(v))
+
+		v, e = NewFormatType[iso8601Type](c).MarshalText()
+		s.Nil(e)
+		s.Equal("2020-08-05T13:14:15+08:00", string(v))
+
+		v, e = NewTimestampMilli(c).MarshalText()
+		s.Nil(e)
+		s.Equal("1596604455999", string(v))
+	})
+
+	s.Run("unmarshal", func() {
+		c := new(Carbon)
+		s.Nil(c.UnmarshalText([]byte("")))
+		s.True(c.IsEmpty())
+		s.Nil(c.UnmarshalText([]byte("2020-08-05 13:14:15")))
+		s.Equal("2020-08-05 13:14:15", c.String())
+		s.Error(c.UnmarshalText([]byte("xxx")))
+
+		d := new(Date)
+		s.Nil(d.UnmarshalText([]byte("")))
+		s.Nil(d.Carbon)
+		s.Nil(d.UnmarshalText([]byte("2020-08-05")))
+		s.Equal("2020-08-05", d.String())
+		s.Error(d.UnmarshalText([]byte("xxx")))
+
+		f := new(FormatType[iso8601Type])
+		s.Nil(f.UnmarshalText([]byte("")))
+		s.Nil(f.UnmarshalText([]byte("2020-08-05T13:14:15+08:00")))
+		s.Equal("2020-08-05T05:14:15+00:00", f.String())
+		s.Error(f.UnmarshalText([]byte("xxx")))
+
+		t := new(TimestampMilli)
+		s.Nil(t.UnmarshalText([]byte("")))
+		s.Nil(t.UnmarshalText([]byte("1596604455999")))
+		s.Equal("1596604455999", t.String())
+		s.Equal(ErrInvalidTimestamp("xxx"), t.UnmarshalText([]byte("xxx")))
+		s.Equal("1596604455999", t.String())
+	})
+}
+
+func (s *CodecTypeSuite) TestCodecType_Binary() {
+	s.Run("invalid carbon", func() {
+		v, e := NewCarbon().MarshalBinary()
+		s.Nil(e)
+		s.Empty(v)
+
+		v, e = DateTime{}.MarshalBinary()
+		s.Nil(e)
+		s.Empty(v)
+
+		_, e = Parse("xxx").MarshalBinary()
+		s.Error(e)
+		_, e = NewDate(Parse("xxx")).MarshalBinary()
+		s.Error(e)
+	})
+
+	s.Run("invalid binary", func() {
+		c := new(Carbon)
+		s.Nil(c.UnmarshalBinary(nil))
+		s.True(c.IsEmpty())
+		s.Error(c.UnmarshalBinary([]byte{20, 1}))
+		s.Error(c.UnmarshalBinary([]byte{2, 9, 9}))
+
+		d := new(Date)
+		s.Nil(d.UnmarshalBinary(nil))
+		s.Nil(d.Carbon)
+		s.Error(d.UnmarshalBinary([]byte{20, 1}))
+		s.Nil(new(FormatType[iso8601Type]).UnmarshalBinary(nil))
+		s.Error(new(FormatType[iso8601Type]).UnmarshalBinary([]byte{20, 1}))
+		s.Nil(new(Timestamp).UnmarshalBinary(nil))
+		s.Error(new(Timestamp).UnmarshalBinary([]byte{20, 1}))
+	})
+
+	s.Run("round trip", func() {
+		c := Parse("2020-08-05 13:14:15.999999999", "America/New_York")
+		v, e := c.MarshalBinary()
+		s.Nil(e)
+
+		r := new(Carbon)
+		s.Nil(r.UnmarshalBinary(v))
+		s.Equal("2020-08-05 13:14:15.999999999 -0400 EDT", r.ToString())
+		s.Equal("America/New_York", r.Timezone())
+
+		// an unknown timezone keeps the fixed zone offset
+		v[len(v)-1] = 'x'
+		s.Nil(r.UnmarshalBinary(v))
+		s.Equal("2020-08-05 13:14:15.999999999 -0400 -0400", r.ToString())
+
+		v, e = NewTimestamp(c).MarshalBinary()
+		s.Nil(e)
+		t := new(Timestamp)
+		s.Nil(t.UnmarshalBinary(v))
+		s.Equal("America/New_York", t.Timezone())
+		s.Equal(int64(1596647655), t.Int64())
+
+		v, e = NewFormatType[iso8601Type](c).MarshalBinary()
+		s.Nil(e)
+		f := new(FormatType[iso8601Type])
+		s.Nil(f.UnmarshalBinary(v))
+		s.Equal("2020-08-05T13:14:15-04:00", f.String())
+	})
+
+	s.Run("gob", func() {
+		c := Parse("2020-08-05 13:14:15.999999999", PRC)
+		model := codecTypeModel{
+			Carbon1:   *c,
+			Carbon2:   c,
+			DateTime:  *NewDateTime(c),
+			Date:      NewDate(c),
+			Customer:  *NewFormatType[iso8601Type](c),
+			Timestamp: *NewTimestampMilli(c),
+		}
+
+		var buf bytes.Buffer
+		s.Nil(gob.NewEncoder(&buf).Encode(&model))
+
+		var decoded codecTypeModel
+		s.Nil(gob.NewDecoder(&buf).Decode(&decoded))
+		s.Equal("2020-08-05 13:14:15.999999999 +0800 CST", decoded.Carbon1.ToString())
+		s.Equal("2020-08-05 13:14:15.999999999 +0800 CST", decoded.Carbon2.ToString())
+		s.Equal("2020-08-05 13:14:15", decoded.DateTime.String())
+		s.Equal("2020-08-05", decoded.Date.String())
+		s.Equal("2020-08-05T13:14:15+08:00", decoded.Customer.String())
+		s.Equal("1596604455999", decoded.Timestamp.String())
+		s.Nil(decoded.DeletedAt)
+		s.Nil(decoded.UpdatedAt)
+	})
+}
+
+func (s *CodecTypeSuite) TestCodecType_BSON() {
+	s.Run("invalid carbon", func() {
+		typ, v, e := NewCarbon().MarshalBSONValue()
+		s.Equal(byte(0x0A), typ)
+		s.Nil(v)
+		s.Nil(e)
+
+		typ, _, e = Parse("").MarshalBSONValue()
+		s.Equal(byte(0x0A), typ)
+		s.Nil(e)
+
+		typ, _, e = DateTime{}.MarshalBSONValue()
+		s.Equal(byte(0x0A), typ)
+		s.Nil(e)
+
+		_, _, e = Parse("xxx").MarshalBSONValue()
+		s.Error(e)
+		_, _, e = NewFormatType[iso8601Type](Parse("xxx")).MarshalBSONValue()
+		s.Error(e)
+		_, _, e = NewTimestamp(Parse("xxx")).MarshalBSONValue()
+		s.Error(e)
+	})
+
+	s.Run("valid carbon", func() {
+		c := Parse("2020-08-05 13:14:15.999999999", PRC)
+		want := bsonDateTime(1596604455999)
+
+		typ, v, e := c.MarshalBSONValue()
+		s.Nil(e)
+		s.Equal(byte(0x09), typ)
+		s.Equal(want, v)
+		s.Equal(uint64(1596604455999), binary.LittleEndian.Uint64(v))
+
+		_, v, _ = NewDate(c).MarshalBSONValue()
+		s.Equal(want, v)
+		_, v, _ = NewFormatType[iso8601Type](c).MarshalBSONValue()
+		s.Equal(want, v)
+		_, v, _ = NewTimestampNano(c).MarshalBSONValue()
+		s.Equal(want, v)
+	})
+
+	s.Run("unmarshal", func() {
+		datetime := bsonDateTime(1596604455999)
+
+		c := new(Carbon)
+		s.Nil(c.UnmarshalBSONValue(0x0A, nil))
+		s.True(c.IsEmpty())
+		s.Nil(c.UnmarshalBSONValue(0x09, datetime))
+		s.Equal("2020-08-05 05:14:15.999 +0000 UTC", c.ToString())
+		s.Equal("UTC", c.Timezone())
+		s.Nil(c.UnmarshalBSONValue(0x02, bsonString("2020-08-05 13:14:15")))
+		s.Equal("2020-08-05 13:14:15", c.String())
+		s.Error(c.UnmarshalBSONValue(0x09, datetime[:4]))
+		s.Error(c.UnmarshalBSONValue(0x02, []byte{1, 0, 0, 0}))
+		s.Error(c.UnmarshalBSONValue(0x02, []byte{9, 0, 0, 0, 'x', 0}))
+		s.Error(c.UnmarshalBSONValue(0x02, bsonString("xxx")))
+		s.Error(c.UnmarshalBSONValue(0x12, datetime))
+
+		d := new(Date)
+		s.Nil(d.UnmarshalBSONValue(0x0A, nil))
+		s.Nil(d.Carbon)
+		s.Nil(d.UnmarshalBSONValue(0x09, datetime))
+		s.Equal("2020-08-05", d.String())
+		s.Nil(d.UnmarshalBSONValue(0x02, bsonString("2020-08-06")))
+		s.Equal("2020-08-06", d.String())
+		s.Error(d.UnmarshalBSONValue(0x12, datetime))
+
+		f := new(FormatType[iso8601Type])
+		s.Nil(f.UnmarshalBSONValue(0x0A, nil))
+		s.Nil(f.UnmarshalBSONValue(0x09, datetime))
+		s.Equal("2020-08-05T05:14:15+00:00", f.String())
+		s.Nil(f.UnmarshalBSONValue(0x02, bsonString("2020-08-05T13:14:15+08:00")))
+		s.Equal("2020-08-05T05:14:15+00:00", f.String())
+		s.Error(f.UnmarshalBSONValue(0x12, datetime))
+
+		t := new(TimestampMilli)
+		s.Nil(t.UnmarshalBSONValue(0x0A, nil))
+		s.Nil(t.UnmarshalBSONValue(0x09, datetime))
+		s.Equal(int64(1596604455999), t.Int64())
+		s.Nil(t.UnmarshalBSONValue(0x02, bsonString("1596604455000")))
+		s.Equal(int64(1596604455000), t.Int64())
+		s.Error(t.UnmarshalBSONValue(0x12, datetime))
+	})
+}
+
+func (s *CodecTypeSuite) TestCodecType_YAML() {
+	s.Run("unset carbon", func() {
+		var model codecTypeModel
+		v, e := yaml.Marshal(&model)
+		s.Nil(e)
+		s.Equal("carbon1: null\ncarbon2: null\ndate_time: null\ndate: null\ncustomer: null\ntimestamp: null\ndeleted_at: null\nupdated_at: null\n", string(v))
+	})
+
+	s.Run("error carbon", func() {
+		c := Parse("xxx")
+		_, e := yaml.Marshal(&codecTypeModel{Carbon2: c})
+		s.Error(e)
+		_, e = yaml.Marshal(&codecTypeModel{Date: NewDate(c)})
+		s.Error(e)
+		_, e = yaml.Marshal(&codecTypeModel{Customer: *NewFormatType[iso8601Type](c)})
+		s.Error(e)
+		_, e = yaml.Marshal(&codecTypeModel{DeletedAt: NewTimestamp(c)})
+		s.Error(e)
+	})
+
+	s.Run("valid carbon", func() {
+		c := Parse("2020-08-05 13:14:15.999999999", PRC)
+		model := codecTypeModel{
+			Carbon1:   *c,
+			Carbon2:   c,
+			DateTime:  *NewDateTime(c),
+			Date:      NewDate(c),
+			Customer:  *NewFormatType[iso8601Type](c),
+			Timestamp: *NewTimestampMilli(c),
+			DeletedAt: NewTimestamp(c),
+			UpdatedAt: NewLayoutType[rfc3339Type](c),
+		}
+		v, e := yaml.Marshal(&model)
+		s.Nil(e)
+		s.Equal(`carbon1: "2020-08-05 13:14:15"
+carbon2: "2020-08-05 13:14:15"
+date_time: "2020-08-05 13:14:15"
+date: "2020-08-05"
+customer: "2020-08-05T13:14:15+08:00"
+timestamp: 1596604455999
+deleted_at: 1596604455
+updated_at: "2020-08-05T13:14:15+08:00"
+`, string(v))
+
+		var decoded codecTypeModel
+		s.Nil(yaml.Unmarshal(v, &decoded))
+		s.Equal("2020-08-05 13:14:15", decoded.Carbon1.String())
+		s.Equal("2020-08-05 13:14:15", decoded.Carbon2.String())
+		s.Equal("2020-08-05 13:14:15", decoded.DateTime.String())
+		s.Equal("2020-08-05", decoded.Date.String())
+		s.Equal("2020-08-05T05:14:15+00:00", decoded.Customer.String())
+		s.Equal("1596604455999", decoded.Timestamp.String())
+		s.Equal("1596604455", decoded.DeletedAt.String())
+		s.Equal("2020-08-05T05:14:15Z", decoded.UpdatedAt.String())
+	})
+
+	s.Run("unmarshal", func() {
+		var model codecTypeModel
+		s.Nil(yaml.Unmarshal([]byte("carbon1: ''\ndate_time: ''\ndate: 2020-08-05\ntimestamp: ''"), &model))
+		s.True(model.Carbon1.IsEmpty())
+		s.Nil(model.DateTime.Carbon)
+		s.Equal("2020-08-05", model.Date.String())
+		s.Nil(model.Timestamp.Carbon)
+
+		s.Error(yaml.Unmarshal([]byte("carbon1: xxx"), &model))
+		s.Error(yaml.Unmarshal([]byte("carbon1: [1]"), &model))
+		s.Error(yaml.Unmarshal([]byte("date: xxx"), &model))
+		s.Error(yaml.Unmarshal([]byte("date: [1]"), &model))
+		s.Error(yaml.Unmarshal([]byte("customer: xxx"), &model))
+		s.Error(yaml.Unmarshal([]byte("customer: [1]"), &model))
+		s.Error(yaml.Unmarshal([]byte("timestamp: xxx"), &model))
+		s.Error(yaml.Unmarshal([]byte("timestamp: [1]"), &model))
+	})
+}
+
+// protoMessage mocks *timestamppb.Timestamp and *durationpb.Duration.
+type protoMessage struct {
+	Seconds int64
+	Nanos   int32
+}
+
+func (m *protoMessage) GetSeconds() int64 {
+	if m == nil {
+		return 0
+	}
+	return m.Seconds
+}
+
+func (m *protoMessage) GetNanos() int32 {
+	if m == nil {
+		return 0
+	}
+	return m.Nanos
+}
+
+type ProtoTypeSuite struct {
+	suite.Suite
+}
+
+func TestProtoTypeSuite(t *testing.T) {
+	suite.Run(t, new(ProtoTypeSuite))
+}
+
+func (s *ProtoTypeSuite) TestCreateFromProtoTimestamp() {
+	s.Run("nil timestamp", func() {
+		s.True(CreateFromProtoTimestamp(nil).IsEmpty())
+
+		var m *protoMessage
+		s.True(CreateFromProtoTimestamp(m).IsEmpty())
+	})
+
+	s.Run("invalid timestamp", func() {
+		s.Error(CreateFromProtoTimestamp(&protoMessage{Nanos: -1}).Error)
+		s.Error(CreateFromProtoTimestamp(&protoMessage{Nanos: 1e9}).Error)
+		s.Error(CreateFromProtoTimestamp(&protoMessage{Seconds: -62135596801}).Error)
+		s.Error(CreateFromProtoTimestamp(&protoMessage{Seconds: 253402300800}).Error)
+		s.Error(CreateFromProtoTimestamp(&protoMessage{}, "xxx").Error)
+	})
+
+	s.Run("valid timestamp", func() {
+		s.Equal("1970-01-01 00:00:00 +0000 UTC", CreateFromProtoTimestamp(&protoMessage{}).ToString())
+		s.Equal("2020-08-05 13:14:15.999999999 +0800 CST", CreateFromProtoTimestamp(&protoMessage{Seconds: 1596604455, Nanos: 999999999}, PRC).ToString())
+		s.True(CreateFromProtoTimestamp(&protoMessage{Seconds: -62135596800}).IsZero())
+	})
+}
+
+func (s *ProtoTypeSuite) TestCarbon_ToProtoTimestamp() {
+	s.Run("invalid carbon", func() {
+		var c *Carbon
+		seconds, nanos := c.ToProtoTimestamp()
+		s.Zero(seconds)
+		s.Zero(nanos)
+
+		seconds, nanos = Parse("xxx").ToProtoTimestamp()
+		s.Zero(seconds)
+		s.Zero(nanos)
+	})
+
+	s.Run("valid carbon", func() {
+		seconds, nanos := NewCarbon().ToProtoTimestamp()
+		s.Equal(int64(-62135596800), seconds)
+		s.Zero(nanos)
+
+		seconds, nanos = Parse("2020-08-05 13:14:15.999999999", PRC).ToProtoTimestamp()
+		s.Equal(int64(1596604455), seconds)
+		s.Equal(int32(999999999), nanos)
+
+		seconds, nanos = NewTimestampMilli(Parse("1969-12-31 23:59:59.5")).ToProtoTimestamp()
+		s.Equal(int64(-1), seconds)
+		s.Equal(int32(500000000), nanos)
+	})
+}
+
+func (s *ProtoTypeSuite) TestCreateDurationFromProto() {
+	s.Run("nil duration", func() {
+		d, e := CreateDurationFromProto(nil)
+		s.Zero(d)
+		s.Nil(e)
+
+		var m *protoMessage
+		d, e = CreateDurationFromProto(m)
+		s.Zero(d)
+		s.Nil(e)
+	})
+
+	s.Run("invalid duration", func() {
+		_, e := CreateDurationFromProto(&protoMessage{Nanos: 1e9})
+		s.Error(e)
+		_, e = CreateDurationFromProto(&protoMessage{Nanos: -1e9})
+		s.Error(e)
+		_, e = CreateDurationFromProto(&protoMessage{Seconds: 1, Nanos: -1})
+		s.Error(e)
+		_, e = CreateDurationFromProto(&protoMessage{Seconds: -1, Nanos: 1})
+		s.Error(e)
+		_, e = CreateDurationFromProto(&protoMessage{Seconds: 9223372037})
+		s.Error(e)
+		_, e = CreateDurationFromProto(&protoMessage{Seconds: -9223372037})
+		s.Error(e)
+		_, e = CreateDurationFromProto(&protoMessage{Seconds: 9223372036, Nanos: 900000000})
+		s.Error(e)
+	})
+
+	s.Run("valid duration", func() {
+		d, e := CreateDurationFromProto(&protoMessage{Seconds: 90, Nanos: 500000000})
+		s.Nil(e)
+		s.Equal(90*time.Second+500*time.Millisecond, d)
+
+		d, e = CreateDurationFromProto(&protoMessage{Seconds: -90, Nanos: -500000000})
+		s.Nil(e)
+		s.Equal(-90*time.Second-500*time.Millisecond, d)
+
+		d, e = CreateDurationFromProto(&protoMessage{Nanos: -1})
+		s.Nil(e)
+		s.Equal(-time.Nanosecond, d)
+
+		d, e = CreateDurationFromProto(&protoMessage{Seconds: 9223372036, Nanos: 854775807})
+		s.Nil(e)
+		s.Equal(Duration(1<<63-1), d)
+	})
+}
+
+func (s *ProtoTypeSuite) TestToProtoDuration() {
+	seconds, nanos := ToProtoDuration(0)
+	s.Zero(seconds)
+	s.Zero(nanos)
+
+	seconds, nanos = ToProtoDuration(90*time.Second + 500*time.Millisecond)
+	s.Equal(int64(90), seconds)
+	s.Equal(int32(500000000), nanos)
+
+	seconds, nanos = ToProtoDuration(-90*time.Second - 500*time.Millisecond)
+	s.Equal(int64(-90), seconds)
+	s.Equal(int32(-500000000), nanos)
+}
+
+type nullTypeModel struct {
+	Date      Null[Date]                                 `json:"date"`
+	DateTime  *Null[DateTime]                            `json:"date_time"`
+	Customer  NullType[FormatType[iso8601Type], AsEmpty] `json:"customer"`
+	Timestamp NullType[Timestamp, AsZero]                `json:"timestamp"`
+	DeletedAt NullType[DateTime, AsOmit]                 `json:"deleted_at"`
+}
+
+type NullTypeSuite struct {
+	suite.Suite
+}
+
+func TestNullTypeSuite(t *testing.T) {
+	suite.Run(t, new(NullTypeSuite))
+}
+
+func (s *NullTypeSuite) TearDownTest() {
+	ResetDefault()
+}
+
+func (s *NullTypeSuite) TestNullType_IsNull() {
+	s.True(NewNull[Date](nil).IsNull())
+	s.True(NewNull(NewDate(nil)).IsNull())
+	s.True(NewNull(NewDate(NewCarbon())).IsNull())
+	s.True(NewNull(NewDate(Parse(""))).IsNull())
+	s.False(NewNull(NewDate(Parse("xxx"))).IsNull())
+	s.False(NewNull(NewDate(Parse("2020-08-05"))).IsNull())
+
+	s.False(NewNull(NewDate(nil)).IsZero())
+	s.False(NewNullType[Date, AsEmpty](NewDate(nil)).IsZero())
+	s.True(NewNullType[Date, AsOmit](NewDate(nil)).IsZero())
+	s.False(NewNullType[Date, AsOmit](NewDate(Parse("2020-08-05"))).IsZero())
+}
+
+func (s *NullTypeSuite) TestNullType_Omit() {
+	type user struct {
+		Name      string                     `yaml:"name" json:"name"`
+		DeletedAt NullType[DateTime, AsOmit] `yaml:"deleted_at,omitempty" json:"deleted_at"`
+	}
+
+	s.Run("null value", func() {
+		v, e := yaml.Marshal(&user{Name: "carbon"})
+		s.Nil(e)
+		s.Equal("name: carbon\n", string(v))
+
+		v, e = json.Marshal(&user{Name: "carbon"})
+		s.Nil(e)
+		s.Equal(`{"name":"carbon","deleted_at":null}`, string(v))
+
+		d, e := NewNullType[DateTime, AsOmit](NewDateTime(Parse(""))).Value()
+		s.Nil(d)
+		s.Nil(e)
+	})
+
+	s.Run("valid value", func() {
+		u := user{Name: "carbon", DeletedAt: *NewNullType[DateTime, AsOmit](NewDateTime(Parse("2020-08-05 13:14:15")))}
+		v, e := yaml.Marshal(&u)
+		s.Nil(e)
+		s.Contains(string(v), "deleted_at:")
+
+		v, e = json.Marshal(&u)
+		s.Nil(e)
+		s.Equal(`{"name":"carbon","deleted_at":"2020-08-05 13:14:15"}`, string(v))
+	})
+}
+
+func (s *NullTypeSuite) TestNullType_Scan() {
+	s.Run("null value", func() {
+		for _, src := range []any{nil, "", "0", "0000-00-00", "0000-00-00 00:00:00", []byte(""), []byte("0000-00-00"), int64(0)} {
+			n := NewNull(NewDate(Parse("2020-08-05")))
+			s.Nil(n.Scan(src))
+			s.True(n.IsNull())
+			s.Nil(n.V.Carbon)
+		}
+	})
+
+	s.Run("valid value", func() {
+		n := NewNull[DateTime](nil)
+		s.Nil(n.Scan("2020-08-05 13:14:15"))
+		s.Equal("2020-08-05 13:14:15", n.String())
+		s.Nil(n.Scan([]byte("2020-08-05 13:14:15")))
+		s.Equal("2020-08-05 13:14:15", n.String())
+		s.Nil(n.Scan(Parse("2020-08-05 13:14:15").StdTime()))
+		s.Equal("2020-08-05 13:14:15", n.String())
+	})
+
+	s.Run("timestamp value", func() {
+		n1 := NewNull[Timestamp](nil)
+		s.Nil(n1.Scan(int64(1596604455)))
+		s.Equal("1596604455", n1.String())
+		s.Nil(n1.Scan([]byte("1596604455")))
+		s.Equal("1596604455", n1.String())
+		s.Nil(n1.Scan("2020-08-05 13:14:15"))
+		s.Equal("1596633255", n1.String())
+
+		n2 := NewNull[TimestampMilli](nil)
+		s.Nil(n2.Scan("1596604455999"))
+		s.Equal("1596604455999", n2.String())
+	})
+
+	s.Run("invalid value", func() {
+		s.Error(NewNull[DateTime](nil).Scan("xxx"))
+		s.Error(NewNull[DateTime](nil).Scan(int64(1)))
+		s.Error(NewNull[DateTime](nil).Scan(true))
+		s.Error(NewNull[*Carbon](nil).Scan("2020-08-05"))
+	})
+}
+
+func (s *NullTypeSuite) TestNullType_Value() {
+	s.Run("null value", func() {
+		v, e := NewNull(NewDate(nil)).Value()
+		s.Nil(v)
+		s.Nil(e)
+
+		v, e = NewNullType[Date, AsNull](NewDate(NewCarbon())).Value()
+		s.Nil(v)
+		s.Nil(e)
+
+		v, e = NewNullType[Date, AsEmpty](NewDate(Parse(""))).Value()
+		s.Equal("", v)
+		s.Nil(e)
+
+		v, e = NewNullType[Timestamp, AsZero](NewTimestamp(nil)).Value()
+		s.Equal(int64(0), v)
+		s.Nil(e)
+
+		v, e = NewNullType[Date, AsOmit](NewDate(nil)).Value()
+		s.Nil(v)
+		s.Nil(e)
+	})
+
+	s.Run("default policy", func() {
+		SetDefault(Default{NullPolicy: NullAsEmpty})
+		v, e := NewNull(NewDate(nil)).Value()
+		s.Equal("", v)
+		s.Nil(e)
+	})
+
+	s.Run("error value", func() {
+		v, e := NewNullType[Date, AsEmpty](NewDate(Parse("xxx"))).Value()
+		s.Nil(v)
+		s.Error(e)
+	})
+
+	s.Run("valid value", func() {
+		c := Parse("2020-08-05 13:14:15")
+		v, e := NewNullType[Date, AsZero](NewDate(c)).Value()
+		s.Equal(c.StdTime(), v)
+		s.Nil(e)
+	})
+}
+
+func (s *NullTypeSuite) TestNullType_MarshalJSON() {
+	s.Run("null value", func() {
+		var model nullTypeModel
+		v, e := json.Marshal(&model)
+		s.Nil(e)
+		s.Equal(`{"date":null,"date_time":null,"customer":"","timestamp":0,"deleted_at":null}`, string(v))
+	})
+
+	s.Run("default policy", func() {
+		SetDefault(Default{NullPolicy: NullAsZero})
+		model := nullTypeModel{
+			DateTime: NewNull(NewDateTime(NewCarbon())),
+		}
+		v, e := json.Marshal(&model)
+		s.Nil(e)
+		s.Equal(`{"date":0,"date_time":0,"customer":"","timestamp":0,"deleted_at":null}`, string(v))
+	})
+
+	s.Run("error value", func() {
+		_, e := json.Marshal(&nullTypeModel{
+			Customer: *NewNullType[FormatType[iso8601Type], AsEmpty](NewFormatType[iso8601Type](Parse("xxx"))),
+		})
+		s.Error(e)
+	})
+
+	s.Run("valid value", func() {
+		c := Parse("2020-08-05 13:14:15", PRC)
+		model := nullTypeModel{
+			Date:      *NewNull(NewDate(c)),
+			DateTime:  NewNull(NewDateTime(c)),
+			Customer:  *NewNullType[FormatType[iso8601Type], AsEmpty](NewFormatType[iso8601Type](c)),
+			Timestamp: *NewNullType[Timestamp, AsZero](NewTimestamp(c)),
+			DeletedAt: *NewNullType[DateTime, AsOmit](NewDateTime(c)),
+		}
+		v, e := json.Marshal(&model)
+		s.Nil(e)
+		s.Equal(`{"date":"2020-08-05","date_time":"2020-08-05 13:14:15","customer":"2020-08-05T13:14:15+08:00","timestamp":1596604455,"deleted_at":"2020-08-05 13:14:15"}`, string(v))
+	})
+}
+
+func (s *NullTypeSuite) TestNullType_UnmarshalJSON() {
+	s.Run("null value", func() {
+		var model nullTypeModel
+		value := `{"date":null,"date_time":"","customer":"0000-00-00 00:00:00","timestamp":0,"deleted_at":"null"}`
+		s.Nil(json.Unmarshal([]byte(value), &model))
+		s.True(model.Date.IsNull())
+		s.True(model.DateTime.IsNull())
+		s.True(model.Customer.IsNull())
+		s.True(model.Timestamp.IsNull())
+		s.True(model.DeletedAt.IsNull())
+		s.Empty(model.Date.String())
+		s.Empty(model.Timestamp.String())
+	})
+
+	s.Run("invalid value", func() {
+		var model nullTypeModel
+		s.Error(json.Unmarshal([]byte(`{"date":"xxx"}`), &model))
+		s.Error(json.Unmarshal([]byte(`{"timestamp":"xxx"}`), &model))
+		s.Error(NewNull[*Carbon](nil).UnmarshalJSON([]byte(`"2020-08-05"`)))
+	})
+
+	s.Run("valid value", func() {
+		var model nullTypeModel
+		value := `{"date":"2020-08-05","date_time":"2020-08-05 13:14:15","customer":"2020-08-05T13:14:15+00:00","timestamp":1596604455,"deleted_at":"2020-08-05 13:14:15"}`
+		s.Nil(json.Unmarshal([]byte(value), &model))
+		s.Equal("2020-08-05", model.Date.String())
+		s.Equal("2020-08-05 13:14:15", model.DateTime.String())
+		s.Equal("2020-08-05T13:14:15+00:00", model.Customer.String())
+		s.Equal("1596604455", model.Timestamp.String())
+		s.Equal("2020-08-05 13:14:15", model.DeletedAt.String())
+	})
+}
+
+func (s *NullTypeSuite) TestNullType_String() {
+	var n *Null[Date]
+	s.Empty(n.String())
+	s.Empty(NewNull(NewDate(nil)).String())
+	s.Equal("2020-08-05", NewNull(NewDate(Parse("2020-08-05"))).String())
+	s.Empty(NewNull[*Carbon](nil).String())
+
+	c := NewCarbon().SetDate(2020, 8, 5)
+	s.Empty(NewNull(&c).String())
+}